@@ -9,6 +9,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -28,30 +29,12 @@ var (
 		Use:   "slash",
 		Short: `An open source, self-hosted platform for sharing and managing your most frequently used links.`,
 		Run: func(_ *cobra.Command, _ []string) {
-			serverProfile := &profile.Profile{
-				Mode:    viper.GetString("mode"),
-				Port:    viper.GetInt("port"),
-				Data:    viper.GetString("data"),
-				DSN:     viper.GetString("dsn"),
-				Driver:  viper.GetString("driver"),
-				Version: common.GetCurrentVersion(viper.GetString("mode")),
-			}
-			if err := serverProfile.Validate(); err != nil {
-				panic(err)
-			}
-
+			serverProfile := newServerProfile()
 			ctx, cancel := context.WithCancel(context.Background())
-			dbDriver, err := db.NewDBDriver(serverProfile)
+			storeInstance, err := newStore(ctx, serverProfile)
 			if err != nil {
 				cancel()
-				slog.Error("failed to create db driver", "error", err)
-				return
-			}
-
-			storeInstance := store.New(dbDriver, serverProfile)
-			if err := storeInstance.Migrate(ctx); err != nil {
-				cancel()
-				slog.Error("failed to migrate db", "error", err)
+				slog.Error("failed to create store", "error", err)
 				return
 			}
 			s, err := server.NewServer(ctx, serverProfile, storeInstance)
@@ -86,8 +69,159 @@ var (
 			<-ctx.Done()
 		},
 	}
+
+	exportCmd = &cobra.Command{
+		Use:   "export",
+		Short: "Export the whole workspace into a backup archive.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			output, _ := cmd.Flags().GetString("output")
+			includeActivities, _ := cmd.Flags().GetBool("include-activities")
+			excludeSecrets, _ := cmd.Flags().GetBool("exclude-secrets")
+
+			serverProfile := newServerProfile()
+			ctx := context.Background()
+			storeInstance, err := newStore(ctx, serverProfile)
+			if err != nil {
+				return err
+			}
+			defer storeInstance.Close()
+
+			archive, err := storeInstance.ExportWorkspace(ctx, &store.ExportWorkspace{
+				SlashVersion:      serverProfile.Version,
+				IncludeActivities: includeActivities,
+				ExcludeSecrets:    excludeSecrets,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to export workspace")
+			}
+			content, err := store.MarshalWorkspaceArchive(archive)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal workspace archive")
+			}
+			if output == "" || output == "-" {
+				_, err = os.Stdout.Write(content)
+				return err
+			}
+			if err := os.WriteFile(output, content, 0600); err != nil {
+				return errors.Wrap(err, "failed to write archive")
+			}
+			slog.Info("workspace exported", "output", output, "users", len(archive.Users), "shortcuts", len(archive.Shortcuts), "collections", len(archive.Collections), "activities", len(archive.Activities))
+			return nil
+		},
+	}
+
+	importCmd = &cobra.Command{
+		Use:   "import",
+		Short: "Restore the workspace from a backup archive.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			input, _ := cmd.Flags().GetString("input")
+			content, err := os.ReadFile(input)
+			if err != nil {
+				return errors.Wrap(err, "failed to read archive")
+			}
+			archive, err := store.UnmarshalWorkspaceArchive(content)
+			if err != nil {
+				return err
+			}
+
+			serverProfile := newServerProfile()
+			ctx := context.Background()
+			storeInstance, err := newStore(ctx, serverProfile)
+			if err != nil {
+				return err
+			}
+			defer storeInstance.Close()
+
+			result, err := storeInstance.ImportWorkspace(ctx, archive)
+			if err != nil {
+				return errors.Wrap(err, "failed to import workspace")
+			}
+			slog.Info("workspace imported", "input", input,
+				"created_users", result.CreatedUsers, "skipped_users", result.SkippedUsers,
+				"created_shortcuts", result.CreatedShortcuts, "skipped_shortcuts", result.SkippedShortcuts,
+				"created_collections", result.CreatedCollections, "skipped_collections", result.SkippedCollections,
+				"created_groups", result.CreatedGroups, "skipped_groups", result.SkippedGroups,
+				"created_activities", result.CreatedActivities)
+			return nil
+		},
+	}
+
+	migrateDBCmd = &cobra.Command{
+		Use:   "migrate-db",
+		Short: "Copy the whole database to another one, e.g. from sqlite to postgres.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			fromDriver, _ := cmd.Flags().GetString("from-driver")
+			fromDSN, _ := cmd.Flags().GetString("from-dsn")
+			toDriver, _ := cmd.Flags().GetString("to-driver")
+			toDSN, _ := cmd.Flags().GetString("to-dsn")
+			if fromDriver == toDriver && fromDSN == toDSN {
+				return errors.New("source and target database are the same")
+			}
+
+			ctx := context.Background()
+			mode := viper.GetString("mode")
+			sourceStore, err := newStore(ctx, &profile.Profile{
+				Mode:    mode,
+				DSN:     fromDSN,
+				Driver:  fromDriver,
+				Version: common.GetCurrentVersion(mode),
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to open source database")
+			}
+			defer sourceStore.Close()
+			targetStore, err := newStore(ctx, &profile.Profile{
+				Mode:    mode,
+				DSN:     toDSN,
+				Driver:  toDriver,
+				Version: common.GetCurrentVersion(mode),
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to open target database")
+			}
+			defer targetStore.Close()
+
+			results, err := store.CopyDatabase(ctx, sourceStore, targetStore)
+			if err != nil {
+				return errors.Wrap(err, "failed to copy database")
+			}
+			for _, result := range results {
+				slog.Info("table copied", "table", result.Table, "rows", result.Rows)
+			}
+			slog.Info("database migrated", "from", fromDriver, "to", toDriver)
+			return nil
+		},
+	}
 )
 
+func newServerProfile() *profile.Profile {
+	serverProfile := &profile.Profile{
+		Mode:    viper.GetString("mode"),
+		Port:    viper.GetInt("port"),
+		Data:    viper.GetString("data"),
+		DSN:     viper.GetString("dsn"),
+		Driver:  viper.GetString("driver"),
+		Version: common.GetCurrentVersion(viper.GetString("mode")),
+	}
+	if err := serverProfile.Validate(); err != nil {
+		panic(err)
+	}
+	return serverProfile
+}
+
+// newStore creates the store of the profile and migrates the database to the latest schema.
+func newStore(ctx context.Context, serverProfile *profile.Profile) (*store.Store, error) {
+	dbDriver, err := db.NewDBDriver(serverProfile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create db driver")
+	}
+	storeInstance := store.New(dbDriver, serverProfile)
+	if err := storeInstance.Migrate(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to migrate db")
+	}
+	return storeInstance, nil
+}
+
 func init() {
 	viper.SetDefault("mode", "dev")
 	viper.SetDefault("driver", "sqlite")
@@ -116,6 +250,24 @@ func init() {
 		panic(err)
 	}
 
+	exportCmd.Flags().String("output", "", "path of the archive file, defaults to stdout")
+	exportCmd.Flags().Bool("include-activities", false, "include the activities, e.g. the shortcut views")
+	exportCmd.Flags().Bool("exclude-secrets", false, "exclude the access tokens and the session secret")
+	importCmd.Flags().String("input", "", "path of the archive file")
+	if err := importCmd.MarkFlagRequired("input"); err != nil {
+		panic(err)
+	}
+	migrateDBCmd.Flags().String("from-driver", "sqlite", "database driver of the source")
+	migrateDBCmd.Flags().String("from-dsn", "", "database source name of the source")
+	migrateDBCmd.Flags().String("to-driver", "postgres", "database driver of the target")
+	migrateDBCmd.Flags().String("to-dsn", "", "database source name of the target")
+	for _, flag := range []string{"from-dsn", "to-dsn"} {
+		if err := migrateDBCmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+	rootCmd.AddCommand(exportCmd, importCmd, migrateDBCmd)
+
 	viper.SetEnvPrefix("slash")
 	viper.AutomaticEnv()
 }
@@ -0,0 +1,184 @@
+// Package linktemplate implements the placeholders of parameterized shortcut links.
+//
+// A placeholder is enclosed in braces and is either positional or named, with an optional default value:
+//
+//	https://jira.example.com/browse/{1}
+//	https://github.com/org/{repo}/pull/{pr}
+//	https://example.com/search?q={query:slash}
+//
+// Positional placeholders refer to the arguments by their 1-based index. Named placeholders take the query param
+// with the same name, otherwise the argument at their position among the named placeholders.
+// Only the links marked as templates are parsed, where literal braces are written as "{{" and "}}".
+package linktemplate
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var placeholderNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// Placeholder is a parsed placeholder of a link template.
+type Placeholder struct {
+	// Name is the name of a named placeholder.
+	Name string
+	// Index is the 1-based index of a positional placeholder.
+	Index int
+	// Default is the value used when the argument is missing.
+	Default    string
+	HasDefault bool
+}
+
+func (p *Placeholder) String() string {
+	if p.Name != "" {
+		return p.Name
+	}
+	return strconv.Itoa(p.Index)
+}
+
+// MissingArgumentError is returned by Expand when a required argument is not given.
+type MissingArgumentError struct {
+	Placeholder string
+}
+
+func (e *MissingArgumentError) Error() string {
+	return fmt.Sprintf("missing argument %q", e.Placeholder)
+}
+
+type segment struct {
+	literal     string
+	placeholder *Placeholder
+	inQuery     bool
+}
+
+// Template is a parsed link template.
+type Template struct {
+	segments []*segment
+}
+
+// Parse parses the link template.
+func Parse(link string) (*Template, error) {
+	t := &Template{}
+	var literal strings.Builder
+	inQuery := false
+	for i := 0; i < len(link); i++ {
+		c := link[i]
+		switch {
+		case c == '{' && i+1 < len(link) && link[i+1] == '{':
+			literal.WriteByte('{')
+			i++
+		case c == '}' && i+1 < len(link) && link[i+1] == '}':
+			literal.WriteByte('}')
+			i++
+		case c == '{':
+			end := strings.IndexByte(link[i+1:], '}')
+			if end < 0 {
+				return nil, errors.Errorf("unclosed placeholder at position %d", i)
+			}
+			placeholder, err := parsePlaceholder(link[i+1 : i+1+end])
+			if err != nil {
+				return nil, err
+			}
+			if literal.Len() > 0 {
+				t.segments = append(t.segments, &segment{literal: literal.String()})
+				literal.Reset()
+			}
+			t.segments = append(t.segments, &segment{placeholder: placeholder, inQuery: inQuery})
+			i += end + 1
+		case c == '}':
+			return nil, errors.Errorf("unexpected \"}\" at position %d", i)
+		default:
+			if c == '?' {
+				inQuery = true
+			}
+			literal.WriteByte(c)
+		}
+	}
+	if literal.Len() > 0 {
+		t.segments = append(t.segments, &segment{literal: literal.String()})
+	}
+	return t, nil
+}
+
+func parsePlaceholder(s string) (*Placeholder, error) {
+	name, defaultValue, hasDefault := strings.Cut(s, ":")
+	placeholder := &Placeholder{
+		Default:    defaultValue,
+		HasDefault: hasDefault,
+	}
+	if index, err := strconv.Atoi(name); err == nil {
+		if index < 1 {
+			return nil, errors.Errorf("invalid placeholder index %d, must start from 1", index)
+		}
+		placeholder.Index = index
+		return placeholder, nil
+	}
+	if !placeholderNameRegexp.MatchString(name) {
+		return nil, errors.Errorf("invalid placeholder name %q", name)
+	}
+	placeholder.Name = name
+	return placeholder, nil
+}
+
+// Placeholders returns the distinct placeholders in the order of their first appearance.
+func (t *Template) Placeholders() []*Placeholder {
+	placeholders := []*Placeholder{}
+	seen := map[string]bool{}
+	for _, segment := range t.segments {
+		if segment.placeholder == nil || seen[segment.placeholder.String()] {
+			continue
+		}
+		seen[segment.placeholder.String()] = true
+		placeholders = append(placeholders, segment.placeholder)
+	}
+	return placeholders
+}
+
+// Expand replaces the placeholders with the given positional arguments and query params.
+// It returns the expanded link and the names of the query params consumed by named placeholders.
+func (t *Template) Expand(args []string, params url.Values) (string, []string, error) {
+	values := map[string]string{}
+	consumedParams := []string{}
+	namedPosition := 0
+	for _, placeholder := range t.Placeholders() {
+		value, ok := "", false
+		if placeholder.Name != "" {
+			if params.Has(placeholder.Name) {
+				value, ok = params.Get(placeholder.Name), true
+				consumedParams = append(consumedParams, placeholder.Name)
+			} else if namedPosition < len(args) {
+				value, ok = args[namedPosition], true
+			}
+			namedPosition++
+		} else if placeholder.Index <= len(args) {
+			value, ok = args[placeholder.Index-1], true
+		}
+		if !ok || value == "" {
+			if !placeholder.HasDefault {
+				return "", nil, &MissingArgumentError{Placeholder: placeholder.String()}
+			}
+			value = placeholder.Default
+		}
+		values[placeholder.String()] = value
+	}
+
+	var link strings.Builder
+	for _, segment := range t.segments {
+		if segment.placeholder == nil {
+			link.WriteString(segment.literal)
+			continue
+		}
+		value := values[segment.placeholder.String()]
+		if segment.inQuery {
+			link.WriteString(url.QueryEscape(value))
+		} else {
+			link.WriteString(url.PathEscape(value))
+		}
+	}
+	return link.String(), consumedParams, nil
+}
@@ -0,0 +1,98 @@
+package linktemplate
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		link    string
+		wantErr bool
+	}{
+		{link: "https://example.com"},
+		{link: "https://jira.example.com/browse/{1}"},
+		{link: "https://github.com/org/{repo}/pull/{pr:1}"},
+		{link: "https://example.com/{{literal}}"},
+		{link: "https://example.com/{1", wantErr: true},
+		{link: "https://example.com/1}", wantErr: true},
+		{link: "https://example.com/{0}", wantErr: true},
+		{link: "https://example.com/{}", wantErr: true},
+		{link: "https://example.com/{a b}", wantErr: true},
+	}
+	for _, test := range tests {
+		_, err := Parse(test.link)
+		require.Equal(t, test.wantErr, err != nil, test.link)
+	}
+}
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		link           string
+		args           []string
+		params         url.Values
+		expected       string
+		consumedParams []string
+		missing        string
+	}{
+		{
+			link:     "https://jira.example.com/browse/{1}",
+			args:     []string{"ABC-123"},
+			expected: "https://jira.example.com/browse/ABC-123",
+		},
+		{
+			link:     "https://github.com/org/{repo}/pull/{pr}",
+			args:     []string{"slash", "42"},
+			expected: "https://github.com/org/slash/pull/42",
+		},
+		{
+			link:           "https://github.com/org/{repo}/pull/{pr}",
+			args:           []string{"slash"},
+			params:         url.Values{"pr": []string{"42"}},
+			expected:       "https://github.com/org/slash/pull/42",
+			consumedParams: []string{"pr"},
+		},
+		{
+			link:     "https://example.com/search?q={query:slash}&lang={2:en}",
+			expected: "https://example.com/search?q=slash&lang=en",
+		},
+		{
+			link:     "https://example.com/search?q={1}",
+			args:     []string{"a b&c"},
+			expected: "https://example.com/search?q=a+b%26c",
+		},
+		{
+			link:     "https://example.com/{{literal}}/{1}",
+			args:     []string{"x"},
+			expected: "https://example.com/{literal}/x",
+		},
+		{
+			link:    "https://github.com/org/{repo}/pull/{pr}",
+			args:    []string{"slash"},
+			missing: "pr",
+		},
+		{
+			link:    "https://jira.example.com/browse/{1}",
+			missing: "1",
+		},
+	}
+	for _, test := range tests {
+		template, err := Parse(test.link)
+		require.NoError(t, err)
+		link, consumedParams, err := template.Expand(test.args, test.params)
+		if test.missing != "" {
+			var missingArgumentErr *MissingArgumentError
+			require.ErrorAs(t, err, &missingArgumentErr)
+			require.Equal(t, test.missing, missingArgumentErr.Placeholder)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, test.expected, link)
+		if test.consumedParams == nil {
+			test.consumedParams = []string{}
+		}
+		require.Equal(t, test.consumedParams, consumedParams)
+	}
+}
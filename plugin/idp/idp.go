@@ -1,7 +1,65 @@
 package idp
 
+import (
+	"slices"
+	"strings"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
 type IdentityProviderUserInfo struct {
 	Identifier  string
 	Email       string
 	DisplayName string
+	Groups      []string
+}
+
+// ParseGroups returns the groups out of a claim value, which is either a list or a single group.
+func ParseGroups(value any) []string {
+	groups := []string{}
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			groups = append(groups, v)
+		}
+	case []any:
+		for _, item := range v {
+			if group, ok := item.(string); ok && group != "" {
+				groups = append(groups, group)
+			}
+		}
+	}
+	return groups
+}
+
+// FindRoleMappingRule returns the first rule the user matches, or nil if none.
+func FindRoleMappingRule(rules []*storepb.IdentityProvider_RoleMappingRule, userInfo *IdentityProviderUserInfo) *storepb.IdentityProvider_RoleMappingRule {
+	for _, rule := range rules {
+		if len(rule.Groups) > 0 && !slices.ContainsFunc(rule.Groups, func(group string) bool {
+			return slices.ContainsFunc(userInfo.Groups, func(userGroup string) bool {
+				return strings.EqualFold(group, userGroup)
+			})
+		}) {
+			continue
+		}
+		if len(rule.EmailDomains) > 0 && !IsEmailDomainAllowed(rule.EmailDomains, userInfo.Identifier) {
+			continue
+		}
+		return rule
+	}
+	return nil
+}
+
+// IsEmailDomainAllowed returns whether the domain of the email is one of the domains, or the domains are empty.
+func IsEmailDomainAllowed(domains []string, email string) bool {
+	if len(domains) == 0 {
+		return true
+	}
+	index := strings.LastIndex(email, "@")
+	if index < 0 {
+		return false
+	}
+	return slices.ContainsFunc(domains, func(domain string) bool {
+		return strings.EqualFold(strings.TrimPrefix(domain, "@"), email[index+1:])
+	})
 }
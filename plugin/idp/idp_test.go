@@ -0,0 +1,64 @@
+package idp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+func TestParseGroups(t *testing.T) {
+	tests := []struct {
+		value any
+		want  []string
+	}{
+		{value: nil, want: []string{}},
+		{value: "admins", want: []string{"admins"}},
+		{value: []any{"admins", 1, "", "users"}, want: []string{"admins", "users"}},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, ParseGroups(test.value))
+	}
+}
+
+func TestFindRoleMappingRule(t *testing.T) {
+	rules := []*storepb.IdentityProvider_RoleMappingRule{
+		{Groups: []string{"contractors"}, Deny: true},
+		{Groups: []string{"admins", "owners"}, EmailDomains: []string{"example.com"}, Role: "ADMIN"},
+		{EmailDomains: []string{"example.com", "example.org"}, Role: "USER"},
+	}
+	tests := []struct {
+		userInfo *IdentityProviderUserInfo
+		want     *storepb.IdentityProvider_RoleMappingRule
+	}{
+		{
+			userInfo: &IdentityProviderUserInfo{Identifier: "alice@example.com", Groups: []string{"Owners"}},
+			want:     rules[1],
+		},
+		{
+			userInfo: &IdentityProviderUserInfo{Identifier: "bob@example.com", Groups: []string{"admins", "contractors"}},
+			want:     rules[0],
+		},
+		{
+			userInfo: &IdentityProviderUserInfo{Identifier: "carol@example.org", Groups: []string{"admins"}},
+			want:     rules[2],
+		},
+		{
+			userInfo: &IdentityProviderUserInfo{Identifier: "dave@example.net"},
+			want:     nil,
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, FindRoleMappingRule(rules, test.userInfo), test.userInfo.Identifier)
+	}
+}
+
+func TestIsEmailDomainAllowed(t *testing.T) {
+	assert.True(t, IsEmailDomainAllowed(nil, "alice@example.com"))
+	assert.True(t, IsEmailDomainAllowed([]string{"example.com"}, "alice@Example.COM"))
+	assert.True(t, IsEmailDomainAllowed([]string{"@example.com"}, "alice@example.com"))
+	assert.False(t, IsEmailDomainAllowed([]string{"example.com"}, "alice@sub.example.com"))
+	assert.False(t, IsEmailDomainAllowed([]string{"example.com"}, "alice@evil.com"))
+	assert.False(t, IsEmailDomainAllowed([]string{"example.com"}, "example.com"))
+}
@@ -0,0 +1,158 @@
+// Package ldap is the plugin for LDAP Identity Provider, such as OpenLDAP or Active Directory,
+// which checks the credentials of the password sign in.
+package ldap
+
+import (
+	"crypto/tls"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/plugin/idp"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+const (
+	defaultUserFilter = "(mail={username})"
+	dialTimeout       = 10 * time.Second
+)
+
+// ErrInvalidCredentials is returned when the user is not found or the password does not match.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// IdentityProvider represents an LDAP Identity Provider.
+type IdentityProvider struct {
+	config *storepb.IdentityProviderConfig_LDAPConfig
+}
+
+// NewIdentityProvider initializes a new LDAP Identity Provider with the given configuration.
+func NewIdentityProvider(config *storepb.IdentityProviderConfig_LDAPConfig) (*IdentityProvider, error) {
+	for v, field := range map[string]string{
+		config.Url:        "url",
+		config.SearchBase: "searchBase",
+	} {
+		if v == "" {
+			return nil, errors.Errorf(`the field "%s" is empty but required`, field)
+		}
+	}
+
+	return &IdentityProvider{
+		config: config,
+	}, nil
+}
+
+// Authenticate searches the user of the username with the service account, then binds as the user
+// with the password. It returns the user information mapped from the attributes of the user entry.
+func (p *IdentityProvider) Authenticate(username, password string) (*idp.IdentityProviderUserInfo, error) {
+	// An empty password would be an unauthenticated bind, which succeeds on most servers.
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	identifierField, displayNameField := "mail", "cn"
+	if fieldMapping := p.config.FieldMapping; fieldMapping != nil {
+		if fieldMapping.Identifier != "" {
+			identifierField = fieldMapping.Identifier
+		}
+		if fieldMapping.DisplayName != "" {
+			displayNameField = fieldMapping.DisplayName
+		}
+	}
+	attributes := []string{identifierField, displayNameField}
+	groupsField := p.config.GetFieldMapping().GetGroups()
+	if groupsField != "" {
+		attributes = append(attributes, groupsField)
+	}
+
+	conn, err := p.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if p.config.BindDn != "" {
+		if err := conn.Bind(p.config.BindDn, p.config.BindPassword); err != nil {
+			return nil, errors.Wrap(err, "failed to bind with the bind dn")
+		}
+	}
+	userFilter := p.config.UserFilter
+	if userFilter == "" {
+		userFilter = defaultUserFilter
+	}
+	result, err := conn.Search(ldap.NewSearchRequest(
+		p.config.SearchBase,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		2,
+		int(dialTimeout.Seconds()),
+		false,
+		strings.ReplaceAll(userFilter, "{username}", ldap.EscapeFilter(username)),
+		attributes,
+		nil,
+	))
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+			return nil, ErrInvalidCredentials
+		}
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+			return nil, errors.New("multiple users found for the username")
+		}
+		return nil, errors.Wrap(err, "failed to search user")
+	}
+	if len(result.Entries) == 0 {
+		return nil, ErrInvalidCredentials
+	}
+	if len(result.Entries) > 1 {
+		return nil, errors.New("multiple users found for the username")
+	}
+
+	entry := result.Entries[0]
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, errors.Wrap(err, "failed to bind with the user dn")
+	}
+
+	userInfo := &idp.IdentityProviderUserInfo{
+		Identifier:  entry.GetAttributeValue(identifierField),
+		DisplayName: entry.GetAttributeValue(displayNameField),
+	}
+	if userInfo.Identifier == "" {
+		return nil, errors.Errorf("the attribute %q is not found in user entry or has empty value", identifierField)
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if groupsField != "" {
+		userInfo.Groups = entry.GetAttributeValues(groupsField)
+	}
+	return userInfo, nil
+}
+
+func (p *IdentityProvider) dial() (*ldap.Conn, error) {
+	u, err := url.Parse(p.config.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url")
+	}
+	tlsConfig := &tls.Config{
+		ServerName: u.Hostname(),
+		MinVersion: tls.VersionTLS12,
+	}
+	conn, err := ldap.DialURL(p.config.Url, ldap.DialWithTLSConfig(tlsConfig), ldap.DialWithDialer(&net.Dialer{Timeout: dialTimeout}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to the ldap server")
+	}
+	conn.SetTimeout(dialTimeout)
+	if p.config.StartTls && u.Scheme == "ldap" {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, errors.Wrap(err, "failed to start tls")
+		}
+	}
+	return conn, nil
+}
@@ -0,0 +1,145 @@
+package ldap
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jimlambrt/gldap"
+	"github.com/jimlambrt/gldap/testdirectory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/plugin/idp"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+const (
+	testBindDN       = "cn=admin,ou=people,dc=example,dc=org"
+	testBindPassword = "admin-password"
+	testGroupDN      = "cn=admins,ou=groups,dc=example,dc=org"
+)
+
+// startTestDirectory starts an embedded LDAP server with the users alice, member of the admins group,
+// and bob, whose password is "password", and the service account of the bind dn.
+func startTestDirectory(t *testing.T, allowAnonymousBind bool) *testdirectory.Directory {
+	users := testdirectory.NewUsers(t, []string{"alice"}, testdirectory.WithMembersOf(t, testGroupDN))
+	users = append(users, testdirectory.NewUsers(t, []string{"bob"})...)
+	users = append(users, gldap.NewEntry(testBindDN, map[string][]string{
+		"password": {testBindPassword},
+	}))
+	return testdirectory.Start(t,
+		testdirectory.WithNoTLS(t),
+		testdirectory.WithDefaults(t, &testdirectory.Defaults{
+			Users:              users,
+			AllowAnonymousBind: allowAnonymousBind,
+		}),
+	)
+}
+
+func newTestIdentityProvider(t *testing.T, directory *testdirectory.Directory, config *storepb.IdentityProviderConfig_LDAPConfig) *IdentityProvider {
+	config.Url = fmt.Sprintf("ldap://%s:%d", directory.Host(), directory.Port())
+	config.SearchBase = testdirectory.DefaultUserDN
+	config.UserFilter = "(cn={username})"
+	if config.FieldMapping == nil {
+		config.FieldMapping = &storepb.IdentityProviderConfig_FieldMapping{
+			Identifier:  "email",
+			DisplayName: "name",
+		}
+	}
+	identityProvider, err := NewIdentityProvider(config)
+	require.NoError(t, err)
+	return identityProvider
+}
+
+func TestNewIdentityProvider(t *testing.T) {
+	_, err := NewIdentityProvider(&storepb.IdentityProviderConfig_LDAPConfig{
+		Url: "ldap://ldap.example.com",
+	})
+	assert.ErrorContains(t, err, `the field "searchBase" is empty but required`)
+}
+
+func TestAuthenticate(t *testing.T) {
+	directory := startTestDirectory(t, false)
+	identityProvider := newTestIdentityProvider(t, directory, &storepb.IdentityProviderConfig_LDAPConfig{
+		BindDn:       testBindDN,
+		BindPassword: testBindPassword,
+	})
+
+	t.Run("success", func(t *testing.T) {
+		userInfo, err := identityProvider.Authenticate("alice", "password")
+		require.NoError(t, err)
+		assert.Equal(t, &idp.IdentityProviderUserInfo{
+			Identifier:  "alice@example.com",
+			DisplayName: "alice",
+		}, userInfo)
+	})
+	t.Run("groups", func(t *testing.T) {
+		identityProvider := newTestIdentityProvider(t, directory, &storepb.IdentityProviderConfig_LDAPConfig{
+			BindDn:       testBindDN,
+			BindPassword: testBindPassword,
+			FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
+				Identifier: "email",
+				Groups:     "memberOf",
+			},
+		})
+		userInfo, err := identityProvider.Authenticate("alice", "password")
+		require.NoError(t, err)
+		assert.Equal(t, []string{testGroupDN}, userInfo.Groups)
+		userInfo, err = identityProvider.Authenticate("bob", "password")
+		require.NoError(t, err)
+		assert.Empty(t, userInfo.Groups)
+	})
+	t.Run("wrong password", func(t *testing.T) {
+		_, err := identityProvider.Authenticate("alice", "wrong-password")
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+	t.Run("empty password", func(t *testing.T) {
+		_, err := identityProvider.Authenticate("alice", "")
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+	t.Run("unknown user", func(t *testing.T) {
+		_, err := identityProvider.Authenticate("carol", "password")
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+	t.Run("missing identifier attribute", func(t *testing.T) {
+		identityProvider := newTestIdentityProvider(t, directory, &storepb.IdentityProviderConfig_LDAPConfig{
+			BindDn:       testBindDN,
+			BindPassword: testBindPassword,
+			FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
+				Identifier: "mail",
+			},
+		})
+		_, err := identityProvider.Authenticate("bob", "password")
+		assert.ErrorContains(t, err, `the attribute "mail" is not found`)
+	})
+	t.Run("wrong bind password", func(t *testing.T) {
+		identityProvider := newTestIdentityProvider(t, directory, &storepb.IdentityProviderConfig_LDAPConfig{
+			BindDn:       testBindDN,
+			BindPassword: "wrong-password",
+		})
+		_, err := identityProvider.Authenticate("alice", "password")
+		assert.ErrorContains(t, err, "failed to bind with the bind dn")
+	})
+}
+
+func TestAuthenticateAnonymousSearch(t *testing.T) {
+	directory := startTestDirectory(t, true)
+	identityProvider := newTestIdentityProvider(t, directory, &storepb.IdentityProviderConfig_LDAPConfig{})
+
+	userInfo, err := identityProvider.Authenticate("bob", "password")
+	require.NoError(t, err)
+	assert.Equal(t, "bob@example.com", userInfo.Identifier)
+}
+
+func TestAuthenticateStartTLS(t *testing.T) {
+	directory := startTestDirectory(t, false)
+	identityProvider := newTestIdentityProvider(t, directory, &storepb.IdentityProviderConfig_LDAPConfig{
+		BindDn:       testBindDN,
+		BindPassword: testBindPassword,
+		StartTls:     true,
+	})
+
+	// The certificate of the test directory is issued by its own CA, which is not trusted.
+	_, err := identityProvider.Authenticate("alice", "password")
+	assert.ErrorContains(t, err, "failed to start tls")
+}
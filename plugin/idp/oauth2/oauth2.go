@@ -58,12 +58,11 @@ func (p *IdentityProvider) ExchangeToken(ctx context.Context, redirectURL, code
 		return "", errors.Wrap(err, "failed to exchange access token")
 	}
 
-	accessToken, ok := token.Extra("access_token").(string)
-	if !ok {
+	if token.AccessToken == "" {
 		return "", errors.New(`missing "access_token" from authorization response`)
 	}
 
-	return accessToken, nil
+	return token.AccessToken, nil
 }
 
 // UserInfo returns the parsed user information using the given OAuth2 token.
@@ -108,5 +107,8 @@ func (p *IdentityProvider) UserInfo(token string) (*idp.IdentityProviderUserInfo
 	if userInfo.DisplayName == "" {
 		userInfo.DisplayName = userInfo.Identifier
 	}
+	if p.config.FieldMapping.Groups != "" {
+		userInfo.Groups = idp.ParseGroups(claims[p.config.FieldMapping.Groups])
+	}
 	return userInfo, nil
 }
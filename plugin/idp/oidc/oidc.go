@@ -0,0 +1,359 @@
+// Package oidc is the plugin for OpenID Connect Identity Provider.
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/yourselfhosted/slash/plugin/idp"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// requestTimeout is the timeout of the requests to the provider.
+const requestTimeout = 10 * time.Second
+
+// supportedSigningMethods are the signing algorithms accepted for ID tokens.
+var supportedSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// IdentityProvider represents an OpenID Connect Identity Provider.
+type IdentityProvider struct {
+	config *storepb.IdentityProviderConfig_OIDCConfig
+	client *http.Client
+}
+
+// ProviderMetadata is the subset of the OpenID provider metadata used to sign in.
+// See https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata.
+type ProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Token is the token set returned by the token endpoint.
+type Token struct {
+	AccessToken string
+	IDToken     string
+}
+
+// NewIdentityProvider initializes a new OIDC Identity Provider with the given configuration.
+func NewIdentityProvider(config *storepb.IdentityProviderConfig_OIDCConfig) (*IdentityProvider, error) {
+	for v, field := range map[string]string{
+		config.ClientId:  "clientId",
+		config.IssuerUrl: "issuerUrl",
+	} {
+		if v == "" {
+			return nil, errors.Errorf(`the field "%s" is empty but required`, field)
+		}
+	}
+
+	return &IdentityProvider{
+		config: config,
+		client: &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// Discover fetches the provider metadata from the ".well-known/openid-configuration" document of the issuer.
+func (p *IdentityProvider) Discover(ctx context.Context) (*ProviderMetadata, error) {
+	discoveryURL := strings.TrimSuffix(p.config.IssuerUrl, "/") + "/.well-known/openid-configuration"
+	metadata := &ProviderMetadata{}
+	if err := p.getJSON(ctx, discoveryURL, "", metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to discover provider metadata")
+	}
+	if strings.TrimSuffix(metadata.Issuer, "/") != strings.TrimSuffix(p.config.IssuerUrl, "/") {
+		return nil, errors.Errorf("issuer %q does not match the configured one %q", metadata.Issuer, p.config.IssuerUrl)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, errors.New("provider metadata misses the authorization, token or jwks endpoints")
+	}
+	return metadata, nil
+}
+
+// AuthCodeURL returns the url to redirect the user to, with the state, the nonce and the PKCE code challenge.
+func (p *IdentityProvider) AuthCodeURL(metadata *ProviderMetadata, redirectURL, state, nonce, codeVerifier string) string {
+	return p.oauth2Config(metadata, redirectURL).AuthCodeURL(
+		state,
+		oauth2.SetAuthURLParam("nonce", nonce),
+		oauth2.S256ChallengeOption(codeVerifier),
+	)
+}
+
+// ExchangeToken returns the tokens exchanged with the given authorization code and PKCE code verifier.
+func (p *IdentityProvider) ExchangeToken(ctx context.Context, metadata *ProviderMetadata, redirectURL, code, codeVerifier string) (*Token, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.client)
+	token, err := p.oauth2Config(metadata, redirectURL).Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to exchange token")
+	}
+
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok || idToken == "" {
+		return nil, errors.New(`missing "id_token" from token response`)
+	}
+	return &Token{
+		AccessToken: token.AccessToken,
+		IDToken:     idToken,
+	}, nil
+}
+
+// VerifyIDToken verifies the signature of the ID token against the JWKS of the provider, and its issuer,
+// audience, expiration and nonce. It returns the claims of the ID token.
+func (p *IdentityProvider) VerifyIDToken(ctx context.Context, metadata *ProviderMetadata, rawIDToken, nonce string) (jwt.MapClaims, error) {
+	keys, err := p.fetchJWKS(ctx, metadata.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(rawIDToken, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		for _, key := range keys {
+			if kid == "" || key.kid == kid {
+				return key.publicKey, nil
+			}
+		}
+		return nil, errors.Errorf("signing key %q not found", kid)
+	},
+		jwt.WithValidMethods(supportedSigningMethods),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(p.config.ClientId),
+		jwt.WithExpirationRequired(),
+	); err != nil {
+		return nil, errors.Wrap(err, "invalid id token")
+	}
+
+	if v, _ := claims["nonce"].(string); v != nonce {
+		return nil, errors.New("id token nonce mismatch")
+	}
+	// The authorized party must be the client when there are multiple audiences.
+	if audiences, _ := claims.GetAudience(); len(audiences) > 1 {
+		if v, _ := claims["azp"].(string); v != p.config.ClientId {
+			return nil, errors.New("id token authorized party mismatch")
+		}
+	}
+	return claims, nil
+}
+
+// UserInfo returns the user information out of the verified ID token claims, completed with the ones
+// of the userinfo endpoint if any.
+func (p *IdentityProvider) UserInfo(ctx context.Context, metadata *ProviderMetadata, token *Token, claims jwt.MapClaims) (*idp.IdentityProviderUserInfo, error) {
+	identifierField, displayNameField := "email", "name"
+	if fieldMapping := p.config.FieldMapping; fieldMapping != nil {
+		if fieldMapping.Identifier != "" {
+			identifierField = fieldMapping.Identifier
+		}
+		if fieldMapping.DisplayName != "" {
+			displayNameField = fieldMapping.DisplayName
+		}
+	}
+
+	if metadata.UserInfoEndpoint != "" && token.AccessToken != "" {
+		userInfoClaims := map[string]any{}
+		if err := p.getJSON(ctx, metadata.UserInfoEndpoint, token.AccessToken, &userInfoClaims); err != nil {
+			return nil, errors.Wrap(err, "failed to get user information")
+		}
+		// The userinfo response must be about the same subject as the ID token.
+		if v, _ := userInfoClaims["sub"].(string); v == "" || v != claims["sub"] {
+			return nil, errors.New("userinfo subject mismatch")
+		}
+		for key, value := range userInfoClaims {
+			if _, ok := claims[key]; !ok {
+				claims[key] = value
+			}
+		}
+	}
+
+	// The users are matched by email, which must not be trusted unless the provider has verified it.
+	if email, _ := claims["email"].(string); email != "" {
+		v, ok := claims["email_verified"]
+		if ok && !isTrue(v) || !ok && !p.config.AllowMissingEmailVerified {
+			return nil, errors.New("the email is not verified by the identity provider")
+		}
+	}
+
+	userInfo := &idp.IdentityProviderUserInfo{}
+	if v, ok := claims[identifierField].(string); ok {
+		userInfo.Identifier = v
+	}
+	if userInfo.Identifier == "" {
+		return nil, errors.Errorf("the field %q is not found in claims or has empty value", identifierField)
+	}
+	if v, ok := claims["email"].(string); ok {
+		userInfo.Email = v
+	}
+	if v, ok := claims[displayNameField].(string); ok {
+		userInfo.DisplayName = v
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if groupsField := p.config.GetFieldMapping().GetGroups(); groupsField != "" {
+		userInfo.Groups = idp.ParseGroups(claims[groupsField])
+	}
+	return userInfo, nil
+}
+
+// isTrue returns whether the claim is true, which some providers send as a string.
+func isTrue(claim any) bool {
+	switch v := claim.(type) {
+	case bool:
+		return v
+	case string:
+		return strings.EqualFold(v, "true")
+	default:
+		return false
+	}
+}
+
+func (p *IdentityProvider) oauth2Config(metadata *ProviderMetadata, redirectURL string) *oauth2.Config {
+	scopes := []string{"openid"}
+	for _, scope := range p.config.Scopes {
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 1 {
+		scopes = append(scopes, "profile", "email")
+	}
+	return &oauth2.Config{
+		ClientID:     p.config.ClientId,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  metadata.AuthorizationEndpoint,
+			TokenURL: metadata.TokenEndpoint,
+		},
+	}
+}
+
+func (p *IdentityProvider) getJSON(ctx context.Context, url, accessToken string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to new http request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if accessToken != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get %s", url)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read response body")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return errors.Wrap(err, "failed to unmarshal response body")
+	}
+	return nil
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type verificationKey struct {
+	kid       string
+	publicKey any
+}
+
+// fetchJWKS returns the signature verification keys of the JWKS. The unsupported keys are skipped.
+func (p *IdentityProvider) fetchJWKS(ctx context.Context, jwksURI string) ([]*verificationKey, error) {
+	jwks := struct {
+		Keys []*jsonWebKey `json:"keys"`
+	}{}
+	if err := p.getJSON(ctx, jwksURI, "", &jwks); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch jwks")
+	}
+
+	keys := []*verificationKey{}
+	for _, key := range jwks.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		publicKey, err := key.publicKey()
+		if err != nil {
+			continue
+		}
+		keys = append(keys, &verificationKey{kid: key.Kid, publicKey: publicKey})
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no signing key found in jwks")
+	}
+	return keys, nil
+}
+
+func (k *jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode key parameter")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
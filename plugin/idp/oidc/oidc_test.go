@@ -0,0 +1,267 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/plugin/idp"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+const (
+	testClientID    = "test-client-id"
+	testCode        = "test-code"
+	testAccessToken = "test-access-token"
+	testSubject     = "248289761001"
+	testName        = "John Doe"
+	testEmail       = "john.doe@example.com"
+	testKeyID       = "test-key"
+)
+
+// mockProvider is a local stand-in of an OpenID provider.
+type mockProvider struct {
+	*httptest.Server
+
+	key *rsa.PrivateKey
+	// signingKey signs the ID tokens, which is the key published in the JWKS by default.
+	signingKey *rsa.PrivateKey
+	issuer     string
+	// userInfo is the response of the userinfo endpoint.
+	userInfo map[string]any
+	// The nonce and code challenge of the last authorization request.
+	nonce         string
+	codeChallenge string
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	p := &mockProvider{key: key, signingKey: key}
+	p.userInfo = map[string]any{
+		"sub":            testSubject,
+		"name":           testName,
+		"email_verified": true,
+		"groups":         []string{"admins", "users"},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, map[string]any{
+			"issuer":                 p.issuer,
+			"authorization_endpoint": p.URL + "/authorize",
+			"token_endpoint":         p.URL + "/token",
+			"userinfo_endpoint":      p.URL + "/userinfo",
+			"jwks_uri":               p.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, map[string]any{
+			"keys": []map[string]any{{
+				"kty": "RSA",
+				"kid": testKeyID,
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		verifierHash := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != testCode || base64.RawURLEncoding.EncodeToString(verifierHash[:]) != p.codeChallenge {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(t, w, map[string]any{"error": "invalid_grant"})
+			return
+		}
+		idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":   p.issuer,
+			"sub":   testSubject,
+			"aud":   testClientID,
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"iat":   time.Now().Unix(),
+			"nonce": p.nonce,
+			"email": testEmail,
+		})
+		idToken.Header["kid"] = testKeyID
+		rawIDToken, err := idToken.SignedString(p.signingKey)
+		require.NoError(t, err)
+		writeJSON(t, w, map[string]any{
+			"access_token": testAccessToken,
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     rawIDToken,
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer "+testAccessToken, r.Header.Get("Authorization"))
+		writeJSON(t, w, p.userInfo)
+	})
+	p.Server = httptest.NewServer(mux)
+	p.issuer = p.URL
+	t.Cleanup(p.Close)
+	return p
+}
+
+// authorize mimics the user consenting at the authorization url, which returns the state.
+func (p *mockProvider) authorize(t *testing.T, authCodeURL string) string {
+	u, err := url.Parse(authCodeURL)
+	require.NoError(t, err)
+	query := u.Query()
+	require.Equal(t, "S256", query.Get("code_challenge_method"))
+	require.Equal(t, testClientID, query.Get("client_id"))
+	require.Contains(t, query.Get("scope"), "openid")
+	p.nonce, p.codeChallenge = query.Get("nonce"), query.Get("code_challenge")
+	return query.Get("state")
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
+
+func TestNewIdentityProvider(t *testing.T) {
+	_, err := NewIdentityProvider(&storepb.IdentityProviderConfig_OIDCConfig{
+		ClientId: testClientID,
+	})
+	assert.ErrorContains(t, err, `the field "issuerUrl" is empty but required`)
+}
+
+func TestIdentityProvider(t *testing.T) {
+	ctx := context.Background()
+	p := newMockProvider(t)
+	oidc, err := NewIdentityProvider(&storepb.IdentityProviderConfig_OIDCConfig{
+		ClientId:     testClientID,
+		ClientSecret: "test-client-secret",
+		IssuerUrl:    p.URL,
+	})
+	require.NoError(t, err)
+	metadata, err := oidc.Discover(ctx)
+	require.NoError(t, err)
+
+	redirectURL := "https://example.com/auth/callback"
+	signIn := func(nonce, codeVerifier string) (*idp.IdentityProviderUserInfo, error) {
+		state := p.authorize(t, oidc.AuthCodeURL(metadata, redirectURL, "test-state", nonce, "valid-code-verifier-0123456789abcdef0123456"))
+		require.Equal(t, "test-state", state)
+		token, err := oidc.ExchangeToken(ctx, metadata, redirectURL, testCode, codeVerifier)
+		if err != nil {
+			return nil, err
+		}
+		claims, err := oidc.VerifyIDToken(ctx, metadata, token.IDToken, "test-nonce")
+		if err != nil {
+			return nil, err
+		}
+		return oidc.UserInfo(ctx, metadata, token, claims)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		userInfo, err := signIn("test-nonce", "valid-code-verifier-0123456789abcdef0123456")
+		require.NoError(t, err)
+		assert.Equal(t, &idp.IdentityProviderUserInfo{
+			Identifier:  testEmail,
+			Email:       testEmail,
+			DisplayName: testName,
+		}, userInfo)
+	})
+	t.Run("wrong code verifier", func(t *testing.T) {
+		_, err := signIn("test-nonce", "other-code-verifier-0123456789abcdef0123456")
+		assert.ErrorContains(t, err, "failed to exchange token")
+	})
+	t.Run("wrong nonce", func(t *testing.T) {
+		_, err := signIn("other-nonce", "valid-code-verifier-0123456789abcdef0123456")
+		assert.ErrorContains(t, err, "id token nonce mismatch")
+	})
+	t.Run("wrong signature", func(t *testing.T) {
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		p.signingKey = otherKey
+		defer func() { p.signingKey = p.key }()
+		_, err = signIn("test-nonce", "valid-code-verifier-0123456789abcdef0123456")
+		assert.ErrorContains(t, err, "invalid id token")
+	})
+}
+
+func TestUserInfoGroups(t *testing.T) {
+	ctx := context.Background()
+	p := newMockProvider(t)
+	oidc, err := NewIdentityProvider(&storepb.IdentityProviderConfig_OIDCConfig{
+		ClientId:  testClientID,
+		IssuerUrl: p.URL,
+		FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
+			Groups: "groups",
+		},
+	})
+	require.NoError(t, err)
+	metadata, err := oidc.Discover(ctx)
+	require.NoError(t, err)
+
+	userInfo, err := oidc.UserInfo(ctx, metadata, &Token{AccessToken: testAccessToken}, map[string]any{
+		"sub":   testSubject,
+		"email": testEmail,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"admins", "users"}, userInfo.Groups)
+}
+
+func TestDiscoverIssuerMismatch(t *testing.T) {
+	p := newMockProvider(t)
+	p.issuer = "https://evil.example.com"
+	oidc, err := NewIdentityProvider(&storepb.IdentityProviderConfig_OIDCConfig{
+		ClientId:  testClientID,
+		IssuerUrl: p.URL,
+	})
+	require.NoError(t, err)
+	_, err = oidc.Discover(context.Background())
+	assert.ErrorContains(t, err, "does not match the configured one")
+}
+
+func TestUserInfoVerification(t *testing.T) {
+	ctx := context.Background()
+	p := newMockProvider(t)
+	oidc, err := NewIdentityProvider(&storepb.IdentityProviderConfig_OIDCConfig{
+		ClientId:  testClientID,
+		IssuerUrl: p.URL,
+	})
+	require.NoError(t, err)
+	metadata, err := oidc.Discover(ctx)
+	require.NoError(t, err)
+	userInfo := func(userInfoClaims, claims map[string]any) (*idp.IdentityProviderUserInfo, error) {
+		p.userInfo = userInfoClaims
+		return oidc.UserInfo(ctx, metadata, &Token{AccessToken: testAccessToken}, claims)
+	}
+
+	_, err = userInfo(map[string]any{"name": testName}, map[string]any{"sub": testSubject, "email": testEmail})
+	assert.ErrorContains(t, err, "userinfo subject mismatch")
+	_, err = userInfo(map[string]any{"sub": "other-subject"}, map[string]any{"sub": testSubject, "email": testEmail})
+	assert.ErrorContains(t, err, "userinfo subject mismatch")
+
+	_, err = userInfo(map[string]any{"sub": testSubject}, map[string]any{"sub": testSubject, "email": testEmail, "email_verified": false})
+	assert.ErrorContains(t, err, "the email is not verified")
+	// The claim of the userinfo response is used if the ID token misses it.
+	_, err = userInfo(map[string]any{"sub": testSubject, "email_verified": "false"}, map[string]any{"sub": testSubject, "email": testEmail})
+	assert.ErrorContains(t, err, "the email is not verified")
+	_, err = userInfo(map[string]any{"sub": testSubject}, map[string]any{"sub": testSubject, "email": testEmail, "email_verified": true})
+	assert.NoError(t, err)
+	// The missing claim is only accepted if the provider is configured so.
+	_, err = userInfo(map[string]any{"sub": testSubject}, map[string]any{"sub": testSubject, "email": testEmail})
+	assert.ErrorContains(t, err, "the email is not verified")
+	oidc.config.AllowMissingEmailVerified = true
+	_, err = userInfo(map[string]any{"sub": testSubject}, map[string]any{"sub": testSubject, "email": testEmail})
+	assert.NoError(t, err)
+	_, err = userInfo(map[string]any{"sub": testSubject}, map[string]any{"sub": testSubject, "email": testEmail, "email_verified": false})
+	assert.ErrorContains(t, err, "the email is not verified")
+}
@@ -0,0 +1,196 @@
+// Package saml is the plugin for SAML 2.0 Identity Provider, where Slash is the service provider.
+package saml
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/plugin/idp"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+const (
+	// requestTimeout is the timeout of the requests fetching the IdP metadata.
+	requestTimeout = 10 * time.Second
+	// metadataCacheTTL is how long the fetched IdP metadata is used before it is fetched again.
+	metadataCacheTTL = 10 * time.Minute
+)
+
+var (
+	client = &http.Client{Timeout: requestTimeout}
+	// metadataCache is the cache of the fetched IdP metadata, keyed by the metadata url of the IdPs.
+	metadataCache sync.Map
+)
+
+type cachedMetadata struct {
+	metadata   *saml.EntityDescriptor
+	expireTime time.Time
+}
+
+// IdentityProvider represents a SAML 2.0 Identity Provider.
+type IdentityProvider struct {
+	config *storepb.IdentityProviderConfig_SAMLConfig
+	sp     *saml.ServiceProvider
+}
+
+// NewIdentityProvider initializes a new SAML Identity Provider with the given configuration.
+// The metadata and ACS urls are the endpoints of the service provider, and the IdP metadata is
+// fetched if only its url is configured.
+func NewIdentityProvider(ctx context.Context, config *storepb.IdentityProviderConfig_SAMLConfig, metadataURL, acsURL string) (*IdentityProvider, error) {
+	if config.IdpMetadataXml == "" && config.IdpMetadataUrl == "" {
+		return nil, errors.New(`one of the fields "idpMetadataXml" and "idpMetadataUrl" is required`)
+	}
+
+	var idpMetadata *saml.EntityDescriptor
+	if config.IdpMetadataXml != "" {
+		metadata, err := samlsp.ParseMetadata([]byte(config.IdpMetadataXml))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse idp metadata")
+		}
+		idpMetadata = metadata
+	} else {
+		metadata, err := fetchMetadata(ctx, config.IdpMetadataUrl)
+		if err != nil {
+			return nil, err
+		}
+		idpMetadata = metadata
+	}
+
+	spMetadataURL, err := url.Parse(metadataURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid metadata url")
+	}
+	spACSURL, err := url.Parse(acsURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid acs url")
+	}
+	return &IdentityProvider{
+		config: config,
+		sp: &saml.ServiceProvider{
+			EntityID:          config.EntityId,
+			MetadataURL:       *spMetadataURL,
+			AcsURL:            *spACSURL,
+			IDPMetadata:       idpMetadata,
+			AuthnNameIDFormat: saml.UnspecifiedNameIDFormat,
+		},
+	}, nil
+}
+
+// fetchMetadata returns the IdP metadata of the url, which is cached for metadataCacheTTL.
+func fetchMetadata(ctx context.Context, metadataURL string) (*saml.EntityDescriptor, error) {
+	if value, ok := metadataCache.Load(metadataURL); ok {
+		cached := value.(*cachedMetadata)
+		if time.Now().Before(cached.expireTime) {
+			return cached.metadata, nil
+		}
+	}
+
+	u, err := url.Parse(metadataURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid idp metadata url")
+	}
+	metadata, err := samlsp.FetchMetadata(ctx, client, *u)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch idp metadata")
+	}
+	metadataCache.Store(metadataURL, &cachedMetadata{
+		metadata:   metadata,
+		expireTime: time.Now().Add(metadataCacheTTL),
+	})
+	return metadata, nil
+}
+
+// Metadata returns the metadata of the service provider to register at the IdP.
+func (p *IdentityProvider) Metadata() *saml.EntityDescriptor {
+	return p.sp.Metadata()
+}
+
+// AuthnRequestURL returns the url to redirect the user to with an authentication request, and the id of
+// the request which the response must be in response to.
+func (p *IdentityProvider) AuthnRequestURL(relayState string) (string, string, error) {
+	bindingLocation := p.sp.GetSSOBindingLocation(saml.HTTPRedirectBinding)
+	if bindingLocation == "" {
+		return "", "", errors.New("the idp does not support the HTTP-Redirect binding")
+	}
+	request, err := p.sp.MakeAuthenticationRequest(bindingLocation, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to make authentication request")
+	}
+	redirectURL, err := request.Redirect(relayState, p.sp)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to make redirect url")
+	}
+	return redirectURL.String(), request.ID, nil
+}
+
+// UserInfo verifies the base64 encoded SAML response posted to the ACS endpoint, which must be in
+// response to the given request, and returns the user information mapped from its assertion.
+func (p *IdentityProvider) UserInfo(samlResponse, requestID string) (*idp.IdentityProviderUserInfo, error) {
+	rawResponse, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode saml response")
+	}
+	assertion, err := p.sp.ParseXMLResponse(rawResponse, []string{requestID}, p.sp.AcsURL)
+	if err != nil {
+		var invalidResponseErr *saml.InvalidResponseError
+		if errors.As(err, &invalidResponseErr) {
+			err = invalidResponseErr.PrivateErr
+		}
+		return nil, errors.Wrap(err, "invalid saml response")
+	}
+
+	attributes := map[string][]string{}
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			values := []string{}
+			for _, value := range attribute.Values {
+				if value.Value != "" {
+					values = append(values, value.Value)
+				}
+			}
+			if len(values) == 0 {
+				continue
+			}
+			attributes[attribute.Name] = values
+			if attribute.FriendlyName != "" {
+				attributes[attribute.FriendlyName] = values
+			}
+		}
+	}
+	firstValue := func(name string) string {
+		if values := attributes[name]; len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+
+	userInfo := &idp.IdentityProviderUserInfo{}
+	fieldMapping := p.config.GetFieldMapping()
+	// The NameID is the identifier unless an attribute is mapped.
+	if fieldMapping.GetIdentifier() != "" {
+		userInfo.Identifier = firstValue(fieldMapping.GetIdentifier())
+	} else if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		userInfo.Identifier = assertion.Subject.NameID.Value
+	}
+	if userInfo.Identifier == "" {
+		return nil, errors.Errorf("the identifier %q is not found in assertion or has empty value", fieldMapping.GetIdentifier())
+	}
+	if fieldMapping.GetDisplayName() != "" {
+		userInfo.DisplayName = firstValue(fieldMapping.GetDisplayName())
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if fieldMapping.GetGroups() != "" {
+		userInfo.Groups = attributes[fieldMapping.GetGroups()]
+	}
+	return userInfo, nil
+}
@@ -0,0 +1,177 @@
+package saml
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/xml"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/plugin/idp"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+const (
+	testMetadataURL = "https://slash.example.com/api/v1/sso/saml/test/metadata"
+	testACSURL      = "https://slash.example.com/api/v1/sso/saml/test/acs"
+	testName        = "John Doe"
+	testEmail       = "john.doe@example.com"
+)
+
+// serviceProviders is the ServiceProviderProvider of the mock IdP, which knows a single service provider.
+type serviceProviders struct {
+	metadata *saml.EntityDescriptor
+}
+
+func (s *serviceProviders) GetServiceProvider(_ *http.Request, _ string) (*saml.EntityDescriptor, error) {
+	return s.metadata, nil
+}
+
+// newMockIdentityProvider returns a local stand-in of a SAML IdP signing with a self-signed certificate.
+func newMockIdentityProvider(t *testing.T) *saml.IdentityProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	metadataURL, err := url.Parse("https://idp.example.com/metadata")
+	require.NoError(t, err)
+	ssoURL, err := url.Parse("https://idp.example.com/sso")
+	require.NoError(t, err)
+	return &saml.IdentityProvider{
+		Key:                     key,
+		Certificate:             certificate,
+		MetadataURL:             *metadataURL,
+		SSOURL:                  *ssoURL,
+		ServiceProviderProvider: &serviceProviders{},
+	}
+}
+
+// respond mimics the user signing in at the IdP with the authentication request url, and returns the
+// base64 encoded SAML response posted to the ACS endpoint.
+func respond(t *testing.T, identityProvider *saml.IdentityProvider, authnRequestURL string) string {
+	request, err := saml.NewIdpAuthnRequest(identityProvider, httptest.NewRequest(http.MethodGet, authnRequestURL, nil))
+	require.NoError(t, err)
+	require.NoError(t, request.Validate())
+	require.NoError(t, saml.DefaultAssertionMaker{}.MakeAssertion(request, &saml.Session{
+		ID:             "session-id",
+		CreateTime:     time.Now(),
+		ExpireTime:     time.Now().Add(time.Hour),
+		NameID:         testEmail,
+		UserEmail:      testEmail,
+		UserCommonName: testName,
+	}))
+	form, err := request.PostBinding()
+	require.NoError(t, err)
+	require.Equal(t, testACSURL, form.URL)
+	return form.SAMLResponse
+}
+
+func TestNewIdentityProvider(t *testing.T) {
+	_, err := NewIdentityProvider(context.Background(), &storepb.IdentityProviderConfig_SAMLConfig{}, testMetadataURL, testACSURL)
+	assert.ErrorContains(t, err, `one of the fields "idpMetadataXml" and "idpMetadataUrl" is required`)
+}
+
+func TestFetchMetadata(t *testing.T) {
+	idpMetadata, err := xml.Marshal(newMockIdentityProvider(t).Metadata())
+	require.NoError(t, err)
+	var requestCount atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requestCount.Add(1)
+		_, _ = w.Write(idpMetadata)
+	}))
+	defer server.Close()
+
+	config := &storepb.IdentityProviderConfig_SAMLConfig{IdpMetadataUrl: server.URL}
+	for i := 0; i < 2; i++ {
+		_, err := NewIdentityProvider(context.Background(), config, testMetadataURL, testACSURL)
+		require.NoError(t, err)
+	}
+	// The metadata is fetched once, and again once expired.
+	assert.Equal(t, int32(1), requestCount.Load())
+	value, ok := metadataCache.Load(server.URL)
+	require.True(t, ok)
+	value.(*cachedMetadata).expireTime = time.Now()
+	_, err = NewIdentityProvider(context.Background(), config, testMetadataURL, testACSURL)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), requestCount.Load())
+}
+
+func TestIdentityProvider(t *testing.T) {
+	mockIdentityProvider := newMockIdentityProvider(t)
+	idpMetadata, err := xml.Marshal(mockIdentityProvider.Metadata())
+	require.NoError(t, err)
+
+	newIdentityProvider := func(fieldMapping *storepb.IdentityProviderConfig_FieldMapping) *IdentityProvider {
+		identityProvider, err := NewIdentityProvider(context.Background(), &storepb.IdentityProviderConfig_SAMLConfig{
+			IdpMetadataXml: string(idpMetadata),
+			FieldMapping:   fieldMapping,
+		}, testMetadataURL, testACSURL)
+		require.NoError(t, err)
+		mockIdentityProvider.ServiceProviderProvider = &serviceProviders{metadata: identityProvider.Metadata()}
+		return identityProvider
+	}
+
+	t.Run("name id", func(t *testing.T) {
+		identityProvider := newIdentityProvider(nil)
+		authnRequestURL, requestID, err := identityProvider.AuthnRequestURL("test-state")
+		require.NoError(t, err)
+		userInfo, err := identityProvider.UserInfo(respond(t, mockIdentityProvider, authnRequestURL), requestID)
+		require.NoError(t, err)
+		assert.Equal(t, &idp.IdentityProviderUserInfo{
+			Identifier:  testEmail,
+			DisplayName: testEmail,
+		}, userInfo)
+	})
+	t.Run("attribute mapping", func(t *testing.T) {
+		identityProvider := newIdentityProvider(&storepb.IdentityProviderConfig_FieldMapping{
+			Identifier:  "mail",
+			DisplayName: "cn",
+		})
+		authnRequestURL, requestID, err := identityProvider.AuthnRequestURL("test-state")
+		require.NoError(t, err)
+		userInfo, err := identityProvider.UserInfo(respond(t, mockIdentityProvider, authnRequestURL), requestID)
+		require.NoError(t, err)
+		assert.Equal(t, &idp.IdentityProviderUserInfo{
+			Identifier:  testEmail,
+			DisplayName: testName,
+		}, userInfo)
+	})
+	t.Run("unsolicited response", func(t *testing.T) {
+		identityProvider := newIdentityProvider(nil)
+		authnRequestURL, _, err := identityProvider.AuthnRequestURL("test-state")
+		require.NoError(t, err)
+		_, err = identityProvider.UserInfo(respond(t, mockIdentityProvider, authnRequestURL), "id-other-request")
+		assert.ErrorContains(t, err, "invalid saml response")
+	})
+	t.Run("untrusted signature", func(t *testing.T) {
+		identityProvider := newIdentityProvider(nil)
+		authnRequestURL, requestID, err := identityProvider.AuthnRequestURL("test-state")
+		require.NoError(t, err)
+		// The response is signed by another IdP with the same metadata urls.
+		otherIdentityProvider := newMockIdentityProvider(t)
+		otherIdentityProvider.ServiceProviderProvider = mockIdentityProvider.ServiceProviderProvider
+		_, err = identityProvider.UserInfo(respond(t, otherIdentityProvider, authnRequestURL), requestID)
+		assert.ErrorContains(t, err, "invalid saml response")
+	})
+}
@@ -60,9 +60,11 @@ func (*GetAuthStatusRequest) Descriptor() ([]byte, []int) {
 }
 
 type SignInRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Email    string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// The id of the LDAP provider to check the credentials against, the local password is checked if empty.
+	IdpId         string `protobuf:"bytes,3,opt,name=idp_id,json=idpId,proto3" json:"idp_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -111,6 +113,13 @@ func (x *SignInRequest) GetPassword() string {
 	return ""
 }
 
+func (x *SignInRequest) GetIdpId() string {
+	if x != nil {
+		return x.IdpId
+	}
+	return ""
+}
+
 type SignUpRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
@@ -178,7 +187,9 @@ type SignInWithSSORequest struct {
 	// The code to sign in with.
 	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	// The redirect URI.
-	RedirectUri   string `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	RedirectUri string `protobuf:"bytes,3,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	// The state returned by AuthorizeSSO, required by the OIDC providers.
+	State         string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -234,6 +245,121 @@ func (x *SignInWithSSORequest) GetRedirectUri() string {
 	return ""
 }
 
+func (x *SignInWithSSORequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type AuthorizeSSORequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the SSO provider.
+	IdpId string `protobuf:"bytes,1,opt,name=idp_id,json=idpId,proto3" json:"idp_id,omitempty"`
+	// The redirect URI, which must be on the instance URL, or on the requested host if the instance URL is not set.
+	RedirectUri   string `protobuf:"bytes,2,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeSSORequest) Reset() {
+	*x = AuthorizeSSORequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeSSORequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeSSORequest) ProtoMessage() {}
+
+func (x *AuthorizeSSORequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeSSORequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeSSORequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AuthorizeSSORequest) GetIdpId() string {
+	if x != nil {
+		return x.IdpId
+	}
+	return ""
+}
+
+func (x *AuthorizeSSORequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+type AuthorizeSSOResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The authorization url of the SSO provider to redirect the user to.
+	AuthorizationUrl string `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	// The state to pass back along with the code to SignInWithSSO.
+	State         string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizeSSOResponse) Reset() {
+	*x = AuthorizeSSOResponse{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeSSOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeSSOResponse) ProtoMessage() {}
+
+func (x *AuthorizeSSOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeSSOResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizeSSOResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AuthorizeSSOResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+func (x *AuthorizeSSOResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
 type SignOutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -242,7 +368,7 @@ type SignOutRequest struct {
 
 func (x *SignOutRequest) Reset() {
 	*x = SignOutRequest{}
-	mi := &file_api_v1_auth_service_proto_msgTypes[4]
+	mi := &file_api_v1_auth_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -254,7 +380,7 @@ func (x *SignOutRequest) String() string {
 func (*SignOutRequest) ProtoMessage() {}
 
 func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_service_proto_msgTypes[4]
+	mi := &file_api_v1_auth_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,7 +393,7 @@ func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignOutRequest.ProtoReflect.Descriptor instead.
 func (*SignOutRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{6}
 }
 
 var File_api_v1_auth_service_proto protoreflect.FileDescriptor
@@ -275,23 +401,32 @@ var File_api_v1_auth_service_proto protoreflect.FileDescriptor
 const file_api_v1_auth_service_proto_rawDesc = "" +
 	"\n" +
 	"\x19api/v1/auth_service.proto\x12\fslash.api.v1\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x16\n" +
-	"\x14GetAuthStatusRequest\"A\n" +
+	"\x14GetAuthStatusRequest\"X\n" +
 	"\rSignInRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"]\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x15\n" +
+	"\x06idp_id\x18\x03 \x01(\tR\x05idpId\"]\n" +
 	"\rSignUpRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"d\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"z\n" +
 	"\x14SignInWithSSORequest\x12\x15\n" +
 	"\x06idp_id\x18\x01 \x01(\tR\x05idpId\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12!\n" +
-	"\fredirect_uri\x18\x03 \x01(\tR\vredirectUri\"\x10\n" +
-	"\x0eSignOutRequest2\xec\x03\n" +
+	"\fredirect_uri\x18\x03 \x01(\tR\vredirectUri\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\"O\n" +
+	"\x13AuthorizeSSORequest\x12\x15\n" +
+	"\x06idp_id\x18\x01 \x01(\tR\x05idpId\x12!\n" +
+	"\fredirect_uri\x18\x02 \x01(\tR\vredirectUri\"Y\n" +
+	"\x14AuthorizeSSOResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"\x10\n" +
+	"\x0eSignOutRequest2\xf2\x04\n" +
 	"\vAuthService\x12d\n" +
 	"\rGetAuthStatus\x12\".slash.api.v1.GetAuthStatusRequest\x1a\x12.slash.api.v1.User\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x13/api/v1/auth/status\x12V\n" +
 	"\x06SignIn\x12\x1b.slash.api.v1.SignInRequest\x1a\x12.slash.api.v1.User\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x13/api/v1/auth/signin\x12h\n" +
-	"\rSignInWithSSO\x12\".slash.api.v1.SignInWithSSORequest\x1a\x12.slash.api.v1.User\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x17/api/v1/auth/signin/sso\x12V\n" +
+	"\rSignInWithSSO\x12\".slash.api.v1.SignInWithSSORequest\x1a\x12.slash.api.v1.User\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x17/api/v1/auth/signin/sso\x12\x83\x01\n" +
+	"\fAuthorizeSSO\x12!.slash.api.v1.AuthorizeSSORequest\x1a\".slash.api.v1.AuthorizeSSOResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/auth/signin/sso:authorize\x12V\n" +
 	"\x06SignUp\x12\x1b.slash.api.v1.SignUpRequest\x1a\x12.slash.api.v1.User\"\x1b\x82\xd3\xe4\x93\x02\x15\"\x13/api/v1/auth/signup\x12]\n" +
 	"\aSignOut\x12\x1c.slash.api.v1.SignOutRequest\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x14/api/v1/auth/signoutB\xae\x01\n" +
 	"\x10com.slash.api.v1B\x10AuthServiceProtoP\x01Z6github.com/yourselfhosted/slash/proto/gen/api/v1;apiv1\xa2\x02\x03SAX\xaa\x02\fSlash.Api.V1\xca\x02\fSlash\\Api\\V1\xe2\x02\x18Slash\\Api\\V1\\GPBMetadata\xea\x02\x0eSlash::Api::V1b\x06proto3"
@@ -308,29 +443,33 @@ func file_api_v1_auth_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_auth_service_proto_rawDescData
 }
 
-var file_api_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_api_v1_auth_service_proto_goTypes = []any{
 	(*GetAuthStatusRequest)(nil), // 0: slash.api.v1.GetAuthStatusRequest
 	(*SignInRequest)(nil),        // 1: slash.api.v1.SignInRequest
 	(*SignUpRequest)(nil),        // 2: slash.api.v1.SignUpRequest
 	(*SignInWithSSORequest)(nil), // 3: slash.api.v1.SignInWithSSORequest
-	(*SignOutRequest)(nil),       // 4: slash.api.v1.SignOutRequest
-	(*User)(nil),                 // 5: slash.api.v1.User
-	(*emptypb.Empty)(nil),        // 6: google.protobuf.Empty
+	(*AuthorizeSSORequest)(nil),  // 4: slash.api.v1.AuthorizeSSORequest
+	(*AuthorizeSSOResponse)(nil), // 5: slash.api.v1.AuthorizeSSOResponse
+	(*SignOutRequest)(nil),       // 6: slash.api.v1.SignOutRequest
+	(*User)(nil),                 // 7: slash.api.v1.User
+	(*emptypb.Empty)(nil),        // 8: google.protobuf.Empty
 }
 var file_api_v1_auth_service_proto_depIdxs = []int32{
 	0, // 0: slash.api.v1.AuthService.GetAuthStatus:input_type -> slash.api.v1.GetAuthStatusRequest
 	1, // 1: slash.api.v1.AuthService.SignIn:input_type -> slash.api.v1.SignInRequest
 	3, // 2: slash.api.v1.AuthService.SignInWithSSO:input_type -> slash.api.v1.SignInWithSSORequest
-	2, // 3: slash.api.v1.AuthService.SignUp:input_type -> slash.api.v1.SignUpRequest
-	4, // 4: slash.api.v1.AuthService.SignOut:input_type -> slash.api.v1.SignOutRequest
-	5, // 5: slash.api.v1.AuthService.GetAuthStatus:output_type -> slash.api.v1.User
-	5, // 6: slash.api.v1.AuthService.SignIn:output_type -> slash.api.v1.User
-	5, // 7: slash.api.v1.AuthService.SignInWithSSO:output_type -> slash.api.v1.User
-	5, // 8: slash.api.v1.AuthService.SignUp:output_type -> slash.api.v1.User
-	6, // 9: slash.api.v1.AuthService.SignOut:output_type -> google.protobuf.Empty
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
+	4, // 3: slash.api.v1.AuthService.AuthorizeSSO:input_type -> slash.api.v1.AuthorizeSSORequest
+	2, // 4: slash.api.v1.AuthService.SignUp:input_type -> slash.api.v1.SignUpRequest
+	6, // 5: slash.api.v1.AuthService.SignOut:input_type -> slash.api.v1.SignOutRequest
+	7, // 6: slash.api.v1.AuthService.GetAuthStatus:output_type -> slash.api.v1.User
+	7, // 7: slash.api.v1.AuthService.SignIn:output_type -> slash.api.v1.User
+	7, // 8: slash.api.v1.AuthService.SignInWithSSO:output_type -> slash.api.v1.User
+	5, // 9: slash.api.v1.AuthService.AuthorizeSSO:output_type -> slash.api.v1.AuthorizeSSOResponse
+	7, // 10: slash.api.v1.AuthService.SignUp:output_type -> slash.api.v1.User
+	8, // 11: slash.api.v1.AuthService.SignOut:output_type -> google.protobuf.Empty
+	6, // [6:12] is the sub-list for method output_type
+	0, // [0:6] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -348,7 +487,7 @@ func file_api_v1_auth_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_auth_service_proto_rawDesc), len(file_api_v1_auth_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,7 @@ const (
 	AuthService_GetAuthStatus_FullMethodName = "/slash.api.v1.AuthService/GetAuthStatus"
 	AuthService_SignIn_FullMethodName        = "/slash.api.v1.AuthService/SignIn"
 	AuthService_SignInWithSSO_FullMethodName = "/slash.api.v1.AuthService/SignInWithSSO"
+	AuthService_AuthorizeSSO_FullMethodName  = "/slash.api.v1.AuthService/AuthorizeSSO"
 	AuthService_SignUp_FullMethodName        = "/slash.api.v1.AuthService/SignUp"
 	AuthService_SignOut_FullMethodName       = "/slash.api.v1.AuthService/SignOut"
 )
@@ -37,6 +38,8 @@ type AuthServiceClient interface {
 	SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*User, error)
 	// SignInWithSSO signs in the user with the given SSO code.
 	SignInWithSSO(ctx context.Context, in *SignInWithSSORequest, opts ...grpc.CallOption) (*User, error)
+	// AuthorizeSSO starts the sign in with the given SSO provider, and returns the url to redirect the user to.
+	AuthorizeSSO(ctx context.Context, in *AuthorizeSSORequest, opts ...grpc.CallOption) (*AuthorizeSSOResponse, error)
 	// SignUp signs up the user with the given username and password.
 	SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*User, error)
 	// SignOut signs out the user.
@@ -81,6 +84,16 @@ func (c *authServiceClient) SignInWithSSO(ctx context.Context, in *SignInWithSSO
 	return out, nil
 }
 
+func (c *authServiceClient) AuthorizeSSO(ctx context.Context, in *AuthorizeSSORequest, opts ...grpc.CallOption) (*AuthorizeSSOResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizeSSOResponse)
+	err := c.cc.Invoke(ctx, AuthService_AuthorizeSSO_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*User, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(User)
@@ -111,6 +124,8 @@ type AuthServiceServer interface {
 	SignIn(context.Context, *SignInRequest) (*User, error)
 	// SignInWithSSO signs in the user with the given SSO code.
 	SignInWithSSO(context.Context, *SignInWithSSORequest) (*User, error)
+	// AuthorizeSSO starts the sign in with the given SSO provider, and returns the url to redirect the user to.
+	AuthorizeSSO(context.Context, *AuthorizeSSORequest) (*AuthorizeSSOResponse, error)
 	// SignUp signs up the user with the given username and password.
 	SignUp(context.Context, *SignUpRequest) (*User, error)
 	// SignOut signs out the user.
@@ -134,6 +149,9 @@ func (UnimplementedAuthServiceServer) SignIn(context.Context, *SignInRequest) (*
 func (UnimplementedAuthServiceServer) SignInWithSSO(context.Context, *SignInWithSSORequest) (*User, error) {
 	return nil, status.Error(codes.Unimplemented, "method SignInWithSSO not implemented")
 }
+func (UnimplementedAuthServiceServer) AuthorizeSSO(context.Context, *AuthorizeSSORequest) (*AuthorizeSSOResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AuthorizeSSO not implemented")
+}
 func (UnimplementedAuthServiceServer) SignUp(context.Context, *SignUpRequest) (*User, error) {
 	return nil, status.Error(codes.Unimplemented, "method SignUp not implemented")
 }
@@ -215,6 +233,24 @@ func _AuthService_SignInWithSSO_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_AuthorizeSSO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeSSORequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).AuthorizeSSO(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_AuthorizeSSO_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).AuthorizeSSO(ctx, req.(*AuthorizeSSORequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_SignUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignUpRequest)
 	if err := dec(in); err != nil {
@@ -270,6 +306,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignInWithSSO",
 			Handler:    _AuthService_SignInWithSSO_Handler,
 		},
+		{
+			MethodName: "AuthorizeSSO",
+			Handler:    _AuthService_AuthorizeSSO_Handler,
+		},
 		{
 			MethodName: "SignUp",
 			Handler:    _AuthService_SignUp_Handler,
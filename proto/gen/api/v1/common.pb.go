@@ -76,6 +76,7 @@ const (
 	Visibility_VISIBILITY_UNSPECIFIED Visibility = 0
 	Visibility_WORKSPACE              Visibility = 1
 	Visibility_PUBLIC                 Visibility = 2
+	Visibility_PRIVATE                Visibility = 3
 )
 
 // Enum value maps for Visibility.
@@ -84,11 +85,13 @@ var (
 		0: "VISIBILITY_UNSPECIFIED",
 		1: "WORKSPACE",
 		2: "PUBLIC",
+		3: "PRIVATE",
 	}
 	Visibility_value = map[string]int32{
 		"VISIBILITY_UNSPECIFIED": 0,
 		"WORKSPACE":              1,
 		"PUBLIC":                 2,
+		"PRIVATE":                3,
 	}
 )
 
@@ -119,22 +122,146 @@ func (Visibility) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_common_proto_rawDescGZIP(), []int{1}
 }
 
+type AnalyticsGranularity int32
+
+const (
+	AnalyticsGranularity_ANALYTICS_GRANULARITY_UNSPECIFIED AnalyticsGranularity = 0
+	AnalyticsGranularity_HOUR                              AnalyticsGranularity = 1
+	AnalyticsGranularity_DAY                               AnalyticsGranularity = 2
+	AnalyticsGranularity_WEEK                              AnalyticsGranularity = 3
+)
+
+// Enum value maps for AnalyticsGranularity.
+var (
+	AnalyticsGranularity_name = map[int32]string{
+		0: "ANALYTICS_GRANULARITY_UNSPECIFIED",
+		1: "HOUR",
+		2: "DAY",
+		3: "WEEK",
+	}
+	AnalyticsGranularity_value = map[string]int32{
+		"ANALYTICS_GRANULARITY_UNSPECIFIED": 0,
+		"HOUR":                              1,
+		"DAY":                               2,
+		"WEEK":                              3,
+	}
+)
+
+func (x AnalyticsGranularity) Enum() *AnalyticsGranularity {
+	p := new(AnalyticsGranularity)
+	*p = x
+	return p
+}
+
+func (x AnalyticsGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnalyticsGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (AnalyticsGranularity) Type() protoreflect.EnumType {
+	return &file_api_v1_common_proto_enumTypes[2]
+}
+
+func (x AnalyticsGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnalyticsGranularity.Descriptor instead.
+func (AnalyticsGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
+type PageToken struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Limit  int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The hash of the query params the token is issued for, e.g. the filter and the order by.
+	QueryHash     string `protobuf:"bytes,3,opt,name=query_hash,json=queryHash,proto3" json:"query_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageToken) Reset() {
+	*x = PageToken{}
+	mi := &file_api_v1_common_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageToken) ProtoMessage() {}
+
+func (x *PageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_common_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageToken.ProtoReflect.Descriptor instead.
+func (*PageToken) Descriptor() ([]byte, []int) {
+	return file_api_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PageToken) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PageToken) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PageToken) GetQueryHash() string {
+	if x != nil {
+		return x.QueryHash
+	}
+	return ""
+}
+
 var File_api_v1_common_proto protoreflect.FileDescriptor
 
 const file_api_v1_common_proto_rawDesc = "" +
 	"\n" +
-	"\x13api/v1/common.proto\x12\fslash.api.v1*8\n" +
+	"\x13api/v1/common.proto\x12\fslash.api.v1\"X\n" +
+	"\tPageToken\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1d\n" +
+	"\n" +
+	"query_hash\x18\x03 \x01(\tR\tqueryHash*8\n" +
 	"\x05State\x12\x15\n" +
 	"\x11STATE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06ACTIVE\x10\x01\x12\f\n" +
-	"\bINACTIVE\x10\x02*C\n" +
+	"\bINACTIVE\x10\x02*P\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tWORKSPACE\x10\x01\x12\n" +
 	"\n" +
-	"\x06PUBLIC\x10\x02B\xa9\x01\n" +
+	"\x06PUBLIC\x10\x02\x12\v\n" +
+	"\aPRIVATE\x10\x03*Z\n" +
+	"\x14AnalyticsGranularity\x12%\n" +
+	"!ANALYTICS_GRANULARITY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04HOUR\x10\x01\x12\a\n" +
+	"\x03DAY\x10\x02\x12\b\n" +
+	"\x04WEEK\x10\x03B\xa9\x01\n" +
 	"\x10com.slash.api.v1B\vCommonProtoP\x01Z6github.com/yourselfhosted/slash/proto/gen/api/v1;apiv1\xa2\x02\x03SAX\xaa\x02\fSlash.Api.V1\xca\x02\fSlash\\Api\\V1\xe2\x02\x18Slash\\Api\\V1\\GPBMetadata\xea\x02\x0eSlash::Api::V1b\x06proto3"
 
 var (
@@ -149,10 +276,13 @@ func file_api_v1_common_proto_rawDescGZIP() []byte {
 	return file_api_v1_common_proto_rawDescData
 }
 
-var file_api_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_api_v1_common_proto_goTypes = []any{
-	(State)(0),      // 0: slash.api.v1.State
-	(Visibility)(0), // 1: slash.api.v1.Visibility
+	(State)(0),                // 0: slash.api.v1.State
+	(Visibility)(0),           // 1: slash.api.v1.Visibility
+	(AnalyticsGranularity)(0), // 2: slash.api.v1.AnalyticsGranularity
+	(*PageToken)(nil),         // 3: slash.api.v1.PageToken
 }
 var file_api_v1_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -172,14 +302,15 @@ func file_api_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_common_proto_rawDesc), len(file_api_v1_common_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   0,
+			NumEnums:      3,
+			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_api_v1_common_proto_goTypes,
 		DependencyIndexes: file_api_v1_common_proto_depIdxs,
 		EnumInfos:         file_api_v1_common_proto_enumTypes,
+		MessageInfos:      file_api_v1_common_proto_msgTypes,
 	}.Build()
 	File_api_v1_common_proto = out.File
 	file_api_v1_common_proto_goTypes = nil
@@ -0,0 +1,600 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: api/v1/group_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Group_Role int32
+
+const (
+	Group_ROLE_UNSPECIFIED Group_Role = 0
+	// The editors can edit the group, and the shortcuts and collections owned by it.
+	Group_EDITOR Group_Role = 1
+	// The viewers can see the private shortcuts and collections owned by the group.
+	Group_VIEWER Group_Role = 2
+)
+
+// Enum value maps for Group_Role.
+var (
+	Group_Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "EDITOR",
+		2: "VIEWER",
+	}
+	Group_Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"EDITOR":           1,
+		"VIEWER":           2,
+	}
+)
+
+func (x Group_Role) Enum() *Group_Role {
+	p := new(Group_Role)
+	*p = x
+	return p
+}
+
+func (x Group_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Group_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_group_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Group_Role) Type() protoreflect.EnumType {
+	return &file_api_v1_group_service_proto_enumTypes[0]
+}
+
+func (x Group_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Group_Role.Descriptor instead.
+func (Group_Role) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_group_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Group struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	UpdatedTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Members       []*Group_Member        `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_api_v1_group_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_group_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_api_v1_group_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Group) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Group) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *Group) GetUpdatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTime
+	}
+	return nil
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Group) GetMembers() []*Group_Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ListGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsRequest) Reset() {
+	*x = ListGroupsRequest{}
+	mi := &file_api_v1_group_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsRequest) ProtoMessage() {}
+
+func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_group_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_group_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ListGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsResponse) Reset() {
+	*x = ListGroupsResponse{}
+	mi := &file_api_v1_group_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsResponse) ProtoMessage() {}
+
+func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_group_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_group_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListGroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	mi := &file_api_v1_group_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_group_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_group_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetGroupRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_api_v1_group_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_group_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_group_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateGroupRequest) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type UpdateGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Group *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	// The supported paths are "name", "description" and "members", which replaces all the members.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_api_v1_group_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_group_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_group_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateGroupRequest) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *UpdateGroupRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	mi := &file_api_v1_group_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_group_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_group_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteGroupRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Group_Member struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          Group_Role             `protobuf:"varint,2,opt,name=role,proto3,enum=slash.api.v1.Group_Role" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group_Member) Reset() {
+	*x = Group_Member{}
+	mi := &file_api_v1_group_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group_Member) ProtoMessage() {}
+
+func (x *Group_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_group_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group_Member.ProtoReflect.Descriptor instead.
+func (*Group_Member) Descriptor() ([]byte, []int) {
+	return file_api_v1_group_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Group_Member) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Group_Member) GetRole() Group_Role {
+	if x != nil {
+		return x.Role
+	}
+	return Group_ROLE_UNSPECIFIED
+}
+
+var File_api_v1_group_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_group_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1aapi/v1/group_service.proto\x12\fslash.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x88\x03\n" +
+	"\x05Group\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12=\n" +
+	"\fcreated_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
+	"\fupdated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x124\n" +
+	"\amembers\x18\x06 \x03(\v2\x1a.slash.api.v1.Group.MemberR\amembers\x1aO\n" +
+	"\x06Member\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12,\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x18.slash.api.v1.Group.RoleR\x04role\"4\n" +
+	"\x04Role\x12\x14\n" +
+	"\x10ROLE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06EDITOR\x10\x01\x12\n" +
+	"\n" +
+	"\x06VIEWER\x10\x02\"\x13\n" +
+	"\x11ListGroupsRequest\"A\n" +
+	"\x12ListGroupsResponse\x12+\n" +
+	"\x06groups\x18\x01 \x03(\v2\x13.slash.api.v1.GroupR\x06groups\"!\n" +
+	"\x0fGetGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"?\n" +
+	"\x12CreateGroupRequest\x12)\n" +
+	"\x05group\x18\x01 \x01(\v2\x13.slash.api.v1.GroupR\x05group\"|\n" +
+	"\x12UpdateGroupRequest\x12)\n" +
+	"\x05group\x18\x01 \x01(\v2\x13.slash.api.v1.GroupR\x05group\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"$\n" +
+	"\x12DeleteGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id2\xae\x04\n" +
+	"\fGroupService\x12g\n" +
+	"\n" +
+	"ListGroups\x12\x1f.slash.api.v1.ListGroupsRequest\x1a .slash.api.v1.ListGroupsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/groups\x12`\n" +
+	"\bGetGroup\x12\x1d.slash.api.v1.GetGroupRequest\x1a\x13.slash.api.v1.Group\" \xdaA\x02id\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/groups/{id}\x12c\n" +
+	"\vCreateGroup\x12 .slash.api.v1.CreateGroupRequest\x1a\x13.slash.api.v1.Group\"\x1d\x82\xd3\xe4\x93\x02\x17:\x05group\"\x0e/api/v1/groups\x12\x82\x01\n" +
+	"\vUpdateGroup\x12 .slash.api.v1.UpdateGroupRequest\x1a\x13.slash.api.v1.Group\"<\xdaA\x11group,update_mask\x82\xd3\xe4\x93\x02\":\x05group\x1a\x19/api/v1/groups/{group.id}\x12i\n" +
+	"\vDeleteGroup\x12 .slash.api.v1.DeleteGroupRequest\x1a\x16.google.protobuf.Empty\" \xdaA\x02id\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/groups/{id}B\xaf\x01\n" +
+	"\x10com.slash.api.v1B\x11GroupServiceProtoP\x01Z6github.com/yourselfhosted/slash/proto/gen/api/v1;apiv1\xa2\x02\x03SAX\xaa\x02\fSlash.Api.V1\xca\x02\fSlash\\Api\\V1\xe2\x02\x18Slash\\Api\\V1\\GPBMetadata\xea\x02\x0eSlash::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_group_service_proto_rawDescOnce sync.Once
+	file_api_v1_group_service_proto_rawDescData []byte
+)
+
+func file_api_v1_group_service_proto_rawDescGZIP() []byte {
+	file_api_v1_group_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_group_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_group_service_proto_rawDesc), len(file_api_v1_group_service_proto_rawDesc)))
+	})
+	return file_api_v1_group_service_proto_rawDescData
+}
+
+var file_api_v1_group_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_v1_group_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_v1_group_service_proto_goTypes = []any{
+	(Group_Role)(0),               // 0: slash.api.v1.Group.Role
+	(*Group)(nil),                 // 1: slash.api.v1.Group
+	(*ListGroupsRequest)(nil),     // 2: slash.api.v1.ListGroupsRequest
+	(*ListGroupsResponse)(nil),    // 3: slash.api.v1.ListGroupsResponse
+	(*GetGroupRequest)(nil),       // 4: slash.api.v1.GetGroupRequest
+	(*CreateGroupRequest)(nil),    // 5: slash.api.v1.CreateGroupRequest
+	(*UpdateGroupRequest)(nil),    // 6: slash.api.v1.UpdateGroupRequest
+	(*DeleteGroupRequest)(nil),    // 7: slash.api.v1.DeleteGroupRequest
+	(*Group_Member)(nil),          // 8: slash.api.v1.Group.Member
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 10: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),         // 11: google.protobuf.Empty
+}
+var file_api_v1_group_service_proto_depIdxs = []int32{
+	9,  // 0: slash.api.v1.Group.created_time:type_name -> google.protobuf.Timestamp
+	9,  // 1: slash.api.v1.Group.updated_time:type_name -> google.protobuf.Timestamp
+	8,  // 2: slash.api.v1.Group.members:type_name -> slash.api.v1.Group.Member
+	1,  // 3: slash.api.v1.ListGroupsResponse.groups:type_name -> slash.api.v1.Group
+	1,  // 4: slash.api.v1.CreateGroupRequest.group:type_name -> slash.api.v1.Group
+	1,  // 5: slash.api.v1.UpdateGroupRequest.group:type_name -> slash.api.v1.Group
+	10, // 6: slash.api.v1.UpdateGroupRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 7: slash.api.v1.Group.Member.role:type_name -> slash.api.v1.Group.Role
+	2,  // 8: slash.api.v1.GroupService.ListGroups:input_type -> slash.api.v1.ListGroupsRequest
+	4,  // 9: slash.api.v1.GroupService.GetGroup:input_type -> slash.api.v1.GetGroupRequest
+	5,  // 10: slash.api.v1.GroupService.CreateGroup:input_type -> slash.api.v1.CreateGroupRequest
+	6,  // 11: slash.api.v1.GroupService.UpdateGroup:input_type -> slash.api.v1.UpdateGroupRequest
+	7,  // 12: slash.api.v1.GroupService.DeleteGroup:input_type -> slash.api.v1.DeleteGroupRequest
+	3,  // 13: slash.api.v1.GroupService.ListGroups:output_type -> slash.api.v1.ListGroupsResponse
+	1,  // 14: slash.api.v1.GroupService.GetGroup:output_type -> slash.api.v1.Group
+	1,  // 15: slash.api.v1.GroupService.CreateGroup:output_type -> slash.api.v1.Group
+	1,  // 16: slash.api.v1.GroupService.UpdateGroup:output_type -> slash.api.v1.Group
+	11, // 17: slash.api.v1.GroupService.DeleteGroup:output_type -> google.protobuf.Empty
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_group_service_proto_init() }
+func file_api_v1_group_service_proto_init() {
+	if File_api_v1_group_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_group_service_proto_rawDesc), len(file_api_v1_group_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_group_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_group_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_group_service_proto_enumTypes,
+		MessageInfos:      file_api_v1_group_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_group_service_proto = out.File
+	file_api_v1_group_service_proto_goTypes = nil
+	file_api_v1_group_service_proto_depIdxs = nil
+}
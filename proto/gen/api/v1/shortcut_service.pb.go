@@ -25,20 +25,194 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ImportShortcutsRequest_Format int32
+
+const (
+	ImportShortcutsRequest_FORMAT_UNSPECIFIED ImportShortcutsRequest_Format = 0
+	ImportShortcutsRequest_CSV                ImportShortcutsRequest_Format = 1
+	ImportShortcutsRequest_JSON               ImportShortcutsRequest_Format = 2
+	ImportShortcutsRequest_BOOKMARK_HTML      ImportShortcutsRequest_Format = 3
+)
+
+// Enum value maps for ImportShortcutsRequest_Format.
+var (
+	ImportShortcutsRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "CSV",
+		2: "JSON",
+		3: "BOOKMARK_HTML",
+	}
+	ImportShortcutsRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"CSV":                1,
+		"JSON":               2,
+		"BOOKMARK_HTML":      3,
+	}
+)
+
+func (x ImportShortcutsRequest_Format) Enum() *ImportShortcutsRequest_Format {
+	p := new(ImportShortcutsRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ImportShortcutsRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportShortcutsRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportShortcutsRequest_Format) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[0]
+}
+
+func (x ImportShortcutsRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportShortcutsRequest_Format.Descriptor instead.
+func (ImportShortcutsRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type ImportShortcutsRequest_ConflictPolicy int32
+
+const (
+	ImportShortcutsRequest_CONFLICT_POLICY_UNSPECIFIED ImportShortcutsRequest_ConflictPolicy = 0
+	// Keep the existing shortcut.
+	ImportShortcutsRequest_SKIP ImportShortcutsRequest_ConflictPolicy = 1
+	// Update the existing shortcut with the imported one.
+	ImportShortcutsRequest_OVERWRITE ImportShortcutsRequest_ConflictPolicy = 2
+	// Import the shortcut with a numeric suffix appended to its name.
+	ImportShortcutsRequest_RENAME ImportShortcutsRequest_ConflictPolicy = 3
+)
+
+// Enum value maps for ImportShortcutsRequest_ConflictPolicy.
+var (
+	ImportShortcutsRequest_ConflictPolicy_name = map[int32]string{
+		0: "CONFLICT_POLICY_UNSPECIFIED",
+		1: "SKIP",
+		2: "OVERWRITE",
+		3: "RENAME",
+	}
+	ImportShortcutsRequest_ConflictPolicy_value = map[string]int32{
+		"CONFLICT_POLICY_UNSPECIFIED": 0,
+		"SKIP":                        1,
+		"OVERWRITE":                   2,
+		"RENAME":                      3,
+	}
+)
+
+func (x ImportShortcutsRequest_ConflictPolicy) Enum() *ImportShortcutsRequest_ConflictPolicy {
+	p := new(ImportShortcutsRequest_ConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x ImportShortcutsRequest_ConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportShortcutsRequest_ConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportShortcutsRequest_ConflictPolicy) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[1]
+}
+
+func (x ImportShortcutsRequest_ConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportShortcutsRequest_ConflictPolicy.Descriptor instead.
+func (ImportShortcutsRequest_ConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{13, 1}
+}
+
+type ImportShortcutsResponse_Status int32
+
+const (
+	ImportShortcutsResponse_STATUS_UNSPECIFIED ImportShortcutsResponse_Status = 0
+	ImportShortcutsResponse_CREATED            ImportShortcutsResponse_Status = 1
+	ImportShortcutsResponse_UPDATED            ImportShortcutsResponse_Status = 2
+	ImportShortcutsResponse_SKIPPED            ImportShortcutsResponse_Status = 3
+	ImportShortcutsResponse_FAILED             ImportShortcutsResponse_Status = 4
+)
+
+// Enum value maps for ImportShortcutsResponse_Status.
+var (
+	ImportShortcutsResponse_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "SKIPPED",
+		4: "FAILED",
+	}
+	ImportShortcutsResponse_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"CREATED":            1,
+		"UPDATED":            2,
+		"SKIPPED":            3,
+		"FAILED":             4,
+	}
+)
+
+func (x ImportShortcutsResponse_Status) Enum() *ImportShortcutsResponse_Status {
+	p := new(ImportShortcutsResponse_Status)
+	*p = x
+	return p
+}
+
+func (x ImportShortcutsResponse_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportShortcutsResponse_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportShortcutsResponse_Status) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[2]
+}
+
+func (x ImportShortcutsResponse_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportShortcutsResponse_Status.Descriptor instead.
+func (ImportShortcutsResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{14, 0}
+}
+
 type Shortcut struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Id            int32                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatorId     int32                       `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	CreatedTime   *timestamppb.Timestamp      `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
-	UpdatedTime   *timestamppb.Timestamp      `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
-	Name          string                      `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Link          string                      `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
-	Title         string                      `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
-	Tags          []string                    `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
-	Description   string                      `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
-	Visibility    Visibility                  `protobuf:"varint,11,opt,name=visibility,proto3,enum=slash.api.v1.Visibility" json:"visibility,omitempty"`
-	ViewCount     int32                       `protobuf:"varint,12,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
-	OgMetadata    *Shortcut_OpenGraphMetadata `protobuf:"bytes,13,opt,name=og_metadata,json=ogMetadata,proto3" json:"og_metadata,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId   int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	UpdatedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
+	// The state of the shortcut, INACTIVE if it is archived in the trash.
+	State       State                       `protobuf:"varint,5,opt,name=state,proto3,enum=slash.api.v1.State" json:"state,omitempty"`
+	Name        string                      `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Link        string                      `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
+	Title       string                      `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	Tags        []string                    `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Description string                      `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility  Visibility                  `protobuf:"varint,11,opt,name=visibility,proto3,enum=slash.api.v1.Visibility" json:"visibility,omitempty"`
+	ViewCount   int32                       `protobuf:"varint,12,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	OgMetadata  *Shortcut_OpenGraphMetadata `protobuf:"bytes,13,opt,name=og_metadata,json=ogMetadata,proto3" json:"og_metadata,omitempty"`
+	// Whether the link is a template with placeholders, e.g. "https://jira.example.com/browse/{1}", which are
+	// expanded with the forwarded path segments and query params. The braces of the other links are literal.
+	LinkTemplate bool `protobuf:"varint,14,opt,name=link_template,json=linkTemplate,proto3" json:"link_template,omitempty"`
+	// Whether the shortcut is in the personal namespace of its creator, instead of the workspace one.
+	// The personal shortcuts are only resolved for their creator, before the workspace ones with the same name,
+	// or explicitly with the "me/" prefix, e.g. "me/standup".
+	Personal bool `protobuf:"varint,15,opt,name=personal,proto3" json:"personal,omitempty"`
+	// The id of the group owning the shortcut along with its creator, or 0 if there is none.
+	// The editors of the group can edit the shortcut, and all the members can see it if it's private.
+	GroupId       int32 `protobuf:"varint,16,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -101,6 +275,13 @@ func (x *Shortcut) GetUpdatedTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Shortcut) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
 func (x *Shortcut) GetName() string {
 	if x != nil {
 		return x.Name
@@ -157,8 +338,43 @@ func (x *Shortcut) GetOgMetadata() *Shortcut_OpenGraphMetadata {
 	return nil
 }
 
+func (x *Shortcut) GetLinkTemplate() bool {
+	if x != nil {
+		return x.LinkTemplate
+	}
+	return false
+}
+
+func (x *Shortcut) GetPersonal() bool {
+	if x != nil {
+		return x.Personal
+	}
+	return false
+}
+
+func (x *Shortcut) GetGroupId() int32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
 type ListShortcutsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of shortcuts to return.
+	// All the matched shortcuts are returned if unspecified.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The page token received from a previous ListShortcuts call.
+	// It must be used with the same filter and order by, and the page size overrides its own one.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The filter is a list of conditions joined by "&&".
+	// e.g. `creator_id == 101 && tag == "dev" && name.startsWith("gh") && created_time >= "2024-01-01T00:00:00Z"`
+	// Supported fields: creator_id, tag, visibility, state, name.startsWith(), created_time and updated_time.
+	// Only the active shortcuts are returned unless filtered by state, e.g. `state == "INACTIVE"` lists the trash.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The order of the shortcuts, one of "created_time", "updated_time" and "name",
+	// optionally followed by "asc" or "desc". Default to "created_time desc".
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -193,9 +409,39 @@ func (*ListShortcutsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *ListShortcutsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListShortcutsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListShortcutsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListShortcutsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListShortcutsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Shortcuts     []*Shortcut            `protobuf:"bytes,1,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Shortcuts []*Shortcut            `protobuf:"bytes,1,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+	// The page token to retrieve the next page, empty if there are no more shortcuts.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -237,6 +483,13 @@ func (x *ListShortcutsResponse) GetShortcuts() []*Shortcut {
 	return nil
 }
 
+func (x *ListShortcutsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetShortcutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -282,8 +535,10 @@ func (x *GetShortcutRequest) GetId() int32 {
 }
 
 type GetShortcutByNameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name is resolved in the personal namespace of the current user first, then in the workspace one.
+	// The name with the "me/" prefix is only resolved in the personal namespace.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -422,8 +677,10 @@ func (x *UpdateShortcutRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 }
 
 type DeleteShortcutRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Whether to delete the shortcut permanently instead of moving it to the trash.
+	Purge         bool `protobuf:"varint,2,opt,name=purge,proto3" json:"purge,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -465,27 +722,34 @@ func (x *DeleteShortcutRequest) GetId() int32 {
 	return 0
 }
 
-type GetShortcutAnalyticsRequest struct {
+func (x *DeleteShortcutRequest) GetPurge() bool {
+	if x != nil {
+		return x.Purge
+	}
+	return false
+}
+
+type UndeleteShortcutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShortcutAnalyticsRequest) Reset() {
-	*x = GetShortcutAnalyticsRequest{}
+func (x *UndeleteShortcutRequest) Reset() {
+	*x = UndeleteShortcutRequest{}
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShortcutAnalyticsRequest) String() string {
+func (x *UndeleteShortcutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShortcutAnalyticsRequest) ProtoMessage() {}
+func (*UndeleteShortcutRequest) ProtoMessage() {}
 
-func (x *GetShortcutAnalyticsRequest) ProtoReflect() protoreflect.Message {
+func (x *UndeleteShortcutRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -497,41 +761,49 @@ func (x *GetShortcutAnalyticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShortcutAnalyticsRequest.ProtoReflect.Descriptor instead.
-func (*GetShortcutAnalyticsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UndeleteShortcutRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteShortcutRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetShortcutAnalyticsRequest) GetId() int32 {
+func (x *UndeleteShortcutRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetShortcutAnalyticsResponse struct {
-	state         protoimpl.MessageState                        `protogen:"open.v1"`
-	References    []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
-	Devices       []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,2,rep,name=devices,proto3" json:"devices,omitempty"`
-	Browsers      []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,3,rep,name=browsers,proto3" json:"browsers,omitempty"`
+type ShortcutRevision struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShortcutId int32                  `protobuf:"varint,2,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	// The id of the user who made the change.
+	CreatorId   int32                  `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// The shortcut before the change.
+	OldValue *Shortcut `protobuf:"bytes,5,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// The shortcut after the change.
+	NewValue *Shortcut `protobuf:"bytes,6,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	// The fields changed in the revision, e.g. "link" and "tags".
+	ChangedFields []string `protobuf:"bytes,7,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShortcutAnalyticsResponse) Reset() {
-	*x = GetShortcutAnalyticsResponse{}
+func (x *ShortcutRevision) Reset() {
+	*x = ShortcutRevision{}
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShortcutAnalyticsResponse) String() string {
+func (x *ShortcutRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShortcutAnalyticsResponse) ProtoMessage() {}
+func (*ShortcutRevision) ProtoMessage() {}
 
-func (x *GetShortcutAnalyticsResponse) ProtoReflect() protoreflect.Message {
+func (x *ShortcutRevision) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,55 +815,81 @@ func (x *GetShortcutAnalyticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShortcutAnalyticsResponse.ProtoReflect.Descriptor instead.
-func (*GetShortcutAnalyticsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShortcutRevision.ProtoReflect.Descriptor instead.
+func (*ShortcutRevision) Descriptor() ([]byte, []int) {
 	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetShortcutAnalyticsResponse) GetReferences() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+func (x *ShortcutRevision) GetId() int32 {
 	if x != nil {
-		return x.References
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShortcutRevision) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ShortcutRevision) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ShortcutRevision) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
 	}
 	return nil
 }
 
-func (x *GetShortcutAnalyticsResponse) GetDevices() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+func (x *ShortcutRevision) GetOldValue() *Shortcut {
 	if x != nil {
-		return x.Devices
+		return x.OldValue
 	}
 	return nil
 }
 
-func (x *GetShortcutAnalyticsResponse) GetBrowsers() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+func (x *ShortcutRevision) GetNewValue() *Shortcut {
 	if x != nil {
-		return x.Browsers
+		return x.NewValue
 	}
 	return nil
 }
 
-type Shortcut_OpenGraphMetadata struct {
+func (x *ShortcutRevision) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+type ListShortcutRevisionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Shortcut_OpenGraphMetadata) Reset() {
-	*x = Shortcut_OpenGraphMetadata{}
+func (x *ListShortcutRevisionsRequest) Reset() {
+	*x = ListShortcutRevisionsRequest{}
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Shortcut_OpenGraphMetadata) String() string {
+func (x *ListShortcutRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Shortcut_OpenGraphMetadata) ProtoMessage() {}
+func (*ListShortcutRevisionsRequest) ProtoMessage() {}
 
-func (x *Shortcut_OpenGraphMetadata) ProtoReflect() protoreflect.Message {
+func (x *ListShortcutRevisionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,55 +901,86 @@ func (x *Shortcut_OpenGraphMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Shortcut_OpenGraphMetadata.ProtoReflect.Descriptor instead.
-func (*Shortcut_OpenGraphMetadata) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use ListShortcutRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListShortcutRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Shortcut_OpenGraphMetadata) GetTitle() string {
+func (x *ListShortcutRevisionsRequest) GetId() int32 {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Shortcut_OpenGraphMetadata) GetDescription() string {
+type ListShortcutRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*ShortcutRevision    `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutRevisionsResponse) Reset() {
+	*x = ListShortcutRevisionsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutRevisionsResponse) ProtoMessage() {}
+
+func (x *ListShortcutRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[11]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Shortcut_OpenGraphMetadata) GetImage() string {
+// Deprecated: Use ListShortcutRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListShortcutRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListShortcutRevisionsResponse) GetRevisions() []*ShortcutRevision {
 	if x != nil {
-		return x.Image
+		return x.Revisions
 	}
-	return ""
+	return nil
 }
 
-type GetShortcutAnalyticsResponse_AnalyticsItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+type RestoreShortcutRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the shortcut.
+	Id            int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RevisionId    int32 `protobuf:"varint,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) Reset() {
-	*x = GetShortcutAnalyticsResponse_AnalyticsItem{}
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[11]
+func (x *RestoreShortcutRevisionRequest) Reset() {
+	*x = RestoreShortcutRevisionRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) String() string {
+func (x *RestoreShortcutRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShortcutAnalyticsResponse_AnalyticsItem) ProtoMessage() {}
+func (*RestoreShortcutRevisionRequest) ProtoMessage() {}
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_shortcut_service_proto_msgTypes[11]
+func (x *RestoreShortcutRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -662,56 +991,619 @@ func (x *GetShortcutAnalyticsResponse_AnalyticsItem) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShortcutAnalyticsResponse_AnalyticsItem.ProtoReflect.Descriptor instead.
-func (*GetShortcutAnalyticsResponse_AnalyticsItem) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use RestoreShortcutRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreShortcutRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) GetName() string {
+func (x *RestoreShortcutRevisionRequest) GetId() int32 {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) GetCount() int32 {
+func (x *RestoreShortcutRevisionRequest) GetRevisionId() int32 {
 	if x != nil {
-		return x.Count
+		return x.RevisionId
 	}
 	return 0
 }
 
-var File_api_v1_shortcut_service_proto protoreflect.FileDescriptor
+type ImportShortcutsRequest struct {
+	state  protoimpl.MessageState        `protogen:"open.v1"`
+	Format ImportShortcutsRequest_Format `protobuf:"varint,1,opt,name=format,proto3,enum=slash.api.v1.ImportShortcutsRequest_Format" json:"format,omitempty"`
+	// The content of the file to import.
+	// CSV: a header row with the columns name, link, title, description, tags and visibility.
+	// JSON: an array of Shortcut.
+	// BOOKMARK_HTML: the Netscape bookmark file exported from browsers.
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Validate the shortcuts and report the results without saving them.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// How to handle the shortcuts whose names already exist. Default to SKIP.
+	ConflictPolicy ImportShortcutsRequest_ConflictPolicy `protobuf:"varint,4,opt,name=conflict_policy,json=conflictPolicy,proto3,enum=slash.api.v1.ImportShortcutsRequest_ConflictPolicy" json:"conflict_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportShortcutsRequest) Reset() {
+	*x = ImportShortcutsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-const file_api_v1_shortcut_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1dapi/v1/shortcut_service.proto\x12\fslash.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb2\x04\n" +
-	"\bShortcut\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
-	"\n" +
-	"creator_id\x18\x02 \x01(\x05R\tcreatorId\x12=\n" +
-	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
-	"\fupdated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12\x12\n" +
-	"\x04name\x18\x06 \x01(\tR\x04name\x12\x12\n" +
-	"\x04link\x18\a \x01(\tR\x04link\x12\x14\n" +
-	"\x05title\x18\b \x01(\tR\x05title\x12\x12\n" +
-	"\x04tags\x18\t \x03(\tR\x04tags\x12 \n" +
-	"\vdescription\x18\n" +
-	" \x01(\tR\vdescription\x128\n" +
-	"\n" +
-	"visibility\x18\v \x01(\x0e2\x18.slash.api.v1.VisibilityR\n" +
-	"visibility\x12\x1d\n" +
-	"\n" +
-	"view_count\x18\f \x01(\x05R\tviewCount\x12I\n" +
-	"\vog_metadata\x18\r \x01(\v2(.slash.api.v1.Shortcut.OpenGraphMetadataR\n" +
-	"ogMetadata\x1aa\n" +
-	"\x11OpenGraphMetadata\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05image\x18\x03 \x01(\tR\x05image\"\x16\n" +
-	"\x14ListShortcutsRequest\"M\n" +
-	"\x15ListShortcutsResponse\x124\n" +
-	"\tshortcuts\x18\x01 \x03(\v2\x16.slash.api.v1.ShortcutR\tshortcuts\"$\n" +
+func (x *ImportShortcutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportShortcutsRequest) ProtoMessage() {}
+
+func (x *ImportShortcutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportShortcutsRequest.ProtoReflect.Descriptor instead.
+func (*ImportShortcutsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImportShortcutsRequest) GetFormat() ImportShortcutsRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return ImportShortcutsRequest_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportShortcutsRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportShortcutsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportShortcutsRequest) GetConflictPolicy() ImportShortcutsRequest_ConflictPolicy {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return ImportShortcutsRequest_CONFLICT_POLICY_UNSPECIFIED
+}
+
+type ImportShortcutsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The results of every imported row, in the order of the file.
+	Results       []*ImportShortcutsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	CreatedCount  int32                             `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	UpdatedCount  int32                             `protobuf:"varint,3,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	SkippedCount  int32                             `protobuf:"varint,4,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	FailedCount   int32                             `protobuf:"varint,5,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportShortcutsResponse) Reset() {
+	*x = ImportShortcutsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportShortcutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportShortcutsResponse) ProtoMessage() {}
+
+func (x *ImportShortcutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportShortcutsResponse.ProtoReflect.Descriptor instead.
+func (*ImportShortcutsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportShortcutsResponse) GetResults() []*ImportShortcutsResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportShortcutsResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportShortcutsResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *ImportShortcutsResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *ImportShortcutsResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+type GetShortcutAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The start of the time range, inclusive. Default to 14 days before the end time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The end of the time range, exclusive. Default to now.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The size of the timeline buckets. Default to DAY.
+	Granularity AnalyticsGranularity `protobuf:"varint,4,opt,name=granularity,proto3,enum=slash.api.v1.AnalyticsGranularity" json:"granularity,omitempty"`
+	// The maximum number of items to return for each dimension. All items are returned if unspecified.
+	TopN          int32 `protobuf:"varint,5,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShortcutAnalyticsRequest) Reset() {
+	*x = GetShortcutAnalyticsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShortcutAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortcutAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetShortcutAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortcutAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetShortcutAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetShortcutAnalyticsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetShortcutAnalyticsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsRequest) GetGranularity() AnalyticsGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return AnalyticsGranularity_ANALYTICS_GRANULARITY_UNSPECIFIED
+}
+
+func (x *GetShortcutAnalyticsRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+type GetShortcutAnalyticsResponse struct {
+	state      protoimpl.MessageState                        `protogen:"open.v1"`
+	References []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
+	Devices    []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,2,rep,name=devices,proto3" json:"devices,omitempty"`
+	Browsers   []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,3,rep,name=browsers,proto3" json:"browsers,omitempty"`
+	// The view counts of every bucket in the time range, in ascending order.
+	Timeline      []*GetShortcutAnalyticsResponse_TimeBucket `protobuf:"bytes,4,rep,name=timeline,proto3" json:"timeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShortcutAnalyticsResponse) Reset() {
+	*x = GetShortcutAnalyticsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShortcutAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortcutAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetShortcutAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortcutAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetShortcutAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetShortcutAnalyticsResponse) GetReferences() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetDevices() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetBrowsers() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.Browsers
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetTimeline() []*GetShortcutAnalyticsResponse_TimeBucket {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+type Shortcut_OpenGraphMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shortcut_OpenGraphMetadata) Reset() {
+	*x = Shortcut_OpenGraphMetadata{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shortcut_OpenGraphMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shortcut_OpenGraphMetadata) ProtoMessage() {}
+
+func (x *Shortcut_OpenGraphMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shortcut_OpenGraphMetadata.ProtoReflect.Descriptor instead.
+func (*Shortcut_OpenGraphMetadata) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Shortcut_OpenGraphMetadata) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Shortcut_OpenGraphMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Shortcut_OpenGraphMetadata) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type ImportShortcutsResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The 1-based row number, not counting the CSV header.
+	Row int32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	// The name of the shortcut, after renaming if any.
+	Name   string                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status ImportShortcutsResponse_Status `protobuf:"varint,3,opt,name=status,proto3,enum=slash.api.v1.ImportShortcutsResponse_Status" json:"status,omitempty"`
+	// The reason of the failure.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// The saved shortcut, empty for dry runs and failures.
+	Shortcut      *Shortcut `protobuf:"bytes,5,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportShortcutsResponse_Result) Reset() {
+	*x = ImportShortcutsResponse_Result{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportShortcutsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportShortcutsResponse_Result) ProtoMessage() {}
+
+func (x *ImportShortcutsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportShortcutsResponse_Result.ProtoReflect.Descriptor instead.
+func (*ImportShortcutsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *ImportShortcutsResponse_Result) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportShortcutsResponse_Result) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportShortcutsResponse_Result) GetStatus() ImportShortcutsResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ImportShortcutsResponse_STATUS_UNSPECIFIED
+}
+
+func (x *ImportShortcutsResponse_Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportShortcutsResponse_Result) GetShortcut() *Shortcut {
+	if x != nil {
+		return x.Shortcut
+	}
+	return nil
+}
+
+type GetShortcutAnalyticsResponse_AnalyticsItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) Reset() {
+	*x = GetShortcutAnalyticsResponse_AnalyticsItem{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortcutAnalyticsResponse_AnalyticsItem) ProtoMessage() {}
+
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortcutAnalyticsResponse_AnalyticsItem.ProtoReflect.Descriptor instead.
+func (*GetShortcutAnalyticsResponse_AnalyticsItem) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetShortcutAnalyticsResponse_TimeBucket struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	ViewCount int32                  `protobuf:"varint,2,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	// The estimated number of unique visitors, counted by their IP addresses.
+	UniqueVisitorCount int32 `protobuf:"varint,3,opt,name=unique_visitor_count,json=uniqueVisitorCount,proto3" json:"unique_visitor_count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetShortcutAnalyticsResponse_TimeBucket) Reset() {
+	*x = GetShortcutAnalyticsResponse_TimeBucket{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShortcutAnalyticsResponse_TimeBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortcutAnalyticsResponse_TimeBucket) ProtoMessage() {}
+
+func (x *GetShortcutAnalyticsResponse_TimeBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortcutAnalyticsResponse_TimeBucket.ProtoReflect.Descriptor instead.
+func (*GetShortcutAnalyticsResponse_TimeBucket) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{16, 1}
+}
+
+func (x *GetShortcutAnalyticsResponse_TimeBucket) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse_TimeBucket) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *GetShortcutAnalyticsResponse_TimeBucket) GetUniqueVisitorCount() int32 {
+	if x != nil {
+		return x.UniqueVisitorCount
+	}
+	return 0
+}
+
+var File_api_v1_shortcut_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_shortcut_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dapi/v1/shortcut_service.proto\x12\fslash.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb9\x05\n" +
+	"\bShortcut\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\x05R\tcreatorId\x12=\n" +
+	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
+	"\fupdated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12)\n" +
+	"\x05state\x18\x05 \x01(\x0e2\x13.slash.api.v1.StateR\x05state\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x12\n" +
+	"\x04link\x18\a \x01(\tR\x04link\x12\x14\n" +
+	"\x05title\x18\b \x01(\tR\x05title\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x128\n" +
+	"\n" +
+	"visibility\x18\v \x01(\x0e2\x18.slash.api.v1.VisibilityR\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\f \x01(\x05R\tviewCount\x12I\n" +
+	"\vog_metadata\x18\r \x01(\v2(.slash.api.v1.Shortcut.OpenGraphMetadataR\n" +
+	"ogMetadata\x12#\n" +
+	"\rlink_template\x18\x0e \x01(\bR\flinkTemplate\x12\x1a\n" +
+	"\bpersonal\x18\x0f \x01(\bR\bpersonal\x12\x19\n" +
+	"\bgroup_id\x18\x10 \x01(\x05R\agroupId\x1aa\n" +
+	"\x11OpenGraphMetadata\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\"\x85\x01\n" +
+	"\x14ListShortcutsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\"u\n" +
+	"\x15ListShortcutsResponse\x124\n" +
+	"\tshortcuts\x18\x01 \x03(\v2\x16.slash.api.v1.ShortcutR\tshortcuts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"$\n" +
 	"\x12GetShortcutRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\".\n" +
 	"\x18GetShortcutByNameRequest\x12\x12\n" +
@@ -721,27 +1613,100 @@ const file_api_v1_shortcut_service_proto_rawDesc = "" +
 	"\x15UpdateShortcutRequest\x122\n" +
 	"\bshortcut\x18\x01 \x01(\v2\x16.slash.api.v1.ShortcutR\bshortcut\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"'\n" +
+	"updateMask\"=\n" +
 	"\x15DeleteShortcutRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"-\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05purge\x18\x02 \x01(\bR\x05purge\")\n" +
+	"\x17UndeleteShortcutRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xb2\x02\n" +
+	"\x10ShortcutRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vshortcut_id\x18\x02 \x01(\x05R\n" +
+	"shortcutId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\x05R\tcreatorId\x12=\n" +
+	"\fcreated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x123\n" +
+	"\told_value\x18\x05 \x01(\v2\x16.slash.api.v1.ShortcutR\boldValue\x123\n" +
+	"\tnew_value\x18\x06 \x01(\v2\x16.slash.api.v1.ShortcutR\bnewValue\x12%\n" +
+	"\x0echanged_fields\x18\a \x03(\tR\rchangedFields\".\n" +
+	"\x1cListShortcutRevisionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"]\n" +
+	"\x1dListShortcutRevisionsResponse\x12<\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1e.slash.api.v1.ShortcutRevisionR\trevisions\"Q\n" +
+	"\x1eRestoreShortcutRevisionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vrevision_id\x18\x02 \x01(\x05R\n" +
+	"revisionId\"\x8e\x03\n" +
+	"\x16ImportShortcutsRequest\x12C\n" +
+	"\x06format\x18\x01 \x01(\x0e2+.slash.api.v1.ImportShortcutsRequest.FormatR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\\\n" +
+	"\x0fconflict_policy\x18\x04 \x01(\x0e23.slash.api.v1.ImportShortcutsRequest.ConflictPolicyR\x0econflictPolicy\"F\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03CSV\x10\x01\x12\b\n" +
+	"\x04JSON\x10\x02\x12\x11\n" +
+	"\rBOOKMARK_HTML\x10\x03\"V\n" +
+	"\x0eConflictPolicy\x12\x1f\n" +
+	"\x1bCONFLICT_POLICY_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04SKIP\x10\x01\x12\r\n" +
+	"\tOVERWRITE\x10\x02\x12\n" +
+	"\n" +
+	"\x06RENAME\x10\x03\"\x89\x04\n" +
+	"\x17ImportShortcutsResponse\x12F\n" +
+	"\aresults\x18\x01 \x03(\v2,.slash.api.v1.ImportShortcutsResponse.ResultR\aresults\x12#\n" +
+	"\rcreated_count\x18\x02 \x01(\x05R\fcreatedCount\x12#\n" +
+	"\rupdated_count\x18\x03 \x01(\x05R\fupdatedCount\x12#\n" +
+	"\rskipped_count\x18\x04 \x01(\x05R\fskippedCount\x12!\n" +
+	"\ffailed_count\x18\x05 \x01(\x05R\vfailedCount\x1a\xbe\x01\n" +
+	"\x06Result\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12D\n" +
+	"\x06status\x18\x03 \x01(\x0e2,.slash.api.v1.ImportShortcutsResponse.StatusR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x122\n" +
+	"\bshortcut\x18\x05 \x01(\v2\x16.slash.api.v1.ShortcutR\bshortcut\"S\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCREATED\x10\x01\x12\v\n" +
+	"\aUPDATED\x10\x02\x12\v\n" +
+	"\aSKIPPED\x10\x03\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x04\"\xfa\x01\n" +
 	"\x1bGetShortcutAnalyticsRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\xdd\x02\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12D\n" +
+	"\vgranularity\x18\x04 \x01(\x0e2\".slash.api.v1.AnalyticsGranularityR\vgranularity\x12\x13\n" +
+	"\x05top_n\x18\x05 \x01(\x05R\x04topN\"\xcb\x04\n" +
 	"\x1cGetShortcutAnalyticsResponse\x12X\n" +
 	"\n" +
 	"references\x18\x01 \x03(\v28.slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\n" +
 	"references\x12R\n" +
 	"\adevices\x18\x02 \x03(\v28.slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\adevices\x12T\n" +
-	"\bbrowsers\x18\x03 \x03(\v28.slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\bbrowsers\x1a9\n" +
+	"\bbrowsers\x18\x03 \x03(\v28.slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\bbrowsers\x12Q\n" +
+	"\btimeline\x18\x04 \x03(\v25.slash.api.v1.GetShortcutAnalyticsResponse.TimeBucketR\btimeline\x1a9\n" +
 	"\rAnalyticsItem\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count2\xec\x06\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x1a\x98\x01\n" +
+	"\n" +
+	"TimeBucket\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x02 \x01(\x05R\tviewCount\x120\n" +
+	"\x14unique_visitor_count\x18\x03 \x01(\x05R\x12uniqueVisitorCount2\xcf\v\n" +
 	"\x0fShortcutService\x12s\n" +
 	"\rListShortcuts\x12\".slash.api.v1.ListShortcutsRequest\x1a#.slash.api.v1.ListShortcutsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/shortcuts\x12l\n" +
 	"\vGetShortcut\x12 .slash.api.v1.GetShortcutRequest\x1a\x16.slash.api.v1.Shortcut\"#\xdaA\x02id\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/shortcuts/{id}\x12U\n" +
 	"\x11GetShortcutByName\x12&.slash.api.v1.GetShortcutByNameRequest\x1a\x16.slash.api.v1.Shortcut\"\x00\x12r\n" +
 	"\x0eCreateShortcut\x12#.slash.api.v1.CreateShortcutRequest\x1a\x16.slash.api.v1.Shortcut\"#\x82\xd3\xe4\x93\x02\x1d:\bshortcut\"\x11/api/v1/shortcuts\x12\x97\x01\n" +
 	"\x0eUpdateShortcut\x12#.slash.api.v1.UpdateShortcutRequest\x1a\x16.slash.api.v1.Shortcut\"H\xdaA\x14shortcut,update_mask\x82\xd3\xe4\x93\x02+:\bshortcut\x1a\x1f/api/v1/shortcuts/{shortcut.id}\x12r\n" +
-	"\x0eDeleteShortcut\x12#.slash.api.v1.DeleteShortcutRequest\x1a\x16.google.protobuf.Empty\"#\xdaA\x02id\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/shortcuts/{id}\x12\x9c\x01\n" +
+	"\x0eDeleteShortcut\x12#.slash.api.v1.DeleteShortcutRequest\x1a\x16.google.protobuf.Empty\"#\xdaA\x02id\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/shortcuts/{id}\x12\x82\x01\n" +
+	"\x10UndeleteShortcut\x12%.slash.api.v1.UndeleteShortcutRequest\x1a\x16.slash.api.v1.Shortcut\"/\xdaA\x02id\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/shortcuts/{id}:undelete\x12\x83\x01\n" +
+	"\x0fImportShortcuts\x12$.slash.api.v1.ImportShortcutsRequest\x1a%.slash.api.v1.ImportShortcutsResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/shortcuts:import\x12\x9f\x01\n" +
+	"\x15ListShortcutRevisions\x12*.slash.api.v1.ListShortcutRevisionsRequest\x1a+.slash.api.v1.ListShortcutRevisionsResponse\"-\xdaA\x02id\x82\xd3\xe4\x93\x02\"\x12 /api/v1/shortcuts/{id}/revisions\x12\xb3\x01\n" +
+	"\x17RestoreShortcutRevision\x12,.slash.api.v1.RestoreShortcutRevisionRequest\x1a\x16.slash.api.v1.Shortcut\"R\xdaA\x0eid,revision_id\x82\xd3\xe4\x93\x02;:\x01*\"6/api/v1/shortcuts/{id}/revisions/{revision_id}:restore\x12\x9c\x01\n" +
 	"\x14GetShortcutAnalytics\x12).slash.api.v1.GetShortcutAnalyticsRequest\x1a*.slash.api.v1.GetShortcutAnalyticsResponse\"-\xdaA\x02id\x82\xd3\xe4\x93\x02\"\x12 /api/v1/shortcuts/{id}/analyticsB\xb2\x01\n" +
 	"\x10com.slash.api.v1B\x14ShortcutServiceProtoP\x01Z6github.com/yourselfhosted/slash/proto/gen/api/v1;apiv1\xa2\x02\x03SAX\xaa\x02\fSlash.Api.V1\xca\x02\fSlash\\Api\\V1\xe2\x02\x18Slash\\Api\\V1\\GPBMetadata\xea\x02\x0eSlash::Api::V1b\x06proto3"
 
@@ -757,56 +1722,94 @@ func file_api_v1_shortcut_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_shortcut_service_proto_rawDescData
 }
 
-var file_api_v1_shortcut_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_v1_shortcut_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_shortcut_service_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_api_v1_shortcut_service_proto_goTypes = []any{
-	(*Shortcut)(nil),                                   // 0: slash.api.v1.Shortcut
-	(*ListShortcutsRequest)(nil),                       // 1: slash.api.v1.ListShortcutsRequest
-	(*ListShortcutsResponse)(nil),                      // 2: slash.api.v1.ListShortcutsResponse
-	(*GetShortcutRequest)(nil),                         // 3: slash.api.v1.GetShortcutRequest
-	(*GetShortcutByNameRequest)(nil),                   // 4: slash.api.v1.GetShortcutByNameRequest
-	(*CreateShortcutRequest)(nil),                      // 5: slash.api.v1.CreateShortcutRequest
-	(*UpdateShortcutRequest)(nil),                      // 6: slash.api.v1.UpdateShortcutRequest
-	(*DeleteShortcutRequest)(nil),                      // 7: slash.api.v1.DeleteShortcutRequest
-	(*GetShortcutAnalyticsRequest)(nil),                // 8: slash.api.v1.GetShortcutAnalyticsRequest
-	(*GetShortcutAnalyticsResponse)(nil),               // 9: slash.api.v1.GetShortcutAnalyticsResponse
-	(*Shortcut_OpenGraphMetadata)(nil),                 // 10: slash.api.v1.Shortcut.OpenGraphMetadata
-	(*GetShortcutAnalyticsResponse_AnalyticsItem)(nil), // 11: slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
-	(*timestamppb.Timestamp)(nil),                      // 12: google.protobuf.Timestamp
-	(Visibility)(0),                                    // 13: slash.api.v1.Visibility
-	(*fieldmaskpb.FieldMask)(nil),                      // 14: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                              // 15: google.protobuf.Empty
+	(ImportShortcutsRequest_Format)(0),                 // 0: slash.api.v1.ImportShortcutsRequest.Format
+	(ImportShortcutsRequest_ConflictPolicy)(0),         // 1: slash.api.v1.ImportShortcutsRequest.ConflictPolicy
+	(ImportShortcutsResponse_Status)(0),                // 2: slash.api.v1.ImportShortcutsResponse.Status
+	(*Shortcut)(nil),                                   // 3: slash.api.v1.Shortcut
+	(*ListShortcutsRequest)(nil),                       // 4: slash.api.v1.ListShortcutsRequest
+	(*ListShortcutsResponse)(nil),                      // 5: slash.api.v1.ListShortcutsResponse
+	(*GetShortcutRequest)(nil),                         // 6: slash.api.v1.GetShortcutRequest
+	(*GetShortcutByNameRequest)(nil),                   // 7: slash.api.v1.GetShortcutByNameRequest
+	(*CreateShortcutRequest)(nil),                      // 8: slash.api.v1.CreateShortcutRequest
+	(*UpdateShortcutRequest)(nil),                      // 9: slash.api.v1.UpdateShortcutRequest
+	(*DeleteShortcutRequest)(nil),                      // 10: slash.api.v1.DeleteShortcutRequest
+	(*UndeleteShortcutRequest)(nil),                    // 11: slash.api.v1.UndeleteShortcutRequest
+	(*ShortcutRevision)(nil),                           // 12: slash.api.v1.ShortcutRevision
+	(*ListShortcutRevisionsRequest)(nil),               // 13: slash.api.v1.ListShortcutRevisionsRequest
+	(*ListShortcutRevisionsResponse)(nil),              // 14: slash.api.v1.ListShortcutRevisionsResponse
+	(*RestoreShortcutRevisionRequest)(nil),             // 15: slash.api.v1.RestoreShortcutRevisionRequest
+	(*ImportShortcutsRequest)(nil),                     // 16: slash.api.v1.ImportShortcutsRequest
+	(*ImportShortcutsResponse)(nil),                    // 17: slash.api.v1.ImportShortcutsResponse
+	(*GetShortcutAnalyticsRequest)(nil),                // 18: slash.api.v1.GetShortcutAnalyticsRequest
+	(*GetShortcutAnalyticsResponse)(nil),               // 19: slash.api.v1.GetShortcutAnalyticsResponse
+	(*Shortcut_OpenGraphMetadata)(nil),                 // 20: slash.api.v1.Shortcut.OpenGraphMetadata
+	(*ImportShortcutsResponse_Result)(nil),             // 21: slash.api.v1.ImportShortcutsResponse.Result
+	(*GetShortcutAnalyticsResponse_AnalyticsItem)(nil), // 22: slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	(*GetShortcutAnalyticsResponse_TimeBucket)(nil),    // 23: slash.api.v1.GetShortcutAnalyticsResponse.TimeBucket
+	(*timestamppb.Timestamp)(nil),                      // 24: google.protobuf.Timestamp
+	(State)(0),                                         // 25: slash.api.v1.State
+	(Visibility)(0),                                    // 26: slash.api.v1.Visibility
+	(*fieldmaskpb.FieldMask)(nil),                      // 27: google.protobuf.FieldMask
+	(AnalyticsGranularity)(0),                          // 28: slash.api.v1.AnalyticsGranularity
+	(*emptypb.Empty)(nil),                              // 29: google.protobuf.Empty
 }
 var file_api_v1_shortcut_service_proto_depIdxs = []int32{
-	12, // 0: slash.api.v1.Shortcut.created_time:type_name -> google.protobuf.Timestamp
-	12, // 1: slash.api.v1.Shortcut.updated_time:type_name -> google.protobuf.Timestamp
-	13, // 2: slash.api.v1.Shortcut.visibility:type_name -> slash.api.v1.Visibility
-	10, // 3: slash.api.v1.Shortcut.og_metadata:type_name -> slash.api.v1.Shortcut.OpenGraphMetadata
-	0,  // 4: slash.api.v1.ListShortcutsResponse.shortcuts:type_name -> slash.api.v1.Shortcut
-	0,  // 5: slash.api.v1.CreateShortcutRequest.shortcut:type_name -> slash.api.v1.Shortcut
-	0,  // 6: slash.api.v1.UpdateShortcutRequest.shortcut:type_name -> slash.api.v1.Shortcut
-	14, // 7: slash.api.v1.UpdateShortcutRequest.update_mask:type_name -> google.protobuf.FieldMask
-	11, // 8: slash.api.v1.GetShortcutAnalyticsResponse.references:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
-	11, // 9: slash.api.v1.GetShortcutAnalyticsResponse.devices:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
-	11, // 10: slash.api.v1.GetShortcutAnalyticsResponse.browsers:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
-	1,  // 11: slash.api.v1.ShortcutService.ListShortcuts:input_type -> slash.api.v1.ListShortcutsRequest
-	3,  // 12: slash.api.v1.ShortcutService.GetShortcut:input_type -> slash.api.v1.GetShortcutRequest
-	4,  // 13: slash.api.v1.ShortcutService.GetShortcutByName:input_type -> slash.api.v1.GetShortcutByNameRequest
-	5,  // 14: slash.api.v1.ShortcutService.CreateShortcut:input_type -> slash.api.v1.CreateShortcutRequest
-	6,  // 15: slash.api.v1.ShortcutService.UpdateShortcut:input_type -> slash.api.v1.UpdateShortcutRequest
-	7,  // 16: slash.api.v1.ShortcutService.DeleteShortcut:input_type -> slash.api.v1.DeleteShortcutRequest
-	8,  // 17: slash.api.v1.ShortcutService.GetShortcutAnalytics:input_type -> slash.api.v1.GetShortcutAnalyticsRequest
-	2,  // 18: slash.api.v1.ShortcutService.ListShortcuts:output_type -> slash.api.v1.ListShortcutsResponse
-	0,  // 19: slash.api.v1.ShortcutService.GetShortcut:output_type -> slash.api.v1.Shortcut
-	0,  // 20: slash.api.v1.ShortcutService.GetShortcutByName:output_type -> slash.api.v1.Shortcut
-	0,  // 21: slash.api.v1.ShortcutService.CreateShortcut:output_type -> slash.api.v1.Shortcut
-	0,  // 22: slash.api.v1.ShortcutService.UpdateShortcut:output_type -> slash.api.v1.Shortcut
-	15, // 23: slash.api.v1.ShortcutService.DeleteShortcut:output_type -> google.protobuf.Empty
-	9,  // 24: slash.api.v1.ShortcutService.GetShortcutAnalytics:output_type -> slash.api.v1.GetShortcutAnalyticsResponse
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	24, // 0: slash.api.v1.Shortcut.created_time:type_name -> google.protobuf.Timestamp
+	24, // 1: slash.api.v1.Shortcut.updated_time:type_name -> google.protobuf.Timestamp
+	25, // 2: slash.api.v1.Shortcut.state:type_name -> slash.api.v1.State
+	26, // 3: slash.api.v1.Shortcut.visibility:type_name -> slash.api.v1.Visibility
+	20, // 4: slash.api.v1.Shortcut.og_metadata:type_name -> slash.api.v1.Shortcut.OpenGraphMetadata
+	3,  // 5: slash.api.v1.ListShortcutsResponse.shortcuts:type_name -> slash.api.v1.Shortcut
+	3,  // 6: slash.api.v1.CreateShortcutRequest.shortcut:type_name -> slash.api.v1.Shortcut
+	3,  // 7: slash.api.v1.UpdateShortcutRequest.shortcut:type_name -> slash.api.v1.Shortcut
+	27, // 8: slash.api.v1.UpdateShortcutRequest.update_mask:type_name -> google.protobuf.FieldMask
+	24, // 9: slash.api.v1.ShortcutRevision.created_time:type_name -> google.protobuf.Timestamp
+	3,  // 10: slash.api.v1.ShortcutRevision.old_value:type_name -> slash.api.v1.Shortcut
+	3,  // 11: slash.api.v1.ShortcutRevision.new_value:type_name -> slash.api.v1.Shortcut
+	12, // 12: slash.api.v1.ListShortcutRevisionsResponse.revisions:type_name -> slash.api.v1.ShortcutRevision
+	0,  // 13: slash.api.v1.ImportShortcutsRequest.format:type_name -> slash.api.v1.ImportShortcutsRequest.Format
+	1,  // 14: slash.api.v1.ImportShortcutsRequest.conflict_policy:type_name -> slash.api.v1.ImportShortcutsRequest.ConflictPolicy
+	21, // 15: slash.api.v1.ImportShortcutsResponse.results:type_name -> slash.api.v1.ImportShortcutsResponse.Result
+	24, // 16: slash.api.v1.GetShortcutAnalyticsRequest.start_time:type_name -> google.protobuf.Timestamp
+	24, // 17: slash.api.v1.GetShortcutAnalyticsRequest.end_time:type_name -> google.protobuf.Timestamp
+	28, // 18: slash.api.v1.GetShortcutAnalyticsRequest.granularity:type_name -> slash.api.v1.AnalyticsGranularity
+	22, // 19: slash.api.v1.GetShortcutAnalyticsResponse.references:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	22, // 20: slash.api.v1.GetShortcutAnalyticsResponse.devices:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	22, // 21: slash.api.v1.GetShortcutAnalyticsResponse.browsers:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	23, // 22: slash.api.v1.GetShortcutAnalyticsResponse.timeline:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.TimeBucket
+	2,  // 23: slash.api.v1.ImportShortcutsResponse.Result.status:type_name -> slash.api.v1.ImportShortcutsResponse.Status
+	3,  // 24: slash.api.v1.ImportShortcutsResponse.Result.shortcut:type_name -> slash.api.v1.Shortcut
+	24, // 25: slash.api.v1.GetShortcutAnalyticsResponse.TimeBucket.start_time:type_name -> google.protobuf.Timestamp
+	4,  // 26: slash.api.v1.ShortcutService.ListShortcuts:input_type -> slash.api.v1.ListShortcutsRequest
+	6,  // 27: slash.api.v1.ShortcutService.GetShortcut:input_type -> slash.api.v1.GetShortcutRequest
+	7,  // 28: slash.api.v1.ShortcutService.GetShortcutByName:input_type -> slash.api.v1.GetShortcutByNameRequest
+	8,  // 29: slash.api.v1.ShortcutService.CreateShortcut:input_type -> slash.api.v1.CreateShortcutRequest
+	9,  // 30: slash.api.v1.ShortcutService.UpdateShortcut:input_type -> slash.api.v1.UpdateShortcutRequest
+	10, // 31: slash.api.v1.ShortcutService.DeleteShortcut:input_type -> slash.api.v1.DeleteShortcutRequest
+	11, // 32: slash.api.v1.ShortcutService.UndeleteShortcut:input_type -> slash.api.v1.UndeleteShortcutRequest
+	16, // 33: slash.api.v1.ShortcutService.ImportShortcuts:input_type -> slash.api.v1.ImportShortcutsRequest
+	13, // 34: slash.api.v1.ShortcutService.ListShortcutRevisions:input_type -> slash.api.v1.ListShortcutRevisionsRequest
+	15, // 35: slash.api.v1.ShortcutService.RestoreShortcutRevision:input_type -> slash.api.v1.RestoreShortcutRevisionRequest
+	18, // 36: slash.api.v1.ShortcutService.GetShortcutAnalytics:input_type -> slash.api.v1.GetShortcutAnalyticsRequest
+	5,  // 37: slash.api.v1.ShortcutService.ListShortcuts:output_type -> slash.api.v1.ListShortcutsResponse
+	3,  // 38: slash.api.v1.ShortcutService.GetShortcut:output_type -> slash.api.v1.Shortcut
+	3,  // 39: slash.api.v1.ShortcutService.GetShortcutByName:output_type -> slash.api.v1.Shortcut
+	3,  // 40: slash.api.v1.ShortcutService.CreateShortcut:output_type -> slash.api.v1.Shortcut
+	3,  // 41: slash.api.v1.ShortcutService.UpdateShortcut:output_type -> slash.api.v1.Shortcut
+	29, // 42: slash.api.v1.ShortcutService.DeleteShortcut:output_type -> google.protobuf.Empty
+	3,  // 43: slash.api.v1.ShortcutService.UndeleteShortcut:output_type -> slash.api.v1.Shortcut
+	17, // 44: slash.api.v1.ShortcutService.ImportShortcuts:output_type -> slash.api.v1.ImportShortcutsResponse
+	14, // 45: slash.api.v1.ShortcutService.ListShortcutRevisions:output_type -> slash.api.v1.ListShortcutRevisionsResponse
+	3,  // 46: slash.api.v1.ShortcutService.RestoreShortcutRevision:output_type -> slash.api.v1.Shortcut
+	19, // 47: slash.api.v1.ShortcutService.GetShortcutAnalytics:output_type -> slash.api.v1.GetShortcutAnalyticsResponse
+	37, // [37:48] is the sub-list for method output_type
+	26, // [26:37] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_shortcut_service_proto_init() }
@@ -820,13 +1823,14 @@ func file_api_v1_shortcut_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_shortcut_service_proto_rawDesc), len(file_api_v1_shortcut_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      3,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_v1_shortcut_service_proto_goTypes,
 		DependencyIndexes: file_api_v1_shortcut_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_shortcut_service_proto_enumTypes,
 		MessageInfos:      file_api_v1_shortcut_service_proto_msgTypes,
 	}.Build()
 	File_api_v1_shortcut_service_proto = out.File
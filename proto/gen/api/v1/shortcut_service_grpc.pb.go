@@ -20,13 +20,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ShortcutService_ListShortcuts_FullMethodName        = "/slash.api.v1.ShortcutService/ListShortcuts"
-	ShortcutService_GetShortcut_FullMethodName          = "/slash.api.v1.ShortcutService/GetShortcut"
-	ShortcutService_GetShortcutByName_FullMethodName    = "/slash.api.v1.ShortcutService/GetShortcutByName"
-	ShortcutService_CreateShortcut_FullMethodName       = "/slash.api.v1.ShortcutService/CreateShortcut"
-	ShortcutService_UpdateShortcut_FullMethodName       = "/slash.api.v1.ShortcutService/UpdateShortcut"
-	ShortcutService_DeleteShortcut_FullMethodName       = "/slash.api.v1.ShortcutService/DeleteShortcut"
-	ShortcutService_GetShortcutAnalytics_FullMethodName = "/slash.api.v1.ShortcutService/GetShortcutAnalytics"
+	ShortcutService_ListShortcuts_FullMethodName           = "/slash.api.v1.ShortcutService/ListShortcuts"
+	ShortcutService_GetShortcut_FullMethodName             = "/slash.api.v1.ShortcutService/GetShortcut"
+	ShortcutService_GetShortcutByName_FullMethodName       = "/slash.api.v1.ShortcutService/GetShortcutByName"
+	ShortcutService_CreateShortcut_FullMethodName          = "/slash.api.v1.ShortcutService/CreateShortcut"
+	ShortcutService_UpdateShortcut_FullMethodName          = "/slash.api.v1.ShortcutService/UpdateShortcut"
+	ShortcutService_DeleteShortcut_FullMethodName          = "/slash.api.v1.ShortcutService/DeleteShortcut"
+	ShortcutService_UndeleteShortcut_FullMethodName        = "/slash.api.v1.ShortcutService/UndeleteShortcut"
+	ShortcutService_ImportShortcuts_FullMethodName         = "/slash.api.v1.ShortcutService/ImportShortcuts"
+	ShortcutService_ListShortcutRevisions_FullMethodName   = "/slash.api.v1.ShortcutService/ListShortcutRevisions"
+	ShortcutService_RestoreShortcutRevision_FullMethodName = "/slash.api.v1.ShortcutService/RestoreShortcutRevision"
+	ShortcutService_GetShortcutAnalytics_FullMethodName    = "/slash.api.v1.ShortcutService/GetShortcutAnalytics"
 )
 
 // ShortcutServiceClient is the client API for ShortcutService service.
@@ -43,8 +47,17 @@ type ShortcutServiceClient interface {
 	CreateShortcut(ctx context.Context, in *CreateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
 	// UpdateShortcut updates a shortcut.
 	UpdateShortcut(ctx context.Context, in *UpdateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
-	// DeleteShortcut deletes a shortcut by name.
+	// DeleteShortcut moves a shortcut to the trash, or purges it permanently.
 	DeleteShortcut(ctx context.Context, in *DeleteShortcutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteShortcut restores a shortcut from the trash.
+	UndeleteShortcut(ctx context.Context, in *UndeleteShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
+	// ImportShortcuts creates shortcuts in bulk from a CSV, JSON or bookmark HTML file.
+	// Every row is saved on its own, and the failed rows are reported without stopping the import.
+	ImportShortcuts(ctx context.Context, in *ImportShortcutsRequest, opts ...grpc.CallOption) (*ImportShortcutsResponse, error)
+	// ListShortcutRevisions returns the revisions of a shortcut, newest first.
+	ListShortcutRevisions(ctx context.Context, in *ListShortcutRevisionsRequest, opts ...grpc.CallOption) (*ListShortcutRevisionsResponse, error)
+	// RestoreShortcutRevision rolls a shortcut back to the values before the revision.
+	RestoreShortcutRevision(ctx context.Context, in *RestoreShortcutRevisionRequest, opts ...grpc.CallOption) (*Shortcut, error)
 	// GetShortcutAnalytics returns the analytics for a shortcut.
 	GetShortcutAnalytics(ctx context.Context, in *GetShortcutAnalyticsRequest, opts ...grpc.CallOption) (*GetShortcutAnalyticsResponse, error)
 }
@@ -117,6 +130,46 @@ func (c *shortcutServiceClient) DeleteShortcut(ctx context.Context, in *DeleteSh
 	return out, nil
 }
 
+func (c *shortcutServiceClient) UndeleteShortcut(ctx context.Context, in *UndeleteShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shortcut)
+	err := c.cc.Invoke(ctx, ShortcutService_UndeleteShortcut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ImportShortcuts(ctx context.Context, in *ImportShortcutsRequest, opts ...grpc.CallOption) (*ImportShortcutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportShortcutsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ImportShortcuts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListShortcutRevisions(ctx context.Context, in *ListShortcutRevisionsRequest, opts ...grpc.CallOption) (*ListShortcutRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShortcutRevisionsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListShortcutRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) RestoreShortcutRevision(ctx context.Context, in *RestoreShortcutRevisionRequest, opts ...grpc.CallOption) (*Shortcut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shortcut)
+	err := c.cc.Invoke(ctx, ShortcutService_RestoreShortcutRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortcutServiceClient) GetShortcutAnalytics(ctx context.Context, in *GetShortcutAnalyticsRequest, opts ...grpc.CallOption) (*GetShortcutAnalyticsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetShortcutAnalyticsResponse)
@@ -141,8 +194,17 @@ type ShortcutServiceServer interface {
 	CreateShortcut(context.Context, *CreateShortcutRequest) (*Shortcut, error)
 	// UpdateShortcut updates a shortcut.
 	UpdateShortcut(context.Context, *UpdateShortcutRequest) (*Shortcut, error)
-	// DeleteShortcut deletes a shortcut by name.
+	// DeleteShortcut moves a shortcut to the trash, or purges it permanently.
 	DeleteShortcut(context.Context, *DeleteShortcutRequest) (*emptypb.Empty, error)
+	// UndeleteShortcut restores a shortcut from the trash.
+	UndeleteShortcut(context.Context, *UndeleteShortcutRequest) (*Shortcut, error)
+	// ImportShortcuts creates shortcuts in bulk from a CSV, JSON or bookmark HTML file.
+	// Every row is saved on its own, and the failed rows are reported without stopping the import.
+	ImportShortcuts(context.Context, *ImportShortcutsRequest) (*ImportShortcutsResponse, error)
+	// ListShortcutRevisions returns the revisions of a shortcut, newest first.
+	ListShortcutRevisions(context.Context, *ListShortcutRevisionsRequest) (*ListShortcutRevisionsResponse, error)
+	// RestoreShortcutRevision rolls a shortcut back to the values before the revision.
+	RestoreShortcutRevision(context.Context, *RestoreShortcutRevisionRequest) (*Shortcut, error)
 	// GetShortcutAnalytics returns the analytics for a shortcut.
 	GetShortcutAnalytics(context.Context, *GetShortcutAnalyticsRequest) (*GetShortcutAnalyticsResponse, error)
 	mustEmbedUnimplementedShortcutServiceServer()
@@ -173,6 +235,18 @@ func (UnimplementedShortcutServiceServer) UpdateShortcut(context.Context, *Updat
 func (UnimplementedShortcutServiceServer) DeleteShortcut(context.Context, *DeleteShortcutRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteShortcut not implemented")
 }
+func (UnimplementedShortcutServiceServer) UndeleteShortcut(context.Context, *UndeleteShortcutRequest) (*Shortcut, error) {
+	return nil, status.Error(codes.Unimplemented, "method UndeleteShortcut not implemented")
+}
+func (UnimplementedShortcutServiceServer) ImportShortcuts(context.Context, *ImportShortcutsRequest) (*ImportShortcutsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportShortcuts not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListShortcutRevisions(context.Context, *ListShortcutRevisionsRequest) (*ListShortcutRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListShortcutRevisions not implemented")
+}
+func (UnimplementedShortcutServiceServer) RestoreShortcutRevision(context.Context, *RestoreShortcutRevisionRequest) (*Shortcut, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreShortcutRevision not implemented")
+}
 func (UnimplementedShortcutServiceServer) GetShortcutAnalytics(context.Context, *GetShortcutAnalyticsRequest) (*GetShortcutAnalyticsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetShortcutAnalytics not implemented")
 }
@@ -305,6 +379,78 @@ func _ShortcutService_DeleteShortcut_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortcutService_UndeleteShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).UndeleteShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_UndeleteShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).UndeleteShortcut(ctx, req.(*UndeleteShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ImportShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportShortcutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ImportShortcuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ImportShortcuts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ImportShortcuts(ctx, req.(*ImportShortcutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ListShortcutRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcutRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcutRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcutRevisions(ctx, req.(*ListShortcutRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_RestoreShortcutRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreShortcutRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).RestoreShortcutRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_RestoreShortcutRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).RestoreShortcutRevision(ctx, req.(*RestoreShortcutRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShortcutService_GetShortcutAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetShortcutAnalyticsRequest)
 	if err := dec(in); err != nil {
@@ -354,6 +500,22 @@ var ShortcutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteShortcut",
 			Handler:    _ShortcutService_DeleteShortcut_Handler,
 		},
+		{
+			MethodName: "UndeleteShortcut",
+			Handler:    _ShortcutService_UndeleteShortcut_Handler,
+		},
+		{
+			MethodName: "ImportShortcuts",
+			Handler:    _ShortcutService_ImportShortcuts_Handler,
+		},
+		{
+			MethodName: "ListShortcutRevisions",
+			Handler:    _ShortcutService_ListShortcutRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreShortcutRevision",
+			Handler:    _ShortcutService_RestoreShortcutRevision_Handler,
+		},
 		{
 			MethodName: "GetShortcutAnalytics",
 			Handler:    _ShortcutService_GetShortcutAnalytics_Handler,
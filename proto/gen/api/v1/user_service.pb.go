@@ -31,6 +31,12 @@ const (
 	Role_ROLE_UNSPECIFIED Role = 0
 	Role_ADMIN            Role = 1
 	Role_USER             Role = 2
+	// The editors can edit all shortcuts and collections by default.
+	Role_EDITOR Role = 3
+	// The viewers can only view shortcuts and collections by default.
+	Role_VIEWER Role = 4
+	// The analysts can view the workspace analytics by default.
+	Role_ANALYST Role = 5
 )
 
 // Enum value maps for Role.
@@ -39,11 +45,17 @@ var (
 		0: "ROLE_UNSPECIFIED",
 		1: "ADMIN",
 		2: "USER",
+		3: "EDITOR",
+		4: "VIEWER",
+		5: "ANALYST",
 	}
 	Role_value = map[string]int32{
 		"ROLE_UNSPECIFIED": 0,
 		"ADMIN":            1,
 		"USER":             2,
+		"EDITOR":           3,
+		"VIEWER":           4,
+		"ANALYST":          5,
 	}
 )
 
@@ -395,10 +407,15 @@ func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 }
 
 type DeleteUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The id of the user to transfer the shortcuts and collections to.
+	// Either it or transfer_to_group_id is required if the user owns any of them.
+	TransferToUserId int32 `protobuf:"varint,2,opt,name=transfer_to_user_id,json=transferToUserId,proto3" json:"transfer_to_user_id,omitempty"`
+	// The id of the group to transfer the shortcuts and collections to.
+	TransferToGroupId int32 `protobuf:"varint,3,opt,name=transfer_to_group_id,json=transferToGroupId,proto3" json:"transfer_to_group_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *DeleteUserRequest) Reset() {
@@ -438,6 +455,137 @@ func (x *DeleteUserRequest) GetId() int32 {
 	return 0
 }
 
+func (x *DeleteUserRequest) GetTransferToUserId() int32 {
+	if x != nil {
+		return x.TransferToUserId
+	}
+	return 0
+}
+
+func (x *DeleteUserRequest) GetTransferToGroupId() int32 {
+	if x != nil {
+		return x.TransferToGroupId
+	}
+	return 0
+}
+
+type TransferOwnershipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the user id.
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The id of the user to transfer the shortcuts and collections to.
+	// The personal shortcuts are moved into the personal namespace of the user.
+	TransferToUserId int32 `protobuf:"varint,2,opt,name=transfer_to_user_id,json=transferToUserId,proto3" json:"transfer_to_user_id,omitempty"`
+	// The id of the group to transfer the shortcuts and collections to, instead of a user.
+	// The caller becomes their creator, and the personal shortcuts are moved into the workspace namespace.
+	TransferToGroupId int32 `protobuf:"varint,3,opt,name=transfer_to_group_id,json=transferToGroupId,proto3" json:"transfer_to_group_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TransferOwnershipRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransferOwnershipRequest) GetTransferToUserId() int32 {
+	if x != nil {
+		return x.TransferToUserId
+	}
+	return 0
+}
+
+func (x *TransferOwnershipRequest) GetTransferToGroupId() int32 {
+	if x != nil {
+		return x.TransferToGroupId
+	}
+	return 0
+}
+
+type TransferOwnershipResponse struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	TransferredShortcutCount   int32                  `protobuf:"varint,1,opt,name=transferred_shortcut_count,json=transferredShortcutCount,proto3" json:"transferred_shortcut_count,omitempty"`
+	TransferredCollectionCount int32                  `protobuf:"varint,2,opt,name=transferred_collection_count,json=transferredCollectionCount,proto3" json:"transferred_collection_count,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipResponse) Reset() {
+	*x = TransferOwnershipResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TransferOwnershipResponse) GetTransferredShortcutCount() int32 {
+	if x != nil {
+		return x.TransferredShortcutCount
+	}
+	return 0
+}
+
+func (x *TransferOwnershipResponse) GetTransferredCollectionCount() int32 {
+	if x != nil {
+		return x.TransferredCollectionCount
+	}
+	return 0
+}
+
 type ListUserAccessTokensRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// id is the user id.
@@ -448,7 +596,7 @@ type ListUserAccessTokensRequest struct {
 
 func (x *ListUserAccessTokensRequest) Reset() {
 	*x = ListUserAccessTokensRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -460,7 +608,7 @@ func (x *ListUserAccessTokensRequest) String() string {
 func (*ListUserAccessTokensRequest) ProtoMessage() {}
 
 func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +621,7 @@ func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserAccessTokensRequest.ProtoReflect.Descriptor instead.
 func (*ListUserAccessTokensRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListUserAccessTokensRequest) GetId() int32 {
@@ -492,7 +640,7 @@ type ListUserAccessTokensResponse struct {
 
 func (x *ListUserAccessTokensResponse) Reset() {
 	*x = ListUserAccessTokensResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -504,7 +652,7 @@ func (x *ListUserAccessTokensResponse) String() string {
 func (*ListUserAccessTokensResponse) ProtoMessage() {}
 
 func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +665,7 @@ func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserAccessTokensResponse.ProtoReflect.Descriptor instead.
 func (*ListUserAccessTokensResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{8}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListUserAccessTokensResponse) GetAccessTokens() []*UserAccessToken {
@@ -542,7 +690,7 @@ type CreateUserAccessTokenRequest struct {
 
 func (x *CreateUserAccessTokenRequest) Reset() {
 	*x = CreateUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -554,7 +702,7 @@ func (x *CreateUserAccessTokenRequest) String() string {
 func (*CreateUserAccessTokenRequest) ProtoMessage() {}
 
 func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +715,7 @@ func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateUserAccessTokenRequest) GetId() int32 {
@@ -603,7 +751,7 @@ type DeleteUserAccessTokenRequest struct {
 
 func (x *DeleteUserAccessTokenRequest) Reset() {
 	*x = DeleteUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -615,7 +763,7 @@ func (x *DeleteUserAccessTokenRequest) String() string {
 func (*DeleteUserAccessTokenRequest) ProtoMessage() {}
 
 func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +776,7 @@ func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteUserAccessTokenRequest) GetId() int32 {
@@ -657,7 +805,7 @@ type UserAccessToken struct {
 
 func (x *UserAccessToken) Reset() {
 	*x = UserAccessToken{}
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -669,7 +817,7 @@ func (x *UserAccessToken) String() string {
 func (*UserAccessToken) ProtoMessage() {}
 
 func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,7 +830,7 @@ func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAccessToken.ProtoReflect.Descriptor instead.
 func (*UserAccessToken) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UserAccessToken) GetAccessToken() string {
@@ -737,9 +885,18 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x11UpdateUserRequest\x12&\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.slash.api.v1.UserR\x04user\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"#\n" +
+	"updateMask\"\x83\x01\n" +
 	"\x11DeleteUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"-\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12-\n" +
+	"\x13transfer_to_user_id\x18\x02 \x01(\x05R\x10transferToUserId\x12/\n" +
+	"\x14transfer_to_group_id\x18\x03 \x01(\x05R\x11transferToGroupId\"\x8a\x01\n" +
+	"\x18TransferOwnershipRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12-\n" +
+	"\x13transfer_to_user_id\x18\x02 \x01(\x05R\x10transferToUserId\x12/\n" +
+	"\x14transfer_to_group_id\x18\x03 \x01(\x05R\x11transferToGroupId\"\x9b\x01\n" +
+	"\x19TransferOwnershipResponse\x12<\n" +
+	"\x1atransferred_shortcut_count\x18\x01 \x01(\x05R\x18transferredShortcutCount\x12@\n" +
+	"\x1ctransferred_collection_count\x18\x02 \x01(\x05R\x1atransferredCollectionCount\"-\n" +
 	"\x1bListUserAccessTokensRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"b\n" +
 	"\x1cListUserAccessTokensResponse\x12B\n" +
@@ -758,11 +915,16 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x127\n" +
 	"\tissued_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x129\n" +
 	"\n" +
-	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt*1\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt*V\n" +
 	"\x04Role\x12\x14\n" +
 	"\x10ROLE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05ADMIN\x10\x01\x12\b\n" +
-	"\x04USER\x10\x022\xf4\a\n" +
+	"\x04USER\x10\x02\x12\n" +
+	"\n" +
+	"\x06EDITOR\x10\x03\x12\n" +
+	"\n" +
+	"\x06VIEWER\x10\x04\x12\v\n" +
+	"\aANALYST\x10\x052\x91\t\n" +
 	"\vUserService\x12c\n" +
 	"\tListUsers\x12\x1e.slash.api.v1.ListUsersRequest\x1a\x1f.slash.api.v1.ListUsersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x12\\\n" +
 	"\aGetUser\x12\x1c.slash.api.v1.GetUserRequest\x1a\x12.slash.api.v1.User\"\x1f\xdaA\x02id\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/users/{id}\x12^\n" +
@@ -771,7 +933,8 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\n" +
 	"UpdateUser\x12\x1f.slash.api.v1.UpdateUserRequest\x1a\x12.slash.api.v1.User\"8\xdaA\x10user,update_mask\x82\xd3\xe4\x93\x02\x1f:\x04user2\x17/api/v1/users/{user.id}\x12f\n" +
 	"\n" +
-	"DeleteUser\x12\x1f.slash.api.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"\x1f\xdaA\x02id\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/users/{id}\x12\x9c\x01\n" +
+	"DeleteUser\x12\x1f.slash.api.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"\x1f\xdaA\x02id\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/users/{id}\x12\x9a\x01\n" +
+	"\x11TransferOwnership\x12&.slash.api.v1.TransferOwnershipRequest\x1a'.slash.api.v1.TransferOwnershipResponse\"4\xdaA\x02id\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/users/{id}:transferOwnership\x12\x9c\x01\n" +
 	"\x14ListUserAccessTokens\x12).slash.api.v1.ListUserAccessTokensRequest\x1a*.slash.api.v1.ListUserAccessTokensResponse\"-\xdaA\x02id\x82\xd3\xe4\x93\x02\"\x12 /api/v1/users/{id}/access_tokens\x12\x94\x01\n" +
 	"\x15CreateUserAccessToken\x12*.slash.api.v1.CreateUserAccessTokenRequest\x1a\x1d.slash.api.v1.UserAccessToken\"0\xdaA\x02id\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/users/{id}/access_tokens\x12\xa6\x01\n" +
 	"\x15DeleteUserAccessToken\x12*.slash.api.v1.DeleteUserAccessTokenRequest\x1a\x16.google.protobuf.Empty\"I\xdaA\x0fid,access_token\x82\xd3\xe4\x93\x021*//api/v1/users/{id}/access_tokens/{access_token}B\xae\x01\n" +
@@ -790,7 +953,7 @@ func file_api_v1_user_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_api_v1_user_service_proto_goTypes = []any{
 	(Role)(0),                            // 0: slash.api.v1.Role
 	(*User)(nil),                         // 1: slash.api.v1.User
@@ -800,47 +963,51 @@ var file_api_v1_user_service_proto_goTypes = []any{
 	(*CreateUserRequest)(nil),            // 5: slash.api.v1.CreateUserRequest
 	(*UpdateUserRequest)(nil),            // 6: slash.api.v1.UpdateUserRequest
 	(*DeleteUserRequest)(nil),            // 7: slash.api.v1.DeleteUserRequest
-	(*ListUserAccessTokensRequest)(nil),  // 8: slash.api.v1.ListUserAccessTokensRequest
-	(*ListUserAccessTokensResponse)(nil), // 9: slash.api.v1.ListUserAccessTokensResponse
-	(*CreateUserAccessTokenRequest)(nil), // 10: slash.api.v1.CreateUserAccessTokenRequest
-	(*DeleteUserAccessTokenRequest)(nil), // 11: slash.api.v1.DeleteUserAccessTokenRequest
-	(*UserAccessToken)(nil),              // 12: slash.api.v1.UserAccessToken
-	(State)(0),                           // 13: slash.api.v1.State
-	(*timestamppb.Timestamp)(nil),        // 14: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),        // 15: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                // 16: google.protobuf.Empty
+	(*TransferOwnershipRequest)(nil),     // 8: slash.api.v1.TransferOwnershipRequest
+	(*TransferOwnershipResponse)(nil),    // 9: slash.api.v1.TransferOwnershipResponse
+	(*ListUserAccessTokensRequest)(nil),  // 10: slash.api.v1.ListUserAccessTokensRequest
+	(*ListUserAccessTokensResponse)(nil), // 11: slash.api.v1.ListUserAccessTokensResponse
+	(*CreateUserAccessTokenRequest)(nil), // 12: slash.api.v1.CreateUserAccessTokenRequest
+	(*DeleteUserAccessTokenRequest)(nil), // 13: slash.api.v1.DeleteUserAccessTokenRequest
+	(*UserAccessToken)(nil),              // 14: slash.api.v1.UserAccessToken
+	(State)(0),                           // 15: slash.api.v1.State
+	(*timestamppb.Timestamp)(nil),        // 16: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),        // 17: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                // 18: google.protobuf.Empty
 }
 var file_api_v1_user_service_proto_depIdxs = []int32{
-	13, // 0: slash.api.v1.User.state:type_name -> slash.api.v1.State
-	14, // 1: slash.api.v1.User.created_time:type_name -> google.protobuf.Timestamp
-	14, // 2: slash.api.v1.User.updated_time:type_name -> google.protobuf.Timestamp
+	15, // 0: slash.api.v1.User.state:type_name -> slash.api.v1.State
+	16, // 1: slash.api.v1.User.created_time:type_name -> google.protobuf.Timestamp
+	16, // 2: slash.api.v1.User.updated_time:type_name -> google.protobuf.Timestamp
 	0,  // 3: slash.api.v1.User.role:type_name -> slash.api.v1.Role
 	1,  // 4: slash.api.v1.ListUsersResponse.users:type_name -> slash.api.v1.User
 	1,  // 5: slash.api.v1.CreateUserRequest.user:type_name -> slash.api.v1.User
 	1,  // 6: slash.api.v1.UpdateUserRequest.user:type_name -> slash.api.v1.User
-	15, // 7: slash.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	12, // 8: slash.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> slash.api.v1.UserAccessToken
-	14, // 9: slash.api.v1.CreateUserAccessTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
-	14, // 10: slash.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
-	14, // 11: slash.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	17, // 7: slash.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	14, // 8: slash.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> slash.api.v1.UserAccessToken
+	16, // 9: slash.api.v1.CreateUserAccessTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	16, // 10: slash.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
+	16, // 11: slash.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
 	2,  // 12: slash.api.v1.UserService.ListUsers:input_type -> slash.api.v1.ListUsersRequest
 	4,  // 13: slash.api.v1.UserService.GetUser:input_type -> slash.api.v1.GetUserRequest
 	5,  // 14: slash.api.v1.UserService.CreateUser:input_type -> slash.api.v1.CreateUserRequest
 	6,  // 15: slash.api.v1.UserService.UpdateUser:input_type -> slash.api.v1.UpdateUserRequest
 	7,  // 16: slash.api.v1.UserService.DeleteUser:input_type -> slash.api.v1.DeleteUserRequest
-	8,  // 17: slash.api.v1.UserService.ListUserAccessTokens:input_type -> slash.api.v1.ListUserAccessTokensRequest
-	10, // 18: slash.api.v1.UserService.CreateUserAccessToken:input_type -> slash.api.v1.CreateUserAccessTokenRequest
-	11, // 19: slash.api.v1.UserService.DeleteUserAccessToken:input_type -> slash.api.v1.DeleteUserAccessTokenRequest
-	3,  // 20: slash.api.v1.UserService.ListUsers:output_type -> slash.api.v1.ListUsersResponse
-	1,  // 21: slash.api.v1.UserService.GetUser:output_type -> slash.api.v1.User
-	1,  // 22: slash.api.v1.UserService.CreateUser:output_type -> slash.api.v1.User
-	1,  // 23: slash.api.v1.UserService.UpdateUser:output_type -> slash.api.v1.User
-	16, // 24: slash.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	9,  // 25: slash.api.v1.UserService.ListUserAccessTokens:output_type -> slash.api.v1.ListUserAccessTokensResponse
-	12, // 26: slash.api.v1.UserService.CreateUserAccessToken:output_type -> slash.api.v1.UserAccessToken
-	16, // 27: slash.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
-	20, // [20:28] is the sub-list for method output_type
-	12, // [12:20] is the sub-list for method input_type
+	8,  // 17: slash.api.v1.UserService.TransferOwnership:input_type -> slash.api.v1.TransferOwnershipRequest
+	10, // 18: slash.api.v1.UserService.ListUserAccessTokens:input_type -> slash.api.v1.ListUserAccessTokensRequest
+	12, // 19: slash.api.v1.UserService.CreateUserAccessToken:input_type -> slash.api.v1.CreateUserAccessTokenRequest
+	13, // 20: slash.api.v1.UserService.DeleteUserAccessToken:input_type -> slash.api.v1.DeleteUserAccessTokenRequest
+	3,  // 21: slash.api.v1.UserService.ListUsers:output_type -> slash.api.v1.ListUsersResponse
+	1,  // 22: slash.api.v1.UserService.GetUser:output_type -> slash.api.v1.User
+	1,  // 23: slash.api.v1.UserService.CreateUser:output_type -> slash.api.v1.User
+	1,  // 24: slash.api.v1.UserService.UpdateUser:output_type -> slash.api.v1.User
+	18, // 25: slash.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	9,  // 26: slash.api.v1.UserService.TransferOwnership:output_type -> slash.api.v1.TransferOwnershipResponse
+	11, // 27: slash.api.v1.UserService.ListUserAccessTokens:output_type -> slash.api.v1.ListUserAccessTokensResponse
+	14, // 28: slash.api.v1.UserService.CreateUserAccessToken:output_type -> slash.api.v1.UserAccessToken
+	18, // 29: slash.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
+	21, // [21:30] is the sub-list for method output_type
+	12, // [12:21] is the sub-list for method input_type
 	12, // [12:12] is the sub-list for extension type_name
 	12, // [12:12] is the sub-list for extension extendee
 	0,  // [0:12] is the sub-list for field type_name
@@ -852,14 +1019,14 @@ func file_api_v1_user_service_proto_init() {
 		return
 	}
 	file_api_v1_common_proto_init()
-	file_api_v1_user_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_api_v1_user_service_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_user_service_proto_rawDesc), len(file_api_v1_user_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   12,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
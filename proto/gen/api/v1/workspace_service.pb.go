@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,11 +24,66 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type QueryMergeStrategy int32
+
+const (
+	QueryMergeStrategy_QUERY_MERGE_STRATEGY_UNSPECIFIED QueryMergeStrategy = 0
+	QueryMergeStrategy_APPEND                           QueryMergeStrategy = 1
+	QueryMergeStrategy_OVERRIDE                         QueryMergeStrategy = 2
+	QueryMergeStrategy_IGNORE                           QueryMergeStrategy = 3
+)
+
+// Enum value maps for QueryMergeStrategy.
+var (
+	QueryMergeStrategy_name = map[int32]string{
+		0: "QUERY_MERGE_STRATEGY_UNSPECIFIED",
+		1: "APPEND",
+		2: "OVERRIDE",
+		3: "IGNORE",
+	}
+	QueryMergeStrategy_value = map[string]int32{
+		"QUERY_MERGE_STRATEGY_UNSPECIFIED": 0,
+		"APPEND":                           1,
+		"OVERRIDE":                         2,
+		"IGNORE":                           3,
+	}
+)
+
+func (x QueryMergeStrategy) Enum() *QueryMergeStrategy {
+	p := new(QueryMergeStrategy)
+	*p = x
+	return p
+}
+
+func (x QueryMergeStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryMergeStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[0].Descriptor()
+}
+
+func (QueryMergeStrategy) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[0]
+}
+
+func (x QueryMergeStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryMergeStrategy.Descriptor instead.
+func (QueryMergeStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{0}
+}
+
 type IdentityProvider_Type int32
 
 const (
 	IdentityProvider_TYPE_UNSPECIFIED IdentityProvider_Type = 0
 	IdentityProvider_OAUTH2           IdentityProvider_Type = 1
+	IdentityProvider_OIDC             IdentityProvider_Type = 2
+	IdentityProvider_SAML             IdentityProvider_Type = 3
+	IdentityProvider_LDAP             IdentityProvider_Type = 4
 )
 
 // Enum value maps for IdentityProvider_Type.
@@ -35,10 +91,16 @@ var (
 	IdentityProvider_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "OAUTH2",
+		2: "OIDC",
+		3: "SAML",
+		4: "LDAP",
 	}
 	IdentityProvider_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"OAUTH2":           1,
+		"OIDC":             2,
+		"SAML":             3,
+		"LDAP":             4,
 	}
 )
 
@@ -53,11 +115,11 @@ func (x IdentityProvider_Type) String() string {
 }
 
 func (IdentityProvider_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_workspace_service_proto_enumTypes[0].Descriptor()
+	return file_api_v1_workspace_service_proto_enumTypes[1].Descriptor()
 }
 
 func (IdentityProvider_Type) Type() protoreflect.EnumType {
-	return &file_api_v1_workspace_service_proto_enumTypes[0]
+	return &file_api_v1_workspace_service_proto_enumTypes[1]
 }
 
 func (x IdentityProvider_Type) Number() protoreflect.EnumNumber {
@@ -66,7 +128,7 @@ func (x IdentityProvider_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IdentityProvider_Type.Descriptor instead.
 func (IdentityProvider_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 0}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3, 0}
 }
 
 type WorkspaceProfile struct {
@@ -165,8 +227,23 @@ type WorkspaceSetting struct {
 	DisallowUserRegistration bool `protobuf:"varint,6,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
 	// Whether to disallow password authentication.
 	DisallowPasswordAuth bool `protobuf:"varint,7,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Whether to append the extra path after the shortcut name to the shortcut link.
+	EnablePathForwarding bool `protobuf:"varint,8,opt,name=enable_path_forwarding,json=enablePathForwarding,proto3" json:"enable_path_forwarding,omitempty"`
+	// How the query params of the request are merged into the shortcut link.
+	QueryMergeStrategy QueryMergeStrategy `protobuf:"varint,9,opt,name=query_merge_strategy,json=queryMergeStrategy,proto3,enum=slash.api.v1.QueryMergeStrategy" json:"query_merge_strategy,omitempty"`
+	// The number of days the archived shortcuts are kept in the trash before they are purged.
+	TrashRetentionDays int32 `protobuf:"varint,10,opt,name=trash_retention_days,json=trashRetentionDays,proto3" json:"trash_retention_days,omitempty"`
+	// The number of days the shortcut view activities are kept before they are compacted into the daily summaries.
+	// The activities are kept forever if 0.
+	ActivityRetentionDays int32 `protobuf:"varint,11,opt,name=activity_retention_days,json=activityRetentionDays,proto3" json:"activity_retention_days,omitempty"`
+	// The permissions granted to the roles, overriding the default ones.
+	// The ADMIN role always has all permissions.
+	RolePermissions []*RolePermission `protobuf:"bytes,12,rep,name=role_permissions,json=rolePermissions,proto3" json:"role_permissions,omitempty"`
+	// Whether the SCIM provisioning endpoints are enabled with a token.
+	// It can only be disabled by update, and is enabled by RotateSCIMToken.
+	ScimEnabled   bool `protobuf:"varint,13,opt,name=scim_enabled,json=scimEnabled,proto3" json:"scim_enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WorkspaceSetting) Reset() {
@@ -241,20 +318,120 @@ func (x *WorkspaceSetting) GetDisallowPasswordAuth() bool {
 	return false
 }
 
-type IdentityProvider struct {
+func (x *WorkspaceSetting) GetEnablePathForwarding() bool {
+	if x != nil {
+		return x.EnablePathForwarding
+	}
+	return false
+}
+
+func (x *WorkspaceSetting) GetQueryMergeStrategy() QueryMergeStrategy {
+	if x != nil {
+		return x.QueryMergeStrategy
+	}
+	return QueryMergeStrategy_QUERY_MERGE_STRATEGY_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting) GetTrashRetentionDays() int32 {
+	if x != nil {
+		return x.TrashRetentionDays
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting) GetActivityRetentionDays() int32 {
+	if x != nil {
+		return x.ActivityRetentionDays
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting) GetRolePermissions() []*RolePermission {
+	if x != nil {
+		return x.RolePermissions
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetScimEnabled() bool {
+	if x != nil {
+		return x.ScimEnabled
+	}
+	return false
+}
+
+type RolePermission struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The unique identifier of the identity provider.
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Type          IdentityProvider_Type   `protobuf:"varint,3,opt,name=type,proto3,enum=slash.api.v1.IdentityProvider_Type" json:"type,omitempty"`
-	Config        *IdentityProviderConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	Role  Role                   `protobuf:"varint,1,opt,name=role,proto3,enum=slash.api.v1.Role" json:"role,omitempty"`
+	// The permissions of the role, e.g. "shortcuts.create". The "workspace.manage" permission is only granted to the admin role.
+	Permissions   []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *RolePermission) Reset() {
+	*x = RolePermission{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolePermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolePermission) ProtoMessage() {}
+
+func (x *RolePermission) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolePermission.ProtoReflect.Descriptor instead.
+func (*RolePermission) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RolePermission) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *RolePermission) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type IdentityProvider struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique identifier of the identity provider.
+	Id     string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title  string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Type   IdentityProvider_Type   `protobuf:"varint,3,opt,name=type,proto3,enum=slash.api.v1.IdentityProvider_Type" json:"type,omitempty"`
+	Config *IdentityProviderConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	// The rules deciding the role of the user on every sign in, where the first matching one applies.
+	// The users matching none keep their role, or get the USER role on their first sign in. The last admin is never demoted.
+	RoleMappingRules []*IdentityProvider_RoleMappingRule `protobuf:"bytes,5,rep,name=role_mapping_rules,json=roleMappingRules,proto3" json:"role_mapping_rules,omitempty"`
+	// The email domains of the users to provision on their first sign in, any if empty.
+	AllowedEmailDomains []string `protobuf:"bytes,6,rep,name=allowed_email_domains,json=allowedEmailDomains,proto3" json:"allowed_email_domains,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
 func (x *IdentityProvider) Reset() {
 	*x = IdentityProvider{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -266,7 +443,7 @@ func (x *IdentityProvider) String() string {
 func (*IdentityProvider) ProtoMessage() {}
 
 func (x *IdentityProvider) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,7 +456,7 @@ func (x *IdentityProvider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityProvider.ProtoReflect.Descriptor instead.
 func (*IdentityProvider) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *IdentityProvider) GetId() string {
@@ -310,11 +487,28 @@ func (x *IdentityProvider) GetConfig() *IdentityProviderConfig {
 	return nil
 }
 
+func (x *IdentityProvider) GetRoleMappingRules() []*IdentityProvider_RoleMappingRule {
+	if x != nil {
+		return x.RoleMappingRules
+	}
+	return nil
+}
+
+func (x *IdentityProvider) GetAllowedEmailDomains() []string {
+	if x != nil {
+		return x.AllowedEmailDomains
+	}
+	return nil
+}
+
 type IdentityProviderConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Config:
 	//
 	//	*IdentityProviderConfig_Oauth2
+	//	*IdentityProviderConfig_Oidc
+	//	*IdentityProviderConfig_Saml
+	//	*IdentityProviderConfig_Ldap
 	Config        isIdentityProviderConfig_Config `protobuf_oneof:"config"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -322,7 +516,7 @@ type IdentityProviderConfig struct {
 
 func (x *IdentityProviderConfig) Reset() {
 	*x = IdentityProviderConfig{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -334,7 +528,7 @@ func (x *IdentityProviderConfig) String() string {
 func (*IdentityProviderConfig) ProtoMessage() {}
 
 func (x *IdentityProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +541,7 @@ func (x *IdentityProviderConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityProviderConfig.ProtoReflect.Descriptor instead.
 func (*IdentityProviderConfig) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *IdentityProviderConfig) GetConfig() isIdentityProviderConfig_Config {
@@ -366,6 +560,33 @@ func (x *IdentityProviderConfig) GetOauth2() *IdentityProviderConfig_OAuth2Confi
 	return nil
 }
 
+func (x *IdentityProviderConfig) GetOidc() *IdentityProviderConfig_OIDCConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_Oidc); ok {
+			return x.Oidc
+		}
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig) GetSaml() *IdentityProviderConfig_SAMLConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_Saml); ok {
+			return x.Saml
+		}
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig) GetLdap() *IdentityProviderConfig_LDAPConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_Ldap); ok {
+			return x.Ldap
+		}
+	}
+	return nil
+}
+
 type isIdentityProviderConfig_Config interface {
 	isIdentityProviderConfig_Config()
 }
@@ -374,8 +595,26 @@ type IdentityProviderConfig_Oauth2 struct {
 	Oauth2 *IdentityProviderConfig_OAuth2Config `protobuf:"bytes,1,opt,name=oauth2,proto3,oneof"`
 }
 
+type IdentityProviderConfig_Oidc struct {
+	Oidc *IdentityProviderConfig_OIDCConfig `protobuf:"bytes,2,opt,name=oidc,proto3,oneof"`
+}
+
+type IdentityProviderConfig_Saml struct {
+	Saml *IdentityProviderConfig_SAMLConfig `protobuf:"bytes,3,opt,name=saml,proto3,oneof"`
+}
+
+type IdentityProviderConfig_Ldap struct {
+	Ldap *IdentityProviderConfig_LDAPConfig `protobuf:"bytes,4,opt,name=ldap,proto3,oneof"`
+}
+
 func (*IdentityProviderConfig_Oauth2) isIdentityProviderConfig_Config() {}
 
+func (*IdentityProviderConfig_Oidc) isIdentityProviderConfig_Config() {}
+
+func (*IdentityProviderConfig_Saml) isIdentityProviderConfig_Config() {}
+
+func (*IdentityProviderConfig_Ldap) isIdentityProviderConfig_Config() {}
+
 type GetWorkspaceProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -384,7 +623,7 @@ type GetWorkspaceProfileRequest struct {
 
 func (x *GetWorkspaceProfileRequest) Reset() {
 	*x = GetWorkspaceProfileRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -396,7 +635,7 @@ func (x *GetWorkspaceProfileRequest) String() string {
 func (*GetWorkspaceProfileRequest) ProtoMessage() {}
 
 func (x *GetWorkspaceProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +648,7 @@ func (x *GetWorkspaceProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkspaceProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetWorkspaceProfileRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5}
 }
 
 type GetWorkspaceSettingRequest struct {
@@ -420,7 +659,7 @@ type GetWorkspaceSettingRequest struct {
 
 func (x *GetWorkspaceSettingRequest) Reset() {
 	*x = GetWorkspaceSettingRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -432,7 +671,7 @@ func (x *GetWorkspaceSettingRequest) String() string {
 func (*GetWorkspaceSettingRequest) ProtoMessage() {}
 
 func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +684,7 @@ func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
 func (*GetWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{6}
 }
 
 type UpdateWorkspaceSettingRequest struct {
@@ -460,7 +699,7 @@ type UpdateWorkspaceSettingRequest struct {
 
 func (x *UpdateWorkspaceSettingRequest) Reset() {
 	*x = UpdateWorkspaceSettingRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -472,7 +711,7 @@ func (x *UpdateWorkspaceSettingRequest) String() string {
 func (*UpdateWorkspaceSettingRequest) ProtoMessage() {}
 
 func (x *UpdateWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +724,7 @@ func (x *UpdateWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{6}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateWorkspaceSettingRequest) GetSetting() *WorkspaceSetting {
@@ -502,29 +741,35 @@ func (x *UpdateWorkspaceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
-type IdentityProviderConfig_FieldMapping struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+type GetWorkspaceAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The start of the time range, inclusive. Default to 14 days before the end time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The end of the time range, exclusive. Default to now.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The maximum number of the top shortcuts, creators and referers. Default to 10.
+	TopN int32 `protobuf:"varint,3,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	// The number of days without any view for a shortcut to be inactive. Default to 30.
+	InactiveDays  int32 `protobuf:"varint,4,opt,name=inactive_days,json=inactiveDays,proto3" json:"inactive_days,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IdentityProviderConfig_FieldMapping) Reset() {
-	*x = IdentityProviderConfig_FieldMapping{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+func (x *GetWorkspaceAnalyticsRequest) Reset() {
+	*x = GetWorkspaceAnalyticsRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IdentityProviderConfig_FieldMapping) String() string {
+func (x *GetWorkspaceAnalyticsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IdentityProviderConfig_FieldMapping) ProtoMessage() {}
+func (*GetWorkspaceAnalyticsRequest) ProtoMessage() {}
 
-func (x *IdentityProviderConfig_FieldMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+func (x *GetWorkspaceAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,53 +780,70 @@ func (x *IdentityProviderConfig_FieldMapping) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IdentityProviderConfig_FieldMapping.ProtoReflect.Descriptor instead.
-func (*IdentityProviderConfig_FieldMapping) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use GetWorkspaceAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *IdentityProviderConfig_FieldMapping) GetIdentifier() string {
+func (x *GetWorkspaceAnalyticsRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Identifier
+		return x.StartTime
 	}
-	return ""
+	return nil
 }
 
-func (x *IdentityProviderConfig_FieldMapping) GetDisplayName() string {
+func (x *GetWorkspaceAnalyticsRequest) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DisplayName
+		return x.EndTime
 	}
-	return ""
+	return nil
 }
 
-type IdentityProviderConfig_OAuth2Config struct {
-	state         protoimpl.MessageState               `protogen:"open.v1"`
-	ClientId      string                               `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ClientSecret  string                               `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
-	AuthUrl       string                               `protobuf:"bytes,3,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
-	TokenUrl      string                               `protobuf:"bytes,4,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
-	UserInfoUrl   string                               `protobuf:"bytes,5,opt,name=user_info_url,json=userInfoUrl,proto3" json:"user_info_url,omitempty"`
-	Scopes        []string                             `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
-	FieldMapping  *IdentityProviderConfig_FieldMapping `protobuf:"bytes,7,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+func (x *GetWorkspaceAnalyticsRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+func (x *GetWorkspaceAnalyticsRequest) GetInactiveDays() int32 {
+	if x != nil {
+		return x.InactiveDays
+	}
+	return 0
+}
+
+type GetWorkspaceAnalyticsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The shortcuts with the most views in the time range.
+	TopShortcuts []*GetWorkspaceAnalyticsResponse_ShortcutViewCount `protobuf:"bytes,1,rep,name=top_shortcuts,json=topShortcuts,proto3" json:"top_shortcuts,omitempty"`
+	// The creators whose shortcuts have the most views in the time range.
+	TopCreators []*GetWorkspaceAnalyticsResponse_CreatorViewCount `protobuf:"bytes,2,rep,name=top_creators,json=topCreators,proto3" json:"top_creators,omitempty"`
+	// The shortcuts without any view in the last inactive days, oldest first.
+	InactiveShortcuts []*GetWorkspaceAnalyticsResponse_ShortcutViewCount `protobuf:"bytes,3,rep,name=inactive_shortcuts,json=inactiveShortcuts,proto3" json:"inactive_shortcuts,omitempty"`
+	// The views of every day in the time range.
+	DailyViews []*GetShortcutAnalyticsResponse_TimeBucket `protobuf:"bytes,4,rep,name=daily_views,json=dailyViews,proto3" json:"daily_views,omitempty"`
+	// The referers with the most views in the time range.
+	TopReferers   []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,5,rep,name=top_referers,json=topReferers,proto3" json:"top_referers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IdentityProviderConfig_OAuth2Config) Reset() {
-	*x = IdentityProviderConfig_OAuth2Config{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+func (x *GetWorkspaceAnalyticsResponse) Reset() {
+	*x = GetWorkspaceAnalyticsResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IdentityProviderConfig_OAuth2Config) String() string {
+func (x *GetWorkspaceAnalyticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IdentityProviderConfig_OAuth2Config) ProtoMessage() {}
+func (*GetWorkspaceAnalyticsResponse) ProtoMessage() {}
 
-func (x *IdentityProviderConfig_OAuth2Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+func (x *GetWorkspaceAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,94 +854,1402 @@ func (x *IdentityProviderConfig_OAuth2Config) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IdentityProviderConfig_OAuth2Config.ProtoReflect.Descriptor instead.
-func (*IdentityProviderConfig_OAuth2Config) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3, 1}
+// Deprecated: Use GetWorkspaceAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *IdentityProviderConfig_OAuth2Config) GetClientId() string {
+func (x *GetWorkspaceAnalyticsResponse) GetTopShortcuts() []*GetWorkspaceAnalyticsResponse_ShortcutViewCount {
 	if x != nil {
-		return x.ClientId
+		return x.TopShortcuts
 	}
-	return ""
+	return nil
 }
 
-func (x *IdentityProviderConfig_OAuth2Config) GetClientSecret() string {
+func (x *GetWorkspaceAnalyticsResponse) GetTopCreators() []*GetWorkspaceAnalyticsResponse_CreatorViewCount {
 	if x != nil {
-		return x.ClientSecret
+		return x.TopCreators
 	}
-	return ""
+	return nil
 }
 
-func (x *IdentityProviderConfig_OAuth2Config) GetAuthUrl() string {
+func (x *GetWorkspaceAnalyticsResponse) GetInactiveShortcuts() []*GetWorkspaceAnalyticsResponse_ShortcutViewCount {
 	if x != nil {
-		return x.AuthUrl
+		return x.InactiveShortcuts
 	}
-	return ""
+	return nil
 }
 
-func (x *IdentityProviderConfig_OAuth2Config) GetTokenUrl() string {
+func (x *GetWorkspaceAnalyticsResponse) GetDailyViews() []*GetShortcutAnalyticsResponse_TimeBucket {
 	if x != nil {
-		return x.TokenUrl
+		return x.DailyViews
 	}
-	return ""
+	return nil
 }
 
-func (x *IdentityProviderConfig_OAuth2Config) GetUserInfoUrl() string {
+func (x *GetWorkspaceAnalyticsResponse) GetTopReferers() []*GetShortcutAnalyticsResponse_AnalyticsItem {
 	if x != nil {
-		return x.UserInfoUrl
+		return x.TopReferers
 	}
-	return ""
+	return nil
 }
 
-func (x *IdentityProviderConfig_OAuth2Config) GetScopes() []string {
-	if x != nil {
-		return x.Scopes
-	}
-	return nil
+type ExportWorkspaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to include the activities, e.g. the shortcut views.
+	IncludeActivities bool `protobuf:"varint,1,opt,name=include_activities,json=includeActivities,proto3" json:"include_activities,omitempty"`
+	// Whether to exclude the access tokens of the users and the session secret of the workspace.
+	ExcludeSecrets bool `protobuf:"varint,2,opt,name=exclude_secrets,json=excludeSecrets,proto3" json:"exclude_secrets,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *IdentityProviderConfig_OAuth2Config) GetFieldMapping() *IdentityProviderConfig_FieldMapping {
-	if x != nil {
-		return x.FieldMapping
-	}
-	return nil
+func (x *ExportWorkspaceRequest) Reset() {
+	*x = ExportWorkspaceRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var File_api_v1_workspace_service_proto protoreflect.FileDescriptor
+func (x *ExportWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const file_api_v1_workspace_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1eapi/v1/workspace_service.proto\x12\fslash.api.v1\x1a\x13api/v1/common.proto\x1a!api/v1/subscription_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a google/protobuf/field_mask.proto\"\xb2\x01\n" +
-	"\x10WorkspaceProfile\x12\x12\n" +
-	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x14\n" +
+func (*ExportWorkspaceRequest) ProtoMessage() {}
+
+func (x *ExportWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExportWorkspaceRequest) GetIncludeActivities() bool {
+	if x != nil {
+		return x.IncludeActivities
+	}
+	return false
+}
+
+func (x *ExportWorkspaceRequest) GetExcludeSecrets() bool {
+	if x != nil {
+		return x.ExcludeSecrets
+	}
+	return false
+}
+
+type ExportWorkspaceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The archive encoded in JSON.
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// The suggested file name of the archive.
+	Filename      string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportWorkspaceResponse) Reset() {
+	*x = ExportWorkspaceResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWorkspaceResponse) ProtoMessage() {}
+
+func (x *ExportWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ExportWorkspaceResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportWorkspaceResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type ImportWorkspaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The archive encoded in JSON or in binary protobuf.
+	Content       []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportWorkspaceRequest) Reset() {
+	*x = ImportWorkspaceRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportWorkspaceRequest) ProtoMessage() {}
+
+func (x *ImportWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*ImportWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ImportWorkspaceRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ImportWorkspaceResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CreatedUsers       int32                  `protobuf:"varint,1,opt,name=created_users,json=createdUsers,proto3" json:"created_users,omitempty"`
+	CreatedShortcuts   int32                  `protobuf:"varint,2,opt,name=created_shortcuts,json=createdShortcuts,proto3" json:"created_shortcuts,omitempty"`
+	CreatedCollections int32                  `protobuf:"varint,3,opt,name=created_collections,json=createdCollections,proto3" json:"created_collections,omitempty"`
+	CreatedActivities  int32                  `protobuf:"varint,4,opt,name=created_activities,json=createdActivities,proto3" json:"created_activities,omitempty"`
+	// The users, shortcuts, collections and groups that already exist are kept as they are.
+	SkippedUsers       int32 `protobuf:"varint,5,opt,name=skipped_users,json=skippedUsers,proto3" json:"skipped_users,omitempty"`
+	SkippedShortcuts   int32 `protobuf:"varint,6,opt,name=skipped_shortcuts,json=skippedShortcuts,proto3" json:"skipped_shortcuts,omitempty"`
+	SkippedCollections int32 `protobuf:"varint,7,opt,name=skipped_collections,json=skippedCollections,proto3" json:"skipped_collections,omitempty"`
+	CreatedGroups      int32 `protobuf:"varint,8,opt,name=created_groups,json=createdGroups,proto3" json:"created_groups,omitempty"`
+	SkippedGroups      int32 `protobuf:"varint,9,opt,name=skipped_groups,json=skippedGroups,proto3" json:"skipped_groups,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ImportWorkspaceResponse) Reset() {
+	*x = ImportWorkspaceResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportWorkspaceResponse) ProtoMessage() {}
+
+func (x *ImportWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*ImportWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ImportWorkspaceResponse) GetCreatedUsers() int32 {
+	if x != nil {
+		return x.CreatedUsers
+	}
+	return 0
+}
+
+func (x *ImportWorkspaceResponse) GetCreatedShortcuts() int32 {
+	if x != nil {
+		return x.CreatedShortcuts
+	}
+	return 0
+}
+
+func (x *ImportWorkspaceResponse) GetCreatedCollections() int32 {
+	if x != nil {
+		return x.CreatedCollections
+	}
+	return 0
+}
+
+func (x *ImportWorkspaceResponse) GetCreatedActivities() int32 {
+	if x != nil {
+		return x.CreatedActivities
+	}
+	return 0
+}
+
+func (x *ImportWorkspaceResponse) GetSkippedUsers() int32 {
+	if x != nil {
+		return x.SkippedUsers
+	}
+	return 0
+}
+
+func (x *ImportWorkspaceResponse) GetSkippedShortcuts() int32 {
+	if x != nil {
+		return x.SkippedShortcuts
+	}
+	return 0
+}
+
+func (x *ImportWorkspaceResponse) GetSkippedCollections() int32 {
+	if x != nil {
+		return x.SkippedCollections
+	}
+	return 0
+}
+
+func (x *ImportWorkspaceResponse) GetCreatedGroups() int32 {
+	if x != nil {
+		return x.CreatedGroups
+	}
+	return 0
+}
+
+func (x *ImportWorkspaceResponse) GetSkippedGroups() int32 {
+	if x != nil {
+		return x.SkippedGroups
+	}
+	return 0
+}
+
+type AuditLog struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The id of the user who called the method, 0 if the caller is not signed in.
+	ActorId     int32                  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// The type of the resource, e.g. "audit.shortcut".
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// The full gRPC method name, e.g. "/slash.api.v1.ShortcutService/UpdateShortcut".
+	Method string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	// The resource the method is called on, e.g. "shortcuts/1".
+	Resource string `protobuf:"bytes,6,opt,name=resource,proto3" json:"resource,omitempty"`
+	// The request in JSON, with the passwords, secrets, tokens and file contents masked.
+	Request   string `protobuf:"bytes,7,opt,name=request,proto3" json:"request,omitempty"`
+	Ip        string `protobuf:"bytes,8,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent string `protobuf:"bytes,9,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// The gRPC status code of the result, e.g. "OK" or "PermissionDenied".
+	Code          string `protobuf:"bytes,10,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuditLog) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditLog) GetActorId() int32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AuditLog) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *AuditLog) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AuditLog) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditLog) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AuditLog) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *AuditLog) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AuditLog) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditLog) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ListAuditLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of audit logs to return. Default to 100.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The page token received from a previous ListAuditLogs call.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The filter is a list of conditions joined by "&&".
+	// e.g. `actor_id == 101 && type == "audit.shortcut" && created_time >= "2024-01-01T00:00:00Z"`
+	// Supported fields: actor_id, type, method, resource, code and created_time.
+	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsRequest) Reset() {
+	*x = ListAuditLogsRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsRequest) ProtoMessage() {}
+
+func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAuditLogsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type ListAuditLogsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AuditLogs []*AuditLog            `protobuf:"bytes,1,rep,name=audit_logs,json=auditLogs,proto3" json:"audit_logs,omitempty"`
+	// The page token to retrieve the next page, empty if there are no more audit logs.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsResponse) Reset() {
+	*x = ListAuditLogsResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsResponse) ProtoMessage() {}
+
+func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListAuditLogsResponse) GetAuditLogs() []*AuditLog {
+	if x != nil {
+		return x.AuditLogs
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetActivityRecorderStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActivityRecorderStatsRequest) Reset() {
+	*x = GetActivityRecorderStatsRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActivityRecorderStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityRecorderStatsRequest) ProtoMessage() {}
+
+func (x *GetActivityRecorderStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityRecorderStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetActivityRecorderStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{17}
+}
+
+// ActivityRecorderStats are the counters of the activity recorder since the server started.
+type ActivityRecorderStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of the activities accepted into the queue.
+	Queued int64 `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	// The number of the activities dropped as the queue is full.
+	Dropped int64 `protobuf:"varint,2,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	// The number of the activities written into the store.
+	Flushed int64 `protobuf:"varint,3,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	// The number of the activities failed to be written into the store.
+	Failed int64 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	// The current number of the queued activities.
+	QueueLength   int32 `protobuf:"varint,5,opt,name=queue_length,json=queueLength,proto3" json:"queue_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityRecorderStats) Reset() {
+	*x = ActivityRecorderStats{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityRecorderStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityRecorderStats) ProtoMessage() {}
+
+func (x *ActivityRecorderStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityRecorderStats.ProtoReflect.Descriptor instead.
+func (*ActivityRecorderStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ActivityRecorderStats) GetQueued() int64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *ActivityRecorderStats) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *ActivityRecorderStats) GetFlushed() int64 {
+	if x != nil {
+		return x.Flushed
+	}
+	return 0
+}
+
+func (x *ActivityRecorderStats) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ActivityRecorderStats) GetQueueLength() int32 {
+	if x != nil {
+		return x.QueueLength
+	}
+	return 0
+}
+
+type RotateSCIMTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateSCIMTokenRequest) Reset() {
+	*x = RotateSCIMTokenRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSCIMTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSCIMTokenRequest) ProtoMessage() {}
+
+func (x *RotateSCIMTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSCIMTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateSCIMTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{19}
+}
+
+type RotateSCIMTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The bearer token of the SCIM provisioning endpoints, which is only returned once.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateSCIMTokenResponse) Reset() {
+	*x = RotateSCIMTokenResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSCIMTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSCIMTokenResponse) ProtoMessage() {}
+
+func (x *RotateSCIMTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSCIMTokenResponse.ProtoReflect.Descriptor instead.
+func (*RotateSCIMTokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RotateSCIMTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type IdentityProvider_RoleMappingRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The groups of the user to match any of, which matches any user if empty.
+	Groups []string `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// The email domains of the user to match any of, which matches any user if empty.
+	EmailDomains []string `protobuf:"bytes,2,rep,name=email_domains,json=emailDomains,proto3" json:"email_domains,omitempty"`
+	// The role to assign.
+	Role Role `protobuf:"varint,3,opt,name=role,proto3,enum=slash.api.v1.Role" json:"role,omitempty"`
+	// Whether to deny the sign in instead of assigning a role.
+	Deny          bool `protobuf:"varint,4,opt,name=deny,proto3" json:"deny,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProvider_RoleMappingRule) Reset() {
+	*x = IdentityProvider_RoleMappingRule{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProvider_RoleMappingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProvider_RoleMappingRule) ProtoMessage() {}
+
+func (x *IdentityProvider_RoleMappingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProvider_RoleMappingRule.ProtoReflect.Descriptor instead.
+func (*IdentityProvider_RoleMappingRule) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *IdentityProvider_RoleMappingRule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *IdentityProvider_RoleMappingRule) GetEmailDomains() []string {
+	if x != nil {
+		return x.EmailDomains
+	}
+	return nil
+}
+
+func (x *IdentityProvider_RoleMappingRule) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *IdentityProvider_RoleMappingRule) GetDeny() bool {
+	if x != nil {
+		return x.Deny
+	}
+	return false
+}
+
+type IdentityProviderConfig_FieldMapping struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Identifier  string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// The claim or attribute listing the groups of the user.
+	Groups        string `protobuf:"bytes,3,opt,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProviderConfig_FieldMapping) Reset() {
+	*x = IdentityProviderConfig_FieldMapping{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProviderConfig_FieldMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProviderConfig_FieldMapping) ProtoMessage() {}
+
+func (x *IdentityProviderConfig_FieldMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProviderConfig_FieldMapping.ProtoReflect.Descriptor instead.
+func (*IdentityProviderConfig_FieldMapping) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *IdentityProviderConfig_FieldMapping) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_FieldMapping) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_FieldMapping) GetGroups() string {
+	if x != nil {
+		return x.Groups
+	}
+	return ""
+}
+
+type IdentityProviderConfig_OAuth2Config struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	ClientId      string                               `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string                               `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	AuthUrl       string                               `protobuf:"bytes,3,opt,name=auth_url,json=authUrl,proto3" json:"auth_url,omitempty"`
+	TokenUrl      string                               `protobuf:"bytes,4,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
+	UserInfoUrl   string                               `protobuf:"bytes,5,opt,name=user_info_url,json=userInfoUrl,proto3" json:"user_info_url,omitempty"`
+	Scopes        []string                             `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	FieldMapping  *IdentityProviderConfig_FieldMapping `protobuf:"bytes,7,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProviderConfig_OAuth2Config) Reset() {
+	*x = IdentityProviderConfig_OAuth2Config{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProviderConfig_OAuth2Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProviderConfig_OAuth2Config) ProtoMessage() {}
+
+func (x *IdentityProviderConfig_OAuth2Config) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProviderConfig_OAuth2Config.ProtoReflect.Descriptor instead.
+func (*IdentityProviderConfig_OAuth2Config) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *IdentityProviderConfig_OAuth2Config) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_OAuth2Config) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_OAuth2Config) GetAuthUrl() string {
+	if x != nil {
+		return x.AuthUrl
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_OAuth2Config) GetTokenUrl() string {
+	if x != nil {
+		return x.TokenUrl
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_OAuth2Config) GetUserInfoUrl() string {
+	if x != nil {
+		return x.UserInfoUrl
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_OAuth2Config) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig_OAuth2Config) GetFieldMapping() *IdentityProviderConfig_FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
+type IdentityProviderConfig_OIDCConfig struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ClientId     string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// The issuer url, where the ".well-known/openid-configuration" document is discovered.
+	IssuerUrl string `protobuf:"bytes,3,opt,name=issuer_url,json=issuerUrl,proto3" json:"issuer_url,omitempty"`
+	// The scopes to request, "openid" is always requested.
+	Scopes []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// The mapping of the ID token claims, defaults to "email" and "name".
+	FieldMapping *IdentityProviderConfig_FieldMapping `protobuf:"bytes,5,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	// Whether to accept the emails without the "email_verified" claim, for the providers that never send it.
+	// The emails are rejected if the claim is false.
+	AllowMissingEmailVerified bool `protobuf:"varint,6,opt,name=allow_missing_email_verified,json=allowMissingEmailVerified,proto3" json:"allow_missing_email_verified,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) Reset() {
+	*x = IdentityProviderConfig_OIDCConfig{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProviderConfig_OIDCConfig) ProtoMessage() {}
+
+func (x *IdentityProviderConfig_OIDCConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProviderConfig_OIDCConfig.ProtoReflect.Descriptor instead.
+func (*IdentityProviderConfig_OIDCConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 2}
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) GetIssuerUrl() string {
+	if x != nil {
+		return x.IssuerUrl
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) GetFieldMapping() *IdentityProviderConfig_FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) GetAllowMissingEmailVerified() bool {
+	if x != nil {
+		return x.AllowMissingEmailVerified
+	}
+	return false
+}
+
+type IdentityProviderConfig_SAMLConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The metadata XML of the IdP, which takes precedence over the metadata url.
+	IdpMetadataXml string `protobuf:"bytes,1,opt,name=idp_metadata_xml,json=idpMetadataXml,proto3" json:"idp_metadata_xml,omitempty"`
+	// The url to fetch the metadata of the IdP from.
+	IdpMetadataUrl string `protobuf:"bytes,2,opt,name=idp_metadata_url,json=idpMetadataUrl,proto3" json:"idp_metadata_url,omitempty"`
+	// The entity ID of the service provider, defaults to its metadata url.
+	EntityId string `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// The mapping of the assertion attributes, the identifier defaults to the NameID.
+	FieldMapping  *IdentityProviderConfig_FieldMapping `protobuf:"bytes,4,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) Reset() {
+	*x = IdentityProviderConfig_SAMLConfig{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProviderConfig_SAMLConfig) ProtoMessage() {}
+
+func (x *IdentityProviderConfig_SAMLConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProviderConfig_SAMLConfig.ProtoReflect.Descriptor instead.
+func (*IdentityProviderConfig_SAMLConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 3}
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetIdpMetadataXml() string {
+	if x != nil {
+		return x.IdpMetadataXml
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetIdpMetadataUrl() string {
+	if x != nil {
+		return x.IdpMetadataUrl
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetFieldMapping() *IdentityProviderConfig_FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
+type IdentityProviderConfig_LDAPConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The url of the LDAP server, e.g. "ldaps://ldap.example.com:636".
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The DN to bind with to search the users, the search is anonymous if empty.
+	BindDn       string `protobuf:"bytes,2,opt,name=bind_dn,json=bindDn,proto3" json:"bind_dn,omitempty"`
+	BindPassword string `protobuf:"bytes,3,opt,name=bind_password,json=bindPassword,proto3" json:"bind_password,omitempty"`
+	// The base DN to search the users under.
+	SearchBase string `protobuf:"bytes,4,opt,name=search_base,json=searchBase,proto3" json:"search_base,omitempty"`
+	// The filter to search the user, where "{username}" is replaced with the escaped username.
+	// Defaults to "(mail={username})".
+	UserFilter string `protobuf:"bytes,5,opt,name=user_filter,json=userFilter,proto3" json:"user_filter,omitempty"`
+	// The mapping of the user attributes, defaults to "mail" and "cn".
+	FieldMapping *IdentityProviderConfig_FieldMapping `protobuf:"bytes,6,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	// Whether to upgrade the connection with StartTLS, for the "ldap://" urls.
+	StartTls      bool `protobuf:"varint,7,opt,name=start_tls,json=startTls,proto3" json:"start_tls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) Reset() {
+	*x = IdentityProviderConfig_LDAPConfig{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProviderConfig_LDAPConfig) ProtoMessage() {}
+
+func (x *IdentityProviderConfig_LDAPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProviderConfig_LDAPConfig.ProtoReflect.Descriptor instead.
+func (*IdentityProviderConfig_LDAPConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 4}
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetBindDn() string {
+	if x != nil {
+		return x.BindDn
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetBindPassword() string {
+	if x != nil {
+		return x.BindPassword
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetSearchBase() string {
+	if x != nil {
+		return x.SearchBase
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetUserFilter() string {
+	if x != nil {
+		return x.UserFilter
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetFieldMapping() *IdentityProviderConfig_FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetStartTls() bool {
+	if x != nil {
+		return x.StartTls
+	}
+	return false
+}
+
+type GetWorkspaceAnalyticsResponse_ShortcutViewCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	ShortcutName  string                 `protobuf:"bytes,2,opt,name=shortcut_name,json=shortcutName,proto3" json:"shortcut_name,omitempty"`
+	ViewCount     int32                  `protobuf:"varint,3,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceAnalyticsResponse_ShortcutViewCount) Reset() {
+	*x = GetWorkspaceAnalyticsResponse_ShortcutViewCount{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceAnalyticsResponse_ShortcutViewCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceAnalyticsResponse_ShortcutViewCount) ProtoMessage() {}
+
+func (x *GetWorkspaceAnalyticsResponse_ShortcutViewCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceAnalyticsResponse_ShortcutViewCount.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceAnalyticsResponse_ShortcutViewCount) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *GetWorkspaceAnalyticsResponse_ShortcutViewCount) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *GetWorkspaceAnalyticsResponse_ShortcutViewCount) GetShortcutName() string {
+	if x != nil {
+		return x.ShortcutName
+	}
+	return ""
+}
+
+func (x *GetWorkspaceAnalyticsResponse_ShortcutViewCount) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+type GetWorkspaceAnalyticsResponse_CreatorViewCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatorId     int32                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	ViewCount     int32                  `protobuf:"varint,2,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceAnalyticsResponse_CreatorViewCount) Reset() {
+	*x = GetWorkspaceAnalyticsResponse_CreatorViewCount{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceAnalyticsResponse_CreatorViewCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceAnalyticsResponse_CreatorViewCount) ProtoMessage() {}
+
+func (x *GetWorkspaceAnalyticsResponse_CreatorViewCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceAnalyticsResponse_CreatorViewCount.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceAnalyticsResponse_CreatorViewCount) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *GetWorkspaceAnalyticsResponse_CreatorViewCount) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *GetWorkspaceAnalyticsResponse_CreatorViewCount) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+var File_api_v1_workspace_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_workspace_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1eapi/v1/workspace_service.proto\x12\fslash.api.v1\x1a\x13api/v1/common.proto\x1a\x1dapi/v1/shortcut_service.proto\x1a!api/v1/subscription_service.proto\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb2\x01\n" +
+	"\x10WorkspaceProfile\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x14\n" +
 	"\x05owner\x18\x03 \x01(\tR\x05owner\x12>\n" +
 	"\fsubscription\x18\x04 \x01(\v2\x1a.slash.api.v1.SubscriptionR\fsubscription\x12\x1a\n" +
-	"\bbranding\x18\x06 \x01(\fR\bbranding\"\xdd\x02\n" +
+	"\bbranding\x18\x06 \x01(\fR\bbranding\"\xbd\x05\n" +
 	"\x10WorkspaceSetting\x12!\n" +
 	"\finstance_url\x18\x01 \x01(\tR\vinstanceUrl\x12\x1a\n" +
 	"\bbranding\x18\x02 \x01(\fR\bbranding\x12G\n" +
 	"\x12default_visibility\x18\x04 \x01(\x0e2\x18.slash.api.v1.VisibilityR\x11defaultVisibility\x12M\n" +
 	"\x12identity_providers\x18\x05 \x03(\v2\x1e.slash.api.v1.IdentityProviderR\x11identityProviders\x12<\n" +
 	"\x1adisallow_user_registration\x18\x06 \x01(\bR\x18disallowUserRegistration\x124\n" +
-	"\x16disallow_password_auth\x18\a \x01(\bR\x14disallowPasswordAuth\"\xd9\x01\n" +
+	"\x16disallow_password_auth\x18\a \x01(\bR\x14disallowPasswordAuth\x124\n" +
+	"\x16enable_path_forwarding\x18\b \x01(\bR\x14enablePathForwarding\x12R\n" +
+	"\x14query_merge_strategy\x18\t \x01(\x0e2 .slash.api.v1.QueryMergeStrategyR\x12queryMergeStrategy\x120\n" +
+	"\x14trash_retention_days\x18\n" +
+	" \x01(\x05R\x12trashRetentionDays\x126\n" +
+	"\x17activity_retention_days\x18\v \x01(\x05R\x15activityRetentionDays\x12G\n" +
+	"\x10role_permissions\x18\f \x03(\v2\x1c.slash.api.v1.RolePermissionR\x0frolePermissions\x12!\n" +
+	"\fscim_enabled\x18\r \x01(\bR\vscimEnabled\"Z\n" +
+	"\x0eRolePermission\x12&\n" +
+	"\x04role\x18\x01 \x01(\x0e2\x12.slash.api.v1.RoleR\x04role\x12 \n" +
+	"\vpermissions\x18\x02 \x03(\tR\vpermissions\"\x96\x04\n" +
 	"\x10IdentityProvider\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x127\n" +
 	"\x04type\x18\x03 \x01(\x0e2#.slash.api.v1.IdentityProvider.TypeR\x04type\x12<\n" +
-	"\x06config\x18\x04 \x01(\v2$.slash.api.v1.IdentityProviderConfigR\x06config\"(\n" +
+	"\x06config\x18\x04 \x01(\v2$.slash.api.v1.IdentityProviderConfigR\x06config\x12\\\n" +
+	"\x12role_mapping_rules\x18\x05 \x03(\v2..slash.api.v1.IdentityProvider.RoleMappingRuleR\x10roleMappingRules\x122\n" +
+	"\x15allowed_email_domains\x18\x06 \x03(\tR\x13allowedEmailDomains\x1a\x8a\x01\n" +
+	"\x0fRoleMappingRule\x12\x16\n" +
+	"\x06groups\x18\x01 \x03(\tR\x06groups\x12#\n" +
+	"\remail_domains\x18\x02 \x03(\tR\femailDomains\x12&\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x12.slash.api.v1.RoleR\x04role\x12\x12\n" +
+	"\x04deny\x18\x04 \x01(\bR\x04deny\"F\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
-	"\x06OAUTH2\x10\x01\"\xe1\x03\n" +
+	"\x06OAUTH2\x10\x01\x12\b\n" +
+	"\x04OIDC\x10\x02\x12\b\n" +
+	"\x04SAML\x10\x03\x12\b\n" +
+	"\x04LDAP\x10\x04\"\xdd\v\n" +
 	"\x16IdentityProviderConfig\x12K\n" +
-	"\x06oauth2\x18\x01 \x01(\v21.slash.api.v1.IdentityProviderConfig.OAuth2ConfigH\x00R\x06oauth2\x1aQ\n" +
+	"\x06oauth2\x18\x01 \x01(\v21.slash.api.v1.IdentityProviderConfig.OAuth2ConfigH\x00R\x06oauth2\x12E\n" +
+	"\x04oidc\x18\x02 \x01(\v2/.slash.api.v1.IdentityProviderConfig.OIDCConfigH\x00R\x04oidc\x12E\n" +
+	"\x04saml\x18\x03 \x01(\v2/.slash.api.v1.IdentityProviderConfig.SAMLConfigH\x00R\x04saml\x12E\n" +
+	"\x04ldap\x18\x04 \x01(\v2/.slash.api.v1.IdentityProviderConfig.LDAPConfigH\x00R\x04ldap\x1ai\n" +
 	"\fFieldMapping\x12\x1e\n" +
 	"\n" +
 	"identifier\x18\x01 \x01(\tR\n" +
 	"identifier\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x1a\x9c\x02\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06groups\x18\x03 \x01(\tR\x06groups\x1a\x9c\x02\n" +
 	"\fOAuth2Config\x12\x1b\n" +
 	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
 	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12\x19\n" +
@@ -687,18 +2257,132 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\ttoken_url\x18\x04 \x01(\tR\btokenUrl\x12\"\n" +
 	"\ruser_info_url\x18\x05 \x01(\tR\vuserInfoUrl\x12\x16\n" +
 	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x12V\n" +
-	"\rfield_mapping\x18\a \x01(\v21.slash.api.v1.IdentityProviderConfig.FieldMappingR\ffieldMappingB\b\n" +
+	"\rfield_mapping\x18\a \x01(\v21.slash.api.v1.IdentityProviderConfig.FieldMappingR\ffieldMapping\x1a\x9e\x02\n" +
+	"\n" +
+	"OIDCConfig\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12\x1d\n" +
+	"\n" +
+	"issuer_url\x18\x03 \x01(\tR\tissuerUrl\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12V\n" +
+	"\rfield_mapping\x18\x05 \x01(\v21.slash.api.v1.IdentityProviderConfig.FieldMappingR\ffieldMapping\x12?\n" +
+	"\x1callow_missing_email_verified\x18\x06 \x01(\bR\x19allowMissingEmailVerified\x1a\xd5\x01\n" +
+	"\n" +
+	"SAMLConfig\x12(\n" +
+	"\x10idp_metadata_xml\x18\x01 \x01(\tR\x0eidpMetadataXml\x12(\n" +
+	"\x10idp_metadata_url\x18\x02 \x01(\tR\x0eidpMetadataUrl\x12\x1b\n" +
+	"\tentity_id\x18\x03 \x01(\tR\bentityId\x12V\n" +
+	"\rfield_mapping\x18\x04 \x01(\v21.slash.api.v1.IdentityProviderConfig.FieldMappingR\ffieldMapping\x1a\x93\x02\n" +
+	"\n" +
+	"LDAPConfig\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x17\n" +
+	"\abind_dn\x18\x02 \x01(\tR\x06bindDn\x12#\n" +
+	"\rbind_password\x18\x03 \x01(\tR\fbindPassword\x12\x1f\n" +
+	"\vsearch_base\x18\x04 \x01(\tR\n" +
+	"searchBase\x12\x1f\n" +
+	"\vuser_filter\x18\x05 \x01(\tR\n" +
+	"userFilter\x12V\n" +
+	"\rfield_mapping\x18\x06 \x01(\v21.slash.api.v1.IdentityProviderConfig.FieldMappingR\ffieldMapping\x12\x1b\n" +
+	"\tstart_tls\x18\a \x01(\bR\bstartTlsB\b\n" +
 	"\x06config\"\x1c\n" +
 	"\x1aGetWorkspaceProfileRequest\"\x1c\n" +
 	"\x1aGetWorkspaceSettingRequest\"\x96\x01\n" +
 	"\x1dUpdateWorkspaceSettingRequest\x128\n" +
 	"\asetting\x18\x01 \x01(\v2\x1e.slash.api.v1.WorkspaceSettingR\asetting\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask2\xc6\x03\n" +
+	"updateMask\"\xca\x01\n" +
+	"\x1cGetWorkspaceAnalyticsRequest\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x13\n" +
+	"\x05top_n\x18\x03 \x01(\x05R\x04topN\x12#\n" +
+	"\rinactive_days\x18\x04 \x01(\x05R\finactiveDays\"\xd3\x05\n" +
+	"\x1dGetWorkspaceAnalyticsResponse\x12b\n" +
+	"\rtop_shortcuts\x18\x01 \x03(\v2=.slash.api.v1.GetWorkspaceAnalyticsResponse.ShortcutViewCountR\ftopShortcuts\x12_\n" +
+	"\ftop_creators\x18\x02 \x03(\v2<.slash.api.v1.GetWorkspaceAnalyticsResponse.CreatorViewCountR\vtopCreators\x12l\n" +
+	"\x12inactive_shortcuts\x18\x03 \x03(\v2=.slash.api.v1.GetWorkspaceAnalyticsResponse.ShortcutViewCountR\x11inactiveShortcuts\x12V\n" +
+	"\vdaily_views\x18\x04 \x03(\v25.slash.api.v1.GetShortcutAnalyticsResponse.TimeBucketR\n" +
+	"dailyViews\x12[\n" +
+	"\ftop_referers\x18\x05 \x03(\v28.slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\vtopReferers\x1ax\n" +
+	"\x11ShortcutViewCount\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12#\n" +
+	"\rshortcut_name\x18\x02 \x01(\tR\fshortcutName\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x03 \x01(\x05R\tviewCount\x1aP\n" +
+	"\x10CreatorViewCount\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x01 \x01(\x05R\tcreatorId\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x02 \x01(\x05R\tviewCount\"p\n" +
+	"\x16ExportWorkspaceRequest\x12-\n" +
+	"\x12include_activities\x18\x01 \x01(\bR\x11includeActivities\x12'\n" +
+	"\x0fexclude_secrets\x18\x02 \x01(\bR\x0eexcludeSecrets\"O\n" +
+	"\x17ExportWorkspaceResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\"2\n" +
+	"\x16ImportWorkspaceRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\"\x9c\x03\n" +
+	"\x17ImportWorkspaceResponse\x12#\n" +
+	"\rcreated_users\x18\x01 \x01(\x05R\fcreatedUsers\x12+\n" +
+	"\x11created_shortcuts\x18\x02 \x01(\x05R\x10createdShortcuts\x12/\n" +
+	"\x13created_collections\x18\x03 \x01(\x05R\x12createdCollections\x12-\n" +
+	"\x12created_activities\x18\x04 \x01(\x05R\x11createdActivities\x12#\n" +
+	"\rskipped_users\x18\x05 \x01(\x05R\fskippedUsers\x12+\n" +
+	"\x11skipped_shortcuts\x18\x06 \x01(\x05R\x10skippedShortcuts\x12/\n" +
+	"\x13skipped_collections\x18\a \x01(\x05R\x12skippedCollections\x12%\n" +
+	"\x0ecreated_groups\x18\b \x01(\x05R\rcreatedGroups\x12%\n" +
+	"\x0eskipped_groups\x18\t \x01(\x05R\rskippedGroups\"\x99\x02\n" +
+	"\bAuditLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\x05R\aactorId\x12=\n" +
+	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12\x1a\n" +
+	"\bresource\x18\x06 \x01(\tR\bresource\x12\x18\n" +
+	"\arequest\x18\a \x01(\tR\arequest\x12\x0e\n" +
+	"\x02ip\x18\b \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\t \x01(\tR\tuserAgent\x12\x12\n" +
+	"\x04code\x18\n" +
+	" \x01(\tR\x04code\"j\n" +
+	"\x14ListAuditLogsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\"v\n" +
+	"\x15ListAuditLogsResponse\x125\n" +
+	"\n" +
+	"audit_logs\x18\x01 \x03(\v2\x16.slash.api.v1.AuditLogR\tauditLogs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"!\n" +
+	"\x1fGetActivityRecorderStatsRequest\"\x9e\x01\n" +
+	"\x15ActivityRecorderStats\x12\x16\n" +
+	"\x06queued\x18\x01 \x01(\x03R\x06queued\x12\x18\n" +
+	"\adropped\x18\x02 \x01(\x03R\adropped\x12\x18\n" +
+	"\aflushed\x18\x03 \x01(\x03R\aflushed\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x03R\x06failed\x12!\n" +
+	"\fqueue_length\x18\x05 \x01(\x05R\vqueueLength\"\x18\n" +
+	"\x16RotateSCIMTokenRequest\"/\n" +
+	"\x17RotateSCIMTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token*`\n" +
+	"\x12QueryMergeStrategy\x12$\n" +
+	" QUERY_MERGE_STRATEGY_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06APPEND\x10\x01\x12\f\n" +
+	"\bOVERRIDE\x10\x02\x12\n" +
+	"\n" +
+	"\x06IGNORE\x10\x032\x9c\n" +
+	"\n" +
 	"\x10WorkspaceService\x12\x82\x01\n" +
 	"\x13GetWorkspaceProfile\x12(.slash.api.v1.GetWorkspaceProfileRequest\x1a\x1e.slash.api.v1.WorkspaceProfile\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/workspace/profile\x12\x82\x01\n" +
 	"\x13GetWorkspaceSetting\x12(.slash.api.v1.GetWorkspaceSettingRequest\x1a\x1e.slash.api.v1.WorkspaceSetting\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/workspace/setting\x12\xa7\x01\n" +
-	"\x16UpdateWorkspaceSetting\x12+.slash.api.v1.UpdateWorkspaceSettingRequest\x1a\x1e.slash.api.v1.WorkspaceSetting\"@\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x02$:\asetting2\x19/api/v1/workspace/settingB\xb3\x01\n" +
+	"\x16UpdateWorkspaceSetting\x12+.slash.api.v1.UpdateWorkspaceSettingRequest\x1a\x1e.slash.api.v1.WorkspaceSetting\"@\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x02$:\asetting2\x19/api/v1/workspace/setting\x12\x95\x01\n" +
+	"\x15GetWorkspaceAnalytics\x12*.slash.api.v1.GetWorkspaceAnalyticsRequest\x1a+.slash.api.v1.GetWorkspaceAnalyticsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/workspace/analytics\x12\x80\x01\n" +
+	"\x0fExportWorkspace\x12$.slash.api.v1.ExportWorkspaceRequest\x1a%.slash.api.v1.ExportWorkspaceResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/workspace:export\x12\x83\x01\n" +
+	"\x0fImportWorkspace\x12$.slash.api.v1.ImportWorkspaceRequest\x1a%.slash.api.v1.ImportWorkspaceResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/workspace:import\x12~\n" +
+	"\rListAuditLogs\x12\".slash.api.v1.ListAuditLogsRequest\x1a#.slash.api.v1.ListAuditLogsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/workspace/audit_logs\x12\xa1\x01\n" +
+	"\x18GetActivityRecorderStats\x12-.slash.api.v1.GetActivityRecorderStatsRequest\x1a#.slash.api.v1.ActivityRecorderStats\"1\x82\xd3\xe4\x93\x02+\x12)/api/v1/workspace/activity_recorder_stats\x12\x8e\x01\n" +
+	"\x0fRotateSCIMToken\x12$.slash.api.v1.RotateSCIMTokenRequest\x1a%.slash.api.v1.RotateSCIMTokenResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/workspace/scim_token:rotateB\xb3\x01\n" +
 	"\x10com.slash.api.v1B\x15WorkspaceServiceProtoP\x01Z6github.com/yourselfhosted/slash/proto/gen/api/v1;apiv1\xa2\x02\x03SAX\xaa\x02\fSlash.Api.V1\xca\x02\fSlash\\Api\\V1\xe2\x02\x18Slash\\Api\\V1\\GPBMetadata\xea\x02\x0eSlash::Api::V1b\x06proto3"
 
 var (
@@ -713,44 +2397,101 @@ func file_api_v1_workspace_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_workspace_service_proto_rawDescData
 }
 
-var file_api_v1_workspace_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v1_workspace_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_api_v1_workspace_service_proto_goTypes = []any{
-	(IdentityProvider_Type)(0),                  // 0: slash.api.v1.IdentityProvider.Type
-	(*WorkspaceProfile)(nil),                    // 1: slash.api.v1.WorkspaceProfile
-	(*WorkspaceSetting)(nil),                    // 2: slash.api.v1.WorkspaceSetting
-	(*IdentityProvider)(nil),                    // 3: slash.api.v1.IdentityProvider
-	(*IdentityProviderConfig)(nil),              // 4: slash.api.v1.IdentityProviderConfig
-	(*GetWorkspaceProfileRequest)(nil),          // 5: slash.api.v1.GetWorkspaceProfileRequest
-	(*GetWorkspaceSettingRequest)(nil),          // 6: slash.api.v1.GetWorkspaceSettingRequest
-	(*UpdateWorkspaceSettingRequest)(nil),       // 7: slash.api.v1.UpdateWorkspaceSettingRequest
-	(*IdentityProviderConfig_FieldMapping)(nil), // 8: slash.api.v1.IdentityProviderConfig.FieldMapping
-	(*IdentityProviderConfig_OAuth2Config)(nil), // 9: slash.api.v1.IdentityProviderConfig.OAuth2Config
-	(*Subscription)(nil),                        // 10: slash.api.v1.Subscription
-	(Visibility)(0),                             // 11: slash.api.v1.Visibility
-	(*fieldmaskpb.FieldMask)(nil),               // 12: google.protobuf.FieldMask
+	(QueryMergeStrategy)(0),                                 // 0: slash.api.v1.QueryMergeStrategy
+	(IdentityProvider_Type)(0),                              // 1: slash.api.v1.IdentityProvider.Type
+	(*WorkspaceProfile)(nil),                                // 2: slash.api.v1.WorkspaceProfile
+	(*WorkspaceSetting)(nil),                                // 3: slash.api.v1.WorkspaceSetting
+	(*RolePermission)(nil),                                  // 4: slash.api.v1.RolePermission
+	(*IdentityProvider)(nil),                                // 5: slash.api.v1.IdentityProvider
+	(*IdentityProviderConfig)(nil),                          // 6: slash.api.v1.IdentityProviderConfig
+	(*GetWorkspaceProfileRequest)(nil),                      // 7: slash.api.v1.GetWorkspaceProfileRequest
+	(*GetWorkspaceSettingRequest)(nil),                      // 8: slash.api.v1.GetWorkspaceSettingRequest
+	(*UpdateWorkspaceSettingRequest)(nil),                   // 9: slash.api.v1.UpdateWorkspaceSettingRequest
+	(*GetWorkspaceAnalyticsRequest)(nil),                    // 10: slash.api.v1.GetWorkspaceAnalyticsRequest
+	(*GetWorkspaceAnalyticsResponse)(nil),                   // 11: slash.api.v1.GetWorkspaceAnalyticsResponse
+	(*ExportWorkspaceRequest)(nil),                          // 12: slash.api.v1.ExportWorkspaceRequest
+	(*ExportWorkspaceResponse)(nil),                         // 13: slash.api.v1.ExportWorkspaceResponse
+	(*ImportWorkspaceRequest)(nil),                          // 14: slash.api.v1.ImportWorkspaceRequest
+	(*ImportWorkspaceResponse)(nil),                         // 15: slash.api.v1.ImportWorkspaceResponse
+	(*AuditLog)(nil),                                        // 16: slash.api.v1.AuditLog
+	(*ListAuditLogsRequest)(nil),                            // 17: slash.api.v1.ListAuditLogsRequest
+	(*ListAuditLogsResponse)(nil),                           // 18: slash.api.v1.ListAuditLogsResponse
+	(*GetActivityRecorderStatsRequest)(nil),                 // 19: slash.api.v1.GetActivityRecorderStatsRequest
+	(*ActivityRecorderStats)(nil),                           // 20: slash.api.v1.ActivityRecorderStats
+	(*RotateSCIMTokenRequest)(nil),                          // 21: slash.api.v1.RotateSCIMTokenRequest
+	(*RotateSCIMTokenResponse)(nil),                         // 22: slash.api.v1.RotateSCIMTokenResponse
+	(*IdentityProvider_RoleMappingRule)(nil),                // 23: slash.api.v1.IdentityProvider.RoleMappingRule
+	(*IdentityProviderConfig_FieldMapping)(nil),             // 24: slash.api.v1.IdentityProviderConfig.FieldMapping
+	(*IdentityProviderConfig_OAuth2Config)(nil),             // 25: slash.api.v1.IdentityProviderConfig.OAuth2Config
+	(*IdentityProviderConfig_OIDCConfig)(nil),               // 26: slash.api.v1.IdentityProviderConfig.OIDCConfig
+	(*IdentityProviderConfig_SAMLConfig)(nil),               // 27: slash.api.v1.IdentityProviderConfig.SAMLConfig
+	(*IdentityProviderConfig_LDAPConfig)(nil),               // 28: slash.api.v1.IdentityProviderConfig.LDAPConfig
+	(*GetWorkspaceAnalyticsResponse_ShortcutViewCount)(nil), // 29: slash.api.v1.GetWorkspaceAnalyticsResponse.ShortcutViewCount
+	(*GetWorkspaceAnalyticsResponse_CreatorViewCount)(nil),  // 30: slash.api.v1.GetWorkspaceAnalyticsResponse.CreatorViewCount
+	(*Subscription)(nil),                                    // 31: slash.api.v1.Subscription
+	(Visibility)(0),                                         // 32: slash.api.v1.Visibility
+	(Role)(0),                                               // 33: slash.api.v1.Role
+	(*fieldmaskpb.FieldMask)(nil),                           // 34: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),                           // 35: google.protobuf.Timestamp
+	(*GetShortcutAnalyticsResponse_TimeBucket)(nil),         // 36: slash.api.v1.GetShortcutAnalyticsResponse.TimeBucket
+	(*GetShortcutAnalyticsResponse_AnalyticsItem)(nil),      // 37: slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
 }
 var file_api_v1_workspace_service_proto_depIdxs = []int32{
-	10, // 0: slash.api.v1.WorkspaceProfile.subscription:type_name -> slash.api.v1.Subscription
-	11, // 1: slash.api.v1.WorkspaceSetting.default_visibility:type_name -> slash.api.v1.Visibility
-	3,  // 2: slash.api.v1.WorkspaceSetting.identity_providers:type_name -> slash.api.v1.IdentityProvider
-	0,  // 3: slash.api.v1.IdentityProvider.type:type_name -> slash.api.v1.IdentityProvider.Type
-	4,  // 4: slash.api.v1.IdentityProvider.config:type_name -> slash.api.v1.IdentityProviderConfig
-	9,  // 5: slash.api.v1.IdentityProviderConfig.oauth2:type_name -> slash.api.v1.IdentityProviderConfig.OAuth2Config
-	2,  // 6: slash.api.v1.UpdateWorkspaceSettingRequest.setting:type_name -> slash.api.v1.WorkspaceSetting
-	12, // 7: slash.api.v1.UpdateWorkspaceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	8,  // 8: slash.api.v1.IdentityProviderConfig.OAuth2Config.field_mapping:type_name -> slash.api.v1.IdentityProviderConfig.FieldMapping
-	5,  // 9: slash.api.v1.WorkspaceService.GetWorkspaceProfile:input_type -> slash.api.v1.GetWorkspaceProfileRequest
-	6,  // 10: slash.api.v1.WorkspaceService.GetWorkspaceSetting:input_type -> slash.api.v1.GetWorkspaceSettingRequest
-	7,  // 11: slash.api.v1.WorkspaceService.UpdateWorkspaceSetting:input_type -> slash.api.v1.UpdateWorkspaceSettingRequest
-	1,  // 12: slash.api.v1.WorkspaceService.GetWorkspaceProfile:output_type -> slash.api.v1.WorkspaceProfile
-	2,  // 13: slash.api.v1.WorkspaceService.GetWorkspaceSetting:output_type -> slash.api.v1.WorkspaceSetting
-	2,  // 14: slash.api.v1.WorkspaceService.UpdateWorkspaceSetting:output_type -> slash.api.v1.WorkspaceSetting
-	12, // [12:15] is the sub-list for method output_type
-	9,  // [9:12] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	31, // 0: slash.api.v1.WorkspaceProfile.subscription:type_name -> slash.api.v1.Subscription
+	32, // 1: slash.api.v1.WorkspaceSetting.default_visibility:type_name -> slash.api.v1.Visibility
+	5,  // 2: slash.api.v1.WorkspaceSetting.identity_providers:type_name -> slash.api.v1.IdentityProvider
+	0,  // 3: slash.api.v1.WorkspaceSetting.query_merge_strategy:type_name -> slash.api.v1.QueryMergeStrategy
+	4,  // 4: slash.api.v1.WorkspaceSetting.role_permissions:type_name -> slash.api.v1.RolePermission
+	33, // 5: slash.api.v1.RolePermission.role:type_name -> slash.api.v1.Role
+	1,  // 6: slash.api.v1.IdentityProvider.type:type_name -> slash.api.v1.IdentityProvider.Type
+	6,  // 7: slash.api.v1.IdentityProvider.config:type_name -> slash.api.v1.IdentityProviderConfig
+	23, // 8: slash.api.v1.IdentityProvider.role_mapping_rules:type_name -> slash.api.v1.IdentityProvider.RoleMappingRule
+	25, // 9: slash.api.v1.IdentityProviderConfig.oauth2:type_name -> slash.api.v1.IdentityProviderConfig.OAuth2Config
+	26, // 10: slash.api.v1.IdentityProviderConfig.oidc:type_name -> slash.api.v1.IdentityProviderConfig.OIDCConfig
+	27, // 11: slash.api.v1.IdentityProviderConfig.saml:type_name -> slash.api.v1.IdentityProviderConfig.SAMLConfig
+	28, // 12: slash.api.v1.IdentityProviderConfig.ldap:type_name -> slash.api.v1.IdentityProviderConfig.LDAPConfig
+	3,  // 13: slash.api.v1.UpdateWorkspaceSettingRequest.setting:type_name -> slash.api.v1.WorkspaceSetting
+	34, // 14: slash.api.v1.UpdateWorkspaceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	35, // 15: slash.api.v1.GetWorkspaceAnalyticsRequest.start_time:type_name -> google.protobuf.Timestamp
+	35, // 16: slash.api.v1.GetWorkspaceAnalyticsRequest.end_time:type_name -> google.protobuf.Timestamp
+	29, // 17: slash.api.v1.GetWorkspaceAnalyticsResponse.top_shortcuts:type_name -> slash.api.v1.GetWorkspaceAnalyticsResponse.ShortcutViewCount
+	30, // 18: slash.api.v1.GetWorkspaceAnalyticsResponse.top_creators:type_name -> slash.api.v1.GetWorkspaceAnalyticsResponse.CreatorViewCount
+	29, // 19: slash.api.v1.GetWorkspaceAnalyticsResponse.inactive_shortcuts:type_name -> slash.api.v1.GetWorkspaceAnalyticsResponse.ShortcutViewCount
+	36, // 20: slash.api.v1.GetWorkspaceAnalyticsResponse.daily_views:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.TimeBucket
+	37, // 21: slash.api.v1.GetWorkspaceAnalyticsResponse.top_referers:type_name -> slash.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	35, // 22: slash.api.v1.AuditLog.created_time:type_name -> google.protobuf.Timestamp
+	16, // 23: slash.api.v1.ListAuditLogsResponse.audit_logs:type_name -> slash.api.v1.AuditLog
+	33, // 24: slash.api.v1.IdentityProvider.RoleMappingRule.role:type_name -> slash.api.v1.Role
+	24, // 25: slash.api.v1.IdentityProviderConfig.OAuth2Config.field_mapping:type_name -> slash.api.v1.IdentityProviderConfig.FieldMapping
+	24, // 26: slash.api.v1.IdentityProviderConfig.OIDCConfig.field_mapping:type_name -> slash.api.v1.IdentityProviderConfig.FieldMapping
+	24, // 27: slash.api.v1.IdentityProviderConfig.SAMLConfig.field_mapping:type_name -> slash.api.v1.IdentityProviderConfig.FieldMapping
+	24, // 28: slash.api.v1.IdentityProviderConfig.LDAPConfig.field_mapping:type_name -> slash.api.v1.IdentityProviderConfig.FieldMapping
+	7,  // 29: slash.api.v1.WorkspaceService.GetWorkspaceProfile:input_type -> slash.api.v1.GetWorkspaceProfileRequest
+	8,  // 30: slash.api.v1.WorkspaceService.GetWorkspaceSetting:input_type -> slash.api.v1.GetWorkspaceSettingRequest
+	9,  // 31: slash.api.v1.WorkspaceService.UpdateWorkspaceSetting:input_type -> slash.api.v1.UpdateWorkspaceSettingRequest
+	10, // 32: slash.api.v1.WorkspaceService.GetWorkspaceAnalytics:input_type -> slash.api.v1.GetWorkspaceAnalyticsRequest
+	12, // 33: slash.api.v1.WorkspaceService.ExportWorkspace:input_type -> slash.api.v1.ExportWorkspaceRequest
+	14, // 34: slash.api.v1.WorkspaceService.ImportWorkspace:input_type -> slash.api.v1.ImportWorkspaceRequest
+	17, // 35: slash.api.v1.WorkspaceService.ListAuditLogs:input_type -> slash.api.v1.ListAuditLogsRequest
+	19, // 36: slash.api.v1.WorkspaceService.GetActivityRecorderStats:input_type -> slash.api.v1.GetActivityRecorderStatsRequest
+	21, // 37: slash.api.v1.WorkspaceService.RotateSCIMToken:input_type -> slash.api.v1.RotateSCIMTokenRequest
+	2,  // 38: slash.api.v1.WorkspaceService.GetWorkspaceProfile:output_type -> slash.api.v1.WorkspaceProfile
+	3,  // 39: slash.api.v1.WorkspaceService.GetWorkspaceSetting:output_type -> slash.api.v1.WorkspaceSetting
+	3,  // 40: slash.api.v1.WorkspaceService.UpdateWorkspaceSetting:output_type -> slash.api.v1.WorkspaceSetting
+	11, // 41: slash.api.v1.WorkspaceService.GetWorkspaceAnalytics:output_type -> slash.api.v1.GetWorkspaceAnalyticsResponse
+	13, // 42: slash.api.v1.WorkspaceService.ExportWorkspace:output_type -> slash.api.v1.ExportWorkspaceResponse
+	15, // 43: slash.api.v1.WorkspaceService.ImportWorkspace:output_type -> slash.api.v1.ImportWorkspaceResponse
+	18, // 44: slash.api.v1.WorkspaceService.ListAuditLogs:output_type -> slash.api.v1.ListAuditLogsResponse
+	20, // 45: slash.api.v1.WorkspaceService.GetActivityRecorderStats:output_type -> slash.api.v1.ActivityRecorderStats
+	22, // 46: slash.api.v1.WorkspaceService.RotateSCIMToken:output_type -> slash.api.v1.RotateSCIMTokenResponse
+	38, // [38:47] is the sub-list for method output_type
+	29, // [29:38] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_workspace_service_proto_init() }
@@ -759,17 +2500,22 @@ func file_api_v1_workspace_service_proto_init() {
 		return
 	}
 	file_api_v1_common_proto_init()
+	file_api_v1_shortcut_service_proto_init()
 	file_api_v1_subscription_service_proto_init()
-	file_api_v1_workspace_service_proto_msgTypes[3].OneofWrappers = []any{
+	file_api_v1_user_service_proto_init()
+	file_api_v1_workspace_service_proto_msgTypes[4].OneofWrappers = []any{
 		(*IdentityProviderConfig_Oauth2)(nil),
+		(*IdentityProviderConfig_Oidc)(nil),
+		(*IdentityProviderConfig_Saml)(nil),
+		(*IdentityProviderConfig_Ldap)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_workspace_service_proto_rawDesc), len(file_api_v1_workspace_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WorkspaceService_GetWorkspaceProfile_FullMethodName    = "/slash.api.v1.WorkspaceService/GetWorkspaceProfile"
-	WorkspaceService_GetWorkspaceSetting_FullMethodName    = "/slash.api.v1.WorkspaceService/GetWorkspaceSetting"
-	WorkspaceService_UpdateWorkspaceSetting_FullMethodName = "/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting"
+	WorkspaceService_GetWorkspaceProfile_FullMethodName      = "/slash.api.v1.WorkspaceService/GetWorkspaceProfile"
+	WorkspaceService_GetWorkspaceSetting_FullMethodName      = "/slash.api.v1.WorkspaceService/GetWorkspaceSetting"
+	WorkspaceService_UpdateWorkspaceSetting_FullMethodName   = "/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting"
+	WorkspaceService_GetWorkspaceAnalytics_FullMethodName    = "/slash.api.v1.WorkspaceService/GetWorkspaceAnalytics"
+	WorkspaceService_ExportWorkspace_FullMethodName          = "/slash.api.v1.WorkspaceService/ExportWorkspace"
+	WorkspaceService_ImportWorkspace_FullMethodName          = "/slash.api.v1.WorkspaceService/ImportWorkspace"
+	WorkspaceService_ListAuditLogs_FullMethodName            = "/slash.api.v1.WorkspaceService/ListAuditLogs"
+	WorkspaceService_GetActivityRecorderStats_FullMethodName = "/slash.api.v1.WorkspaceService/GetActivityRecorderStats"
+	WorkspaceService_RotateSCIMToken_FullMethodName          = "/slash.api.v1.WorkspaceService/RotateSCIMToken"
 )
 
 // WorkspaceServiceClient is the client API for WorkspaceService service.
@@ -31,6 +37,19 @@ type WorkspaceServiceClient interface {
 	GetWorkspaceProfile(ctx context.Context, in *GetWorkspaceProfileRequest, opts ...grpc.CallOption) (*WorkspaceProfile, error)
 	GetWorkspaceSetting(ctx context.Context, in *GetWorkspaceSettingRequest, opts ...grpc.CallOption) (*WorkspaceSetting, error)
 	UpdateWorkspaceSetting(ctx context.Context, in *UpdateWorkspaceSettingRequest, opts ...grpc.CallOption) (*WorkspaceSetting, error)
+	// GetWorkspaceAnalytics returns the analytics of the shortcut views across the workspace.
+	GetWorkspaceAnalytics(ctx context.Context, in *GetWorkspaceAnalyticsRequest, opts ...grpc.CallOption) (*GetWorkspaceAnalyticsResponse, error)
+	// ExportWorkspace exports the whole workspace into a backup archive.
+	ExportWorkspace(ctx context.Context, in *ExportWorkspaceRequest, opts ...grpc.CallOption) (*ExportWorkspaceResponse, error)
+	// ImportWorkspace restores the workspace from a backup archive.
+	ImportWorkspace(ctx context.Context, in *ImportWorkspaceRequest, opts ...grpc.CallOption) (*ImportWorkspaceResponse, error)
+	// ListAuditLogs lists the audit logs of the mutating API calls, newest first.
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
+	// GetActivityRecorderStats returns the counters of the recorder writing the shortcut views in batches,
+	// e.g. the views dropped as its queue is full.
+	GetActivityRecorderStats(ctx context.Context, in *GetActivityRecorderStatsRequest, opts ...grpc.CallOption) (*ActivityRecorderStats, error)
+	// RotateSCIMToken generates the bearer token of the SCIM provisioning endpoints, which revokes the previous one.
+	RotateSCIMToken(ctx context.Context, in *RotateSCIMTokenRequest, opts ...grpc.CallOption) (*RotateSCIMTokenResponse, error)
 }
 
 type workspaceServiceClient struct {
@@ -71,6 +90,66 @@ func (c *workspaceServiceClient) UpdateWorkspaceSetting(ctx context.Context, in
 	return out, nil
 }
 
+func (c *workspaceServiceClient) GetWorkspaceAnalytics(ctx context.Context, in *GetWorkspaceAnalyticsRequest, opts ...grpc.CallOption) (*GetWorkspaceAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkspaceAnalyticsResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_GetWorkspaceAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ExportWorkspace(ctx context.Context, in *ExportWorkspaceRequest, opts ...grpc.CallOption) (*ExportWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportWorkspaceResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ExportWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ImportWorkspace(ctx context.Context, in *ImportWorkspaceRequest, opts ...grpc.CallOption) (*ImportWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportWorkspaceResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ImportWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogsResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) GetActivityRecorderStats(ctx context.Context, in *GetActivityRecorderStatsRequest, opts ...grpc.CallOption) (*ActivityRecorderStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivityRecorderStats)
+	err := c.cc.Invoke(ctx, WorkspaceService_GetActivityRecorderStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) RotateSCIMToken(ctx context.Context, in *RotateSCIMTokenRequest, opts ...grpc.CallOption) (*RotateSCIMTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateSCIMTokenResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_RotateSCIMToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceServiceServer is the server API for WorkspaceService service.
 // All implementations must embed UnimplementedWorkspaceServiceServer
 // for forward compatibility.
@@ -78,6 +157,19 @@ type WorkspaceServiceServer interface {
 	GetWorkspaceProfile(context.Context, *GetWorkspaceProfileRequest) (*WorkspaceProfile, error)
 	GetWorkspaceSetting(context.Context, *GetWorkspaceSettingRequest) (*WorkspaceSetting, error)
 	UpdateWorkspaceSetting(context.Context, *UpdateWorkspaceSettingRequest) (*WorkspaceSetting, error)
+	// GetWorkspaceAnalytics returns the analytics of the shortcut views across the workspace.
+	GetWorkspaceAnalytics(context.Context, *GetWorkspaceAnalyticsRequest) (*GetWorkspaceAnalyticsResponse, error)
+	// ExportWorkspace exports the whole workspace into a backup archive.
+	ExportWorkspace(context.Context, *ExportWorkspaceRequest) (*ExportWorkspaceResponse, error)
+	// ImportWorkspace restores the workspace from a backup archive.
+	ImportWorkspace(context.Context, *ImportWorkspaceRequest) (*ImportWorkspaceResponse, error)
+	// ListAuditLogs lists the audit logs of the mutating API calls, newest first.
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
+	// GetActivityRecorderStats returns the counters of the recorder writing the shortcut views in batches,
+	// e.g. the views dropped as its queue is full.
+	GetActivityRecorderStats(context.Context, *GetActivityRecorderStatsRequest) (*ActivityRecorderStats, error)
+	// RotateSCIMToken generates the bearer token of the SCIM provisioning endpoints, which revokes the previous one.
+	RotateSCIMToken(context.Context, *RotateSCIMTokenRequest) (*RotateSCIMTokenResponse, error)
 	mustEmbedUnimplementedWorkspaceServiceServer()
 }
 
@@ -97,6 +189,24 @@ func (UnimplementedWorkspaceServiceServer) GetWorkspaceSetting(context.Context,
 func (UnimplementedWorkspaceServiceServer) UpdateWorkspaceSetting(context.Context, *UpdateWorkspaceSettingRequest) (*WorkspaceSetting, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateWorkspaceSetting not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) GetWorkspaceAnalytics(context.Context, *GetWorkspaceAnalyticsRequest) (*GetWorkspaceAnalyticsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWorkspaceAnalytics not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ExportWorkspace(context.Context, *ExportWorkspaceRequest) (*ExportWorkspaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportWorkspace not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ImportWorkspace(context.Context, *ImportWorkspaceRequest) (*ImportWorkspaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportWorkspace not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) GetActivityRecorderStats(context.Context, *GetActivityRecorderStatsRequest) (*ActivityRecorderStats, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActivityRecorderStats not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) RotateSCIMToken(context.Context, *RotateSCIMTokenRequest) (*RotateSCIMTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateSCIMToken not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) mustEmbedUnimplementedWorkspaceServiceServer() {}
 func (UnimplementedWorkspaceServiceServer) testEmbeddedByValue()                          {}
 
@@ -172,6 +282,114 @@ func _WorkspaceService_UpdateWorkspaceSetting_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_GetWorkspaceAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetWorkspaceAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_GetWorkspaceAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetWorkspaceAnalytics(ctx, req.(*GetWorkspaceAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ExportWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ExportWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ExportWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ExportWorkspace(ctx, req.(*ExportWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ImportWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ImportWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ImportWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ImportWorkspace(ctx, req.(*ImportWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_GetActivityRecorderStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityRecorderStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetActivityRecorderStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_GetActivityRecorderStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetActivityRecorderStats(ctx, req.(*GetActivityRecorderStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_RotateSCIMToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSCIMTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).RotateSCIMToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_RotateSCIMToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).RotateSCIMToken(ctx, req.(*RotateSCIMTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceService_ServiceDesc is the grpc.ServiceDesc for WorkspaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +409,30 @@ var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateWorkspaceSetting",
 			Handler:    _WorkspaceService_UpdateWorkspaceSetting_Handler,
 		},
+		{
+			MethodName: "GetWorkspaceAnalytics",
+			Handler:    _WorkspaceService_GetWorkspaceAnalytics_Handler,
+		},
+		{
+			MethodName: "ExportWorkspace",
+			Handler:    _WorkspaceService_ExportWorkspace_Handler,
+		},
+		{
+			MethodName: "ImportWorkspace",
+			Handler:    _WorkspaceService_ImportWorkspace_Handler,
+		},
+		{
+			MethodName: "ListAuditLogs",
+			Handler:    _WorkspaceService_ListAuditLogs_Handler,
+		},
+		{
+			MethodName: "GetActivityRecorderStats",
+			Handler:    _WorkspaceService_GetActivityRecorderStats_Handler,
+		},
+		{
+			MethodName: "RotateSCIMToken",
+			Handler:    _WorkspaceService_RotateSCIMToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/workspace_service.proto",
@@ -141,6 +141,94 @@ func (x *ActivityShorcutViewPayload) GetParams() map[string]*ActivityShorcutView
 	return nil
 }
 
+type ActivityAuditPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The full gRPC method name, e.g. "/slash.api.v1.ShortcutService/UpdateShortcut".
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// The resource the method is called on, e.g. "shortcuts/1".
+	Resource string `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	// The request in JSON, with the sensitive fields masked.
+	Request   string `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	Ip        string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	UserAgent string `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// The gRPC status code of the result, e.g. "OK".
+	Code          string `protobuf:"bytes,6,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityAuditPayload) Reset() {
+	*x = ActivityAuditPayload{}
+	mi := &file_store_activity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityAuditPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityAuditPayload) ProtoMessage() {}
+
+func (x *ActivityAuditPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityAuditPayload.ProtoReflect.Descriptor instead.
+func (*ActivityAuditPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityAuditPayload) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ActivityAuditPayload) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *ActivityAuditPayload) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *ActivityAuditPayload) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ActivityAuditPayload) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *ActivityAuditPayload) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
 type ActivityShorcutViewPayload_ValueList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
@@ -150,7 +238,7 @@ type ActivityShorcutViewPayload_ValueList struct {
 
 func (x *ActivityShorcutViewPayload_ValueList) Reset() {
 	*x = ActivityShorcutViewPayload_ValueList{}
-	mi := &file_store_activity_proto_msgTypes[3]
+	mi := &file_store_activity_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -162,7 +250,7 @@ func (x *ActivityShorcutViewPayload_ValueList) String() string {
 func (*ActivityShorcutViewPayload_ValueList) ProtoMessage() {}
 
 func (x *ActivityShorcutViewPayload_ValueList) ProtoReflect() protoreflect.Message {
-	mi := &file_store_activity_proto_msgTypes[3]
+	mi := &file_store_activity_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,7 +293,15 @@ const file_store_activity_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12G\n" +
 	"\x05value\x18\x02 \x01(\v21.slash.store.ActivityShorcutViewPayload.ValueListR\x05value:\x028\x01\x1a#\n" +
 	"\tValueList\x12\x16\n" +
-	"\x06values\x18\x01 \x03(\tR\x06valuesB\x9e\x01\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\xa7\x01\n" +
+	"\x14ActivityAuditPayload\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x1a\n" +
+	"\bresource\x18\x02 \x01(\tR\bresource\x12\x18\n" +
+	"\arequest\x18\x03 \x01(\tR\arequest\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\x12\x12\n" +
+	"\x04code\x18\x06 \x01(\tR\x04codeB\x9e\x01\n" +
 	"\x0fcom.slash.storeB\rActivityProtoP\x01Z/github.com/yourselfhosted/slash/proto/gen/store\xa2\x02\x03SSX\xaa\x02\vSlash.Store\xca\x02\vSlash\\Store\xe2\x02\x17Slash\\Store\\GPBMetadata\xea\x02\fSlash::Storeb\x06proto3"
 
 var (
@@ -220,16 +316,17 @@ func file_store_activity_proto_rawDescGZIP() []byte {
 	return file_store_activity_proto_rawDescData
 }
 
-var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_store_activity_proto_goTypes = []any{
-	(*ActivityShorcutCreatePayload)(nil), // 0: slash.store.ActivityShorcutCreatePayload
-	(*ActivityShorcutViewPayload)(nil),   // 1: slash.store.ActivityShorcutViewPayload
-	nil,                                  // 2: slash.store.ActivityShorcutViewPayload.ParamsEntry
-	(*ActivityShorcutViewPayload_ValueList)(nil), // 3: slash.store.ActivityShorcutViewPayload.ValueList
+	(*ActivityShorcutCreatePayload)(nil),         // 0: slash.store.ActivityShorcutCreatePayload
+	(*ActivityShorcutViewPayload)(nil),           // 1: slash.store.ActivityShorcutViewPayload
+	(*ActivityAuditPayload)(nil),                 // 2: slash.store.ActivityAuditPayload
+	nil,                                          // 3: slash.store.ActivityShorcutViewPayload.ParamsEntry
+	(*ActivityShorcutViewPayload_ValueList)(nil), // 4: slash.store.ActivityShorcutViewPayload.ValueList
 }
 var file_store_activity_proto_depIdxs = []int32{
-	2, // 0: slash.store.ActivityShorcutViewPayload.params:type_name -> slash.store.ActivityShorcutViewPayload.ParamsEntry
-	3, // 1: slash.store.ActivityShorcutViewPayload.ParamsEntry.value:type_name -> slash.store.ActivityShorcutViewPayload.ValueList
+	3, // 0: slash.store.ActivityShorcutViewPayload.params:type_name -> slash.store.ActivityShorcutViewPayload.ParamsEntry
+	4, // 1: slash.store.ActivityShorcutViewPayload.ParamsEntry.value:type_name -> slash.store.ActivityShorcutViewPayload.ValueList
 	2, // [2:2] is the sub-list for method output_type
 	2, // [2:2] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
@@ -248,7 +345,7 @@ func file_store_activity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_activity_proto_rawDesc), len(file_store_activity_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
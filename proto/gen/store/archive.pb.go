@@ -0,0 +1,705 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: store/archive.proto
+
+package store
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WorkspaceArchive is the backup of a whole workspace, which can be restored to any database driver.
+type WorkspaceArchive struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The version of the archive format, increased on incompatible changes.
+	FormatVersion int32 `protobuf:"varint,1,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	// The version of Slash that created the archive.
+	SlashVersion      string                   `protobuf:"bytes,2,opt,name=slash_version,json=slashVersion,proto3" json:"slash_version,omitempty"`
+	CreatedTs         int64                    `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	Users             []*WorkspaceArchive_User `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+	UserSettings      []*UserSetting           `protobuf:"bytes,5,rep,name=user_settings,json=userSettings,proto3" json:"user_settings,omitempty"`
+	Shortcuts         []*Shortcut              `protobuf:"bytes,6,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+	Collections       []*Collection            `protobuf:"bytes,7,rep,name=collections,proto3" json:"collections,omitempty"`
+	WorkspaceSettings []*WorkspaceSetting      `protobuf:"bytes,8,rep,name=workspace_settings,json=workspaceSettings,proto3" json:"workspace_settings,omitempty"`
+	// The activities are only included on demand.
+	Activities []*WorkspaceArchive_Activity `protobuf:"bytes,9,rep,name=activities,proto3" json:"activities,omitempty"`
+	// The daily summaries of the compacted view activities, included along with the activities.
+	ShortcutViewSummaries []*WorkspaceArchive_ShortcutViewSummary `protobuf:"bytes,10,rep,name=shortcut_view_summaries,json=shortcutViewSummaries,proto3" json:"shortcut_view_summaries,omitempty"`
+	// The groups owning the shortcuts and collections, along with their members.
+	Groups        []*WorkspaceArchive_Group `protobuf:"bytes,11,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceArchive) Reset() {
+	*x = WorkspaceArchive{}
+	mi := &file_store_archive_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceArchive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceArchive) ProtoMessage() {}
+
+func (x *WorkspaceArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_store_archive_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceArchive.ProtoReflect.Descriptor instead.
+func (*WorkspaceArchive) Descriptor() ([]byte, []int) {
+	return file_store_archive_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorkspaceArchive) GetFormatVersion() int32 {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive) GetSlashVersion() string {
+	if x != nil {
+		return x.SlashVersion
+	}
+	return ""
+}
+
+func (x *WorkspaceArchive) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive) GetUsers() []*WorkspaceArchive_User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *WorkspaceArchive) GetUserSettings() []*UserSetting {
+	if x != nil {
+		return x.UserSettings
+	}
+	return nil
+}
+
+func (x *WorkspaceArchive) GetShortcuts() []*Shortcut {
+	if x != nil {
+		return x.Shortcuts
+	}
+	return nil
+}
+
+func (x *WorkspaceArchive) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *WorkspaceArchive) GetWorkspaceSettings() []*WorkspaceSetting {
+	if x != nil {
+		return x.WorkspaceSettings
+	}
+	return nil
+}
+
+func (x *WorkspaceArchive) GetActivities() []*WorkspaceArchive_Activity {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+func (x *WorkspaceArchive) GetShortcutViewSummaries() []*WorkspaceArchive_ShortcutViewSummary {
+	if x != nil {
+		return x.ShortcutViewSummaries
+	}
+	return nil
+}
+
+func (x *WorkspaceArchive) GetGroups() []*WorkspaceArchive_Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type WorkspaceArchive_User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedTs     int64                  `protobuf:"varint,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	UpdatedTs     int64                  `protobuf:"varint,3,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
+	RowStatus     RowStatus              `protobuf:"varint,4,opt,name=row_status,json=rowStatus,proto3,enum=slash.store.RowStatus" json:"row_status,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Nickname      string                 `protobuf:"bytes,6,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	PasswordHash  string                 `protobuf:"bytes,7,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+	Role          string                 `protobuf:"bytes,8,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceArchive_User) Reset() {
+	*x = WorkspaceArchive_User{}
+	mi := &file_store_archive_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceArchive_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceArchive_User) ProtoMessage() {}
+
+func (x *WorkspaceArchive_User) ProtoReflect() protoreflect.Message {
+	mi := &file_store_archive_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceArchive_User.ProtoReflect.Descriptor instead.
+func (*WorkspaceArchive_User) Descriptor() ([]byte, []int) {
+	return file_store_archive_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *WorkspaceArchive_User) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_User) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_User) GetUpdatedTs() int64 {
+	if x != nil {
+		return x.UpdatedTs
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_User) GetRowStatus() RowStatus {
+	if x != nil {
+		return x.RowStatus
+	}
+	return RowStatus_ROW_STATUS_UNSPECIFIED
+}
+
+func (x *WorkspaceArchive_User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *WorkspaceArchive_User) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *WorkspaceArchive_User) GetPasswordHash() string {
+	if x != nil {
+		return x.PasswordHash
+	}
+	return ""
+}
+
+func (x *WorkspaceArchive_User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type WorkspaceArchive_Group struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Id            int32                            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedTs     int64                            `protobuf:"varint,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	UpdatedTs     int64                            `protobuf:"varint,3,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
+	Name          string                           `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                           `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Members       []*WorkspaceArchive_Group_Member `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceArchive_Group) Reset() {
+	*x = WorkspaceArchive_Group{}
+	mi := &file_store_archive_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceArchive_Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceArchive_Group) ProtoMessage() {}
+
+func (x *WorkspaceArchive_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_store_archive_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceArchive_Group.ProtoReflect.Descriptor instead.
+func (*WorkspaceArchive_Group) Descriptor() ([]byte, []int) {
+	return file_store_archive_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *WorkspaceArchive_Group) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_Group) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_Group) GetUpdatedTs() int64 {
+	if x != nil {
+		return x.UpdatedTs
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkspaceArchive_Group) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WorkspaceArchive_Group) GetMembers() []*WorkspaceArchive_Group_Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type WorkspaceArchive_Activity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId     int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTs     int64                  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Level         string                 `protobuf:"bytes,5,opt,name=level,proto3" json:"level,omitempty"`
+	Payload       string                 `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceArchive_Activity) Reset() {
+	*x = WorkspaceArchive_Activity{}
+	mi := &file_store_archive_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceArchive_Activity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceArchive_Activity) ProtoMessage() {}
+
+func (x *WorkspaceArchive_Activity) ProtoReflect() protoreflect.Message {
+	mi := &file_store_archive_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceArchive_Activity.ProtoReflect.Descriptor instead.
+func (*WorkspaceArchive_Activity) Descriptor() ([]byte, []int) {
+	return file_store_archive_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *WorkspaceArchive_Activity) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_Activity) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_Activity) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_Activity) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WorkspaceArchive_Activity) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *WorkspaceArchive_Activity) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type WorkspaceArchive_ShortcutViewSummary struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	// The start of the UTC day.
+	BucketTs           int64            `protobuf:"varint,2,opt,name=bucket_ts,json=bucketTs,proto3" json:"bucket_ts,omitempty"`
+	ViewCount          int32            `protobuf:"varint,3,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	UniqueVisitorCount int32            `protobuf:"varint,4,opt,name=unique_visitor_count,json=uniqueVisitorCount,proto3" json:"unique_visitor_count,omitempty"`
+	RefererCounts      map[string]int32 `protobuf:"bytes,5,rep,name=referer_counts,json=refererCounts,proto3" json:"referer_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	UserAgentCounts    map[string]int32 `protobuf:"bytes,6,rep,name=user_agent_counts,json=userAgentCounts,proto3" json:"user_agent_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WorkspaceArchive_ShortcutViewSummary) Reset() {
+	*x = WorkspaceArchive_ShortcutViewSummary{}
+	mi := &file_store_archive_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceArchive_ShortcutViewSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceArchive_ShortcutViewSummary) ProtoMessage() {}
+
+func (x *WorkspaceArchive_ShortcutViewSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_store_archive_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceArchive_ShortcutViewSummary.ProtoReflect.Descriptor instead.
+func (*WorkspaceArchive_ShortcutViewSummary) Descriptor() ([]byte, []int) {
+	return file_store_archive_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *WorkspaceArchive_ShortcutViewSummary) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_ShortcutViewSummary) GetBucketTs() int64 {
+	if x != nil {
+		return x.BucketTs
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_ShortcutViewSummary) GetViewCount() int32 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_ShortcutViewSummary) GetUniqueVisitorCount() int32 {
+	if x != nil {
+		return x.UniqueVisitorCount
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_ShortcutViewSummary) GetRefererCounts() map[string]int32 {
+	if x != nil {
+		return x.RefererCounts
+	}
+	return nil
+}
+
+func (x *WorkspaceArchive_ShortcutViewSummary) GetUserAgentCounts() map[string]int32 {
+	if x != nil {
+		return x.UserAgentCounts
+	}
+	return nil
+}
+
+type WorkspaceArchive_Group_Member struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceArchive_Group_Member) Reset() {
+	*x = WorkspaceArchive_Group_Member{}
+	mi := &file_store_archive_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceArchive_Group_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceArchive_Group_Member) ProtoMessage() {}
+
+func (x *WorkspaceArchive_Group_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_store_archive_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceArchive_Group_Member.ProtoReflect.Descriptor instead.
+func (*WorkspaceArchive_Group_Member) Descriptor() ([]byte, []int) {
+	return file_store_archive_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+func (x *WorkspaceArchive_Group_Member) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *WorkspaceArchive_Group_Member) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+var File_store_archive_proto protoreflect.FileDescriptor
+
+const file_store_archive_proto_rawDesc = "" +
+	"\n" +
+	"\x13store/archive.proto\x12\vslash.store\x1a\x16store/collection.proto\x1a\x12store/common.proto\x1a\x14store/shortcut.proto\x1a\x18store/user_setting.proto\x1a\x1dstore/workspace_setting.proto\"\xd5\x0e\n" +
+	"\x10WorkspaceArchive\x12%\n" +
+	"\x0eformat_version\x18\x01 \x01(\x05R\rformatVersion\x12#\n" +
+	"\rslash_version\x18\x02 \x01(\tR\fslashVersion\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x03 \x01(\x03R\tcreatedTs\x128\n" +
+	"\x05users\x18\x04 \x03(\v2\".slash.store.WorkspaceArchive.UserR\x05users\x12=\n" +
+	"\ruser_settings\x18\x05 \x03(\v2\x18.slash.store.UserSettingR\fuserSettings\x123\n" +
+	"\tshortcuts\x18\x06 \x03(\v2\x15.slash.store.ShortcutR\tshortcuts\x129\n" +
+	"\vcollections\x18\a \x03(\v2\x17.slash.store.CollectionR\vcollections\x12L\n" +
+	"\x12workspace_settings\x18\b \x03(\v2\x1d.slash.store.WorkspaceSettingR\x11workspaceSettings\x12F\n" +
+	"\n" +
+	"activities\x18\t \x03(\v2&.slash.store.WorkspaceArchive.ActivityR\n" +
+	"activities\x12i\n" +
+	"\x17shortcut_view_summaries\x18\n" +
+	" \x03(\v21.slash.store.WorkspaceArchive.ShortcutViewSummaryR\x15shortcutViewSummaries\x12;\n" +
+	"\x06groups\x18\v \x03(\v2#.slash.store.WorkspaceArchive.GroupR\x06groups\x1a\xf6\x01\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x02 \x01(\x03R\tcreatedTs\x12\x1d\n" +
+	"\n" +
+	"updated_ts\x18\x03 \x01(\x03R\tupdatedTs\x125\n" +
+	"\n" +
+	"row_status\x18\x04 \x01(\x0e2\x16.slash.store.RowStatusR\trowStatus\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x1a\n" +
+	"\bnickname\x18\x06 \x01(\tR\bnickname\x12#\n" +
+	"\rpassword_hash\x18\a \x01(\tR\fpasswordHash\x12\x12\n" +
+	"\x04role\x18\b \x01(\tR\x04role\x1a\x88\x02\n" +
+	"\x05Group\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x02 \x01(\x03R\tcreatedTs\x12\x1d\n" +
+	"\n" +
+	"updated_ts\x18\x03 \x01(\x03R\tupdatedTs\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12D\n" +
+	"\amembers\x18\x06 \x03(\v2*.slash.store.WorkspaceArchive.Group.MemberR\amembers\x1a5\n" +
+	"\x06Member\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x1a\x9c\x01\n" +
+	"\bActivity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\x05R\tcreatorId\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x03 \x01(\x03R\tcreatedTs\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x14\n" +
+	"\x05level\x18\x05 \x01(\tR\x05level\x12\x18\n" +
+	"\apayload\x18\x06 \x01(\tR\apayload\x1a\x8b\x04\n" +
+	"\x13ShortcutViewSummary\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x1b\n" +
+	"\tbucket_ts\x18\x02 \x01(\x03R\bbucketTs\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x03 \x01(\x05R\tviewCount\x120\n" +
+	"\x14unique_visitor_count\x18\x04 \x01(\x05R\x12uniqueVisitorCount\x12k\n" +
+	"\x0ereferer_counts\x18\x05 \x03(\v2D.slash.store.WorkspaceArchive.ShortcutViewSummary.RefererCountsEntryR\rrefererCounts\x12r\n" +
+	"\x11user_agent_counts\x18\x06 \x03(\v2F.slash.store.WorkspaceArchive.ShortcutViewSummary.UserAgentCountsEntryR\x0fuserAgentCounts\x1a@\n" +
+	"\x12RefererCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aB\n" +
+	"\x14UserAgentCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01B\x9d\x01\n" +
+	"\x0fcom.slash.storeB\fArchiveProtoP\x01Z/github.com/yourselfhosted/slash/proto/gen/store\xa2\x02\x03SSX\xaa\x02\vSlash.Store\xca\x02\vSlash\\Store\xe2\x02\x17Slash\\Store\\GPBMetadata\xea\x02\fSlash::Storeb\x06proto3"
+
+var (
+	file_store_archive_proto_rawDescOnce sync.Once
+	file_store_archive_proto_rawDescData []byte
+)
+
+func file_store_archive_proto_rawDescGZIP() []byte {
+	file_store_archive_proto_rawDescOnce.Do(func() {
+		file_store_archive_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_store_archive_proto_rawDesc), len(file_store_archive_proto_rawDesc)))
+	})
+	return file_store_archive_proto_rawDescData
+}
+
+var file_store_archive_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_store_archive_proto_goTypes = []any{
+	(*WorkspaceArchive)(nil),                     // 0: slash.store.WorkspaceArchive
+	(*WorkspaceArchive_User)(nil),                // 1: slash.store.WorkspaceArchive.User
+	(*WorkspaceArchive_Group)(nil),               // 2: slash.store.WorkspaceArchive.Group
+	(*WorkspaceArchive_Activity)(nil),            // 3: slash.store.WorkspaceArchive.Activity
+	(*WorkspaceArchive_ShortcutViewSummary)(nil), // 4: slash.store.WorkspaceArchive.ShortcutViewSummary
+	(*WorkspaceArchive_Group_Member)(nil),        // 5: slash.store.WorkspaceArchive.Group.Member
+	nil,                                          // 6: slash.store.WorkspaceArchive.ShortcutViewSummary.RefererCountsEntry
+	nil,                                          // 7: slash.store.WorkspaceArchive.ShortcutViewSummary.UserAgentCountsEntry
+	(*UserSetting)(nil),                          // 8: slash.store.UserSetting
+	(*Shortcut)(nil),                             // 9: slash.store.Shortcut
+	(*Collection)(nil),                           // 10: slash.store.Collection
+	(*WorkspaceSetting)(nil),                     // 11: slash.store.WorkspaceSetting
+	(RowStatus)(0),                               // 12: slash.store.RowStatus
+}
+var file_store_archive_proto_depIdxs = []int32{
+	1,  // 0: slash.store.WorkspaceArchive.users:type_name -> slash.store.WorkspaceArchive.User
+	8,  // 1: slash.store.WorkspaceArchive.user_settings:type_name -> slash.store.UserSetting
+	9,  // 2: slash.store.WorkspaceArchive.shortcuts:type_name -> slash.store.Shortcut
+	10, // 3: slash.store.WorkspaceArchive.collections:type_name -> slash.store.Collection
+	11, // 4: slash.store.WorkspaceArchive.workspace_settings:type_name -> slash.store.WorkspaceSetting
+	3,  // 5: slash.store.WorkspaceArchive.activities:type_name -> slash.store.WorkspaceArchive.Activity
+	4,  // 6: slash.store.WorkspaceArchive.shortcut_view_summaries:type_name -> slash.store.WorkspaceArchive.ShortcutViewSummary
+	2,  // 7: slash.store.WorkspaceArchive.groups:type_name -> slash.store.WorkspaceArchive.Group
+	12, // 8: slash.store.WorkspaceArchive.User.row_status:type_name -> slash.store.RowStatus
+	5,  // 9: slash.store.WorkspaceArchive.Group.members:type_name -> slash.store.WorkspaceArchive.Group.Member
+	6,  // 10: slash.store.WorkspaceArchive.ShortcutViewSummary.referer_counts:type_name -> slash.store.WorkspaceArchive.ShortcutViewSummary.RefererCountsEntry
+	7,  // 11: slash.store.WorkspaceArchive.ShortcutViewSummary.user_agent_counts:type_name -> slash.store.WorkspaceArchive.ShortcutViewSummary.UserAgentCountsEntry
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_store_archive_proto_init() }
+func file_store_archive_proto_init() {
+	if File_store_archive_proto != nil {
+		return
+	}
+	file_store_collection_proto_init()
+	file_store_common_proto_init()
+	file_store_shortcut_proto_init()
+	file_store_user_setting_proto_init()
+	file_store_workspace_setting_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_archive_proto_rawDesc), len(file_store_archive_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_store_archive_proto_goTypes,
+		DependencyIndexes: file_store_archive_proto_depIdxs,
+		MessageInfos:      file_store_archive_proto_msgTypes,
+	}.Build()
+	File_store_archive_proto = out.File
+	file_store_archive_proto_goTypes = nil
+	file_store_archive_proto_depIdxs = nil
+}
@@ -76,6 +76,7 @@ const (
 	Visibility_VISIBILITY_UNSPECIFIED Visibility = 0
 	Visibility_WORKSPACE              Visibility = 1
 	Visibility_PUBLIC                 Visibility = 2
+	Visibility_PRIVATE                Visibility = 3
 )
 
 // Enum value maps for Visibility.
@@ -84,11 +85,13 @@ var (
 		0: "VISIBILITY_UNSPECIFIED",
 		1: "WORKSPACE",
 		2: "PUBLIC",
+		3: "PRIVATE",
 	}
 	Visibility_value = map[string]int32{
 		"VISIBILITY_UNSPECIFIED": 0,
 		"WORKSPACE":              1,
 		"PUBLIC":                 2,
+		"PRIVATE":                3,
 	}
 )
 
@@ -128,13 +131,14 @@ const file_store_common_proto_rawDesc = "" +
 	"\x16ROW_STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06NORMAL\x10\x01\x12\f\n" +
-	"\bARCHIVED\x10\x02*C\n" +
+	"\bARCHIVED\x10\x02*P\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tWORKSPACE\x10\x01\x12\n" +
 	"\n" +
-	"\x06PUBLIC\x10\x02B\x9c\x01\n" +
+	"\x06PUBLIC\x10\x02\x12\v\n" +
+	"\aPRIVATE\x10\x03B\x9c\x01\n" +
 	"\x0fcom.slash.storeB\vCommonProtoP\x01Z/github.com/yourselfhosted/slash/proto/gen/store\xa2\x02\x03SSX\xaa\x02\vSlash.Store\xca\x02\vSlash\\Store\xe2\x02\x17Slash\\Store\\GPBMetadata\xea\x02\fSlash::Storeb\x06proto3"
 
 var (
@@ -26,6 +26,9 @@ type IdentityProvider_Type int32
 const (
 	IdentityProvider_TYPE_UNSPECIFIED IdentityProvider_Type = 0
 	IdentityProvider_OAUTH2           IdentityProvider_Type = 1
+	IdentityProvider_OIDC             IdentityProvider_Type = 2
+	IdentityProvider_SAML             IdentityProvider_Type = 3
+	IdentityProvider_LDAP             IdentityProvider_Type = 4
 )
 
 // Enum value maps for IdentityProvider_Type.
@@ -33,10 +36,16 @@ var (
 	IdentityProvider_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "OAUTH2",
+		2: "OIDC",
+		3: "SAML",
+		4: "LDAP",
 	}
 	IdentityProvider_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"OAUTH2":           1,
+		"OIDC":             2,
+		"SAML":             3,
+		"LDAP":             4,
 	}
 )
 
@@ -70,12 +79,17 @@ func (IdentityProvider_Type) EnumDescriptor() ([]byte, []int) {
 type IdentityProvider struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The unique identifier of the identity provider.
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Type          IdentityProvider_Type   `protobuf:"varint,3,opt,name=type,proto3,enum=slash.store.IdentityProvider_Type" json:"type,omitempty"`
-	Config        *IdentityProviderConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Id     string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title  string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Type   IdentityProvider_Type   `protobuf:"varint,3,opt,name=type,proto3,enum=slash.store.IdentityProvider_Type" json:"type,omitempty"`
+	Config *IdentityProviderConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	// The rules deciding the role of the user on every sign in, where the first matching one applies.
+	// The users matching none keep their role, or get the USER role on their first sign in. The last admin is never demoted.
+	RoleMappingRules []*IdentityProvider_RoleMappingRule `protobuf:"bytes,5,rep,name=role_mapping_rules,json=roleMappingRules,proto3" json:"role_mapping_rules,omitempty"`
+	// The email domains of the users to provision on their first sign in, any if empty.
+	AllowedEmailDomains []string `protobuf:"bytes,6,rep,name=allowed_email_domains,json=allowedEmailDomains,proto3" json:"allowed_email_domains,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *IdentityProvider) Reset() {
@@ -136,11 +150,28 @@ func (x *IdentityProvider) GetConfig() *IdentityProviderConfig {
 	return nil
 }
 
+func (x *IdentityProvider) GetRoleMappingRules() []*IdentityProvider_RoleMappingRule {
+	if x != nil {
+		return x.RoleMappingRules
+	}
+	return nil
+}
+
+func (x *IdentityProvider) GetAllowedEmailDomains() []string {
+	if x != nil {
+		return x.AllowedEmailDomains
+	}
+	return nil
+}
+
 type IdentityProviderConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Config:
 	//
 	//	*IdentityProviderConfig_Oauth2
+	//	*IdentityProviderConfig_Oidc
+	//	*IdentityProviderConfig_Saml
+	//	*IdentityProviderConfig_Ldap
 	Config        isIdentityProviderConfig_Config `protobuf_oneof:"config"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -192,6 +223,33 @@ func (x *IdentityProviderConfig) GetOauth2() *IdentityProviderConfig_OAuth2Confi
 	return nil
 }
 
+func (x *IdentityProviderConfig) GetOidc() *IdentityProviderConfig_OIDCConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_Oidc); ok {
+			return x.Oidc
+		}
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig) GetSaml() *IdentityProviderConfig_SAMLConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_Saml); ok {
+			return x.Saml
+		}
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig) GetLdap() *IdentityProviderConfig_LDAPConfig {
+	if x != nil {
+		if x, ok := x.Config.(*IdentityProviderConfig_Ldap); ok {
+			return x.Ldap
+		}
+	}
+	return nil
+}
+
 type isIdentityProviderConfig_Config interface {
 	isIdentityProviderConfig_Config()
 }
@@ -200,19 +258,111 @@ type IdentityProviderConfig_Oauth2 struct {
 	Oauth2 *IdentityProviderConfig_OAuth2Config `protobuf:"bytes,1,opt,name=oauth2,proto3,oneof"`
 }
 
+type IdentityProviderConfig_Oidc struct {
+	Oidc *IdentityProviderConfig_OIDCConfig `protobuf:"bytes,2,opt,name=oidc,proto3,oneof"`
+}
+
+type IdentityProviderConfig_Saml struct {
+	Saml *IdentityProviderConfig_SAMLConfig `protobuf:"bytes,3,opt,name=saml,proto3,oneof"`
+}
+
+type IdentityProviderConfig_Ldap struct {
+	Ldap *IdentityProviderConfig_LDAPConfig `protobuf:"bytes,4,opt,name=ldap,proto3,oneof"`
+}
+
 func (*IdentityProviderConfig_Oauth2) isIdentityProviderConfig_Config() {}
 
+func (*IdentityProviderConfig_Oidc) isIdentityProviderConfig_Config() {}
+
+func (*IdentityProviderConfig_Saml) isIdentityProviderConfig_Config() {}
+
+func (*IdentityProviderConfig_Ldap) isIdentityProviderConfig_Config() {}
+
+type IdentityProvider_RoleMappingRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The groups of the user to match any of, which matches any user if empty.
+	Groups []string `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// The email domains of the user to match any of, which matches any user if empty.
+	EmailDomains []string `protobuf:"bytes,2,rep,name=email_domains,json=emailDomains,proto3" json:"email_domains,omitempty"`
+	// The role to assign, e.g. "ADMIN" or "USER".
+	Role string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	// Whether to deny the sign in instead of assigning a role.
+	Deny          bool `protobuf:"varint,4,opt,name=deny,proto3" json:"deny,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProvider_RoleMappingRule) Reset() {
+	*x = IdentityProvider_RoleMappingRule{}
+	mi := &file_store_idp_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProvider_RoleMappingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProvider_RoleMappingRule) ProtoMessage() {}
+
+func (x *IdentityProvider_RoleMappingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_store_idp_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProvider_RoleMappingRule.ProtoReflect.Descriptor instead.
+func (*IdentityProvider_RoleMappingRule) Descriptor() ([]byte, []int) {
+	return file_store_idp_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *IdentityProvider_RoleMappingRule) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *IdentityProvider_RoleMappingRule) GetEmailDomains() []string {
+	if x != nil {
+		return x.EmailDomains
+	}
+	return nil
+}
+
+func (x *IdentityProvider_RoleMappingRule) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *IdentityProvider_RoleMappingRule) GetDeny() bool {
+	if x != nil {
+		return x.Deny
+	}
+	return false
+}
+
 type IdentityProviderConfig_FieldMapping struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Identifier    string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Identifier  string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// The claim or attribute listing the groups of the user.
+	Groups        string `protobuf:"bytes,3,opt,name=groups,proto3" json:"groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *IdentityProviderConfig_FieldMapping) Reset() {
 	*x = IdentityProviderConfig_FieldMapping{}
-	mi := &file_store_idp_proto_msgTypes[2]
+	mi := &file_store_idp_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -224,7 +374,7 @@ func (x *IdentityProviderConfig_FieldMapping) String() string {
 func (*IdentityProviderConfig_FieldMapping) ProtoMessage() {}
 
 func (x *IdentityProviderConfig_FieldMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_store_idp_proto_msgTypes[2]
+	mi := &file_store_idp_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -254,6 +404,13 @@ func (x *IdentityProviderConfig_FieldMapping) GetDisplayName() string {
 	return ""
 }
 
+func (x *IdentityProviderConfig_FieldMapping) GetGroups() string {
+	if x != nil {
+		return x.Groups
+	}
+	return ""
+}
+
 type IdentityProviderConfig_OAuth2Config struct {
 	state         protoimpl.MessageState               `protogen:"open.v1"`
 	ClientId      string                               `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
@@ -269,7 +426,7 @@ type IdentityProviderConfig_OAuth2Config struct {
 
 func (x *IdentityProviderConfig_OAuth2Config) Reset() {
 	*x = IdentityProviderConfig_OAuth2Config{}
-	mi := &file_store_idp_proto_msgTypes[3]
+	mi := &file_store_idp_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -281,7 +438,7 @@ func (x *IdentityProviderConfig_OAuth2Config) String() string {
 func (*IdentityProviderConfig_OAuth2Config) ProtoMessage() {}
 
 func (x *IdentityProviderConfig_OAuth2Config) ProtoReflect() protoreflect.Message {
-	mi := &file_store_idp_proto_msgTypes[3]
+	mi := &file_store_idp_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -346,27 +503,301 @@ func (x *IdentityProviderConfig_OAuth2Config) GetFieldMapping() *IdentityProvide
 	return nil
 }
 
+type IdentityProviderConfig_OIDCConfig struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ClientId     string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// The issuer url, where the ".well-known/openid-configuration" document is discovered.
+	IssuerUrl string `protobuf:"bytes,3,opt,name=issuer_url,json=issuerUrl,proto3" json:"issuer_url,omitempty"`
+	// The scopes to request, "openid" is always requested.
+	Scopes []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// The mapping of the ID token claims, defaults to "email" and "name".
+	FieldMapping *IdentityProviderConfig_FieldMapping `protobuf:"bytes,5,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	// Whether to accept the emails without the "email_verified" claim, for the providers that never send it.
+	// The emails are rejected if the claim is false.
+	AllowMissingEmailVerified bool `protobuf:"varint,6,opt,name=allow_missing_email_verified,json=allowMissingEmailVerified,proto3" json:"allow_missing_email_verified,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) Reset() {
+	*x = IdentityProviderConfig_OIDCConfig{}
+	mi := &file_store_idp_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProviderConfig_OIDCConfig) ProtoMessage() {}
+
+func (x *IdentityProviderConfig_OIDCConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_idp_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProviderConfig_OIDCConfig.ProtoReflect.Descriptor instead.
+func (*IdentityProviderConfig_OIDCConfig) Descriptor() ([]byte, []int) {
+	return file_store_idp_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) GetIssuerUrl() string {
+	if x != nil {
+		return x.IssuerUrl
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) GetFieldMapping() *IdentityProviderConfig_FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig_OIDCConfig) GetAllowMissingEmailVerified() bool {
+	if x != nil {
+		return x.AllowMissingEmailVerified
+	}
+	return false
+}
+
+type IdentityProviderConfig_SAMLConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The metadata XML of the IdP, which takes precedence over the metadata url.
+	IdpMetadataXml string `protobuf:"bytes,1,opt,name=idp_metadata_xml,json=idpMetadataXml,proto3" json:"idp_metadata_xml,omitempty"`
+	// The url to fetch the metadata of the IdP from.
+	IdpMetadataUrl string `protobuf:"bytes,2,opt,name=idp_metadata_url,json=idpMetadataUrl,proto3" json:"idp_metadata_url,omitempty"`
+	// The entity ID of the service provider, defaults to its metadata url.
+	EntityId string `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// The mapping of the assertion attributes, the identifier defaults to the NameID.
+	FieldMapping  *IdentityProviderConfig_FieldMapping `protobuf:"bytes,4,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) Reset() {
+	*x = IdentityProviderConfig_SAMLConfig{}
+	mi := &file_store_idp_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProviderConfig_SAMLConfig) ProtoMessage() {}
+
+func (x *IdentityProviderConfig_SAMLConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_idp_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProviderConfig_SAMLConfig.ProtoReflect.Descriptor instead.
+func (*IdentityProviderConfig_SAMLConfig) Descriptor() ([]byte, []int) {
+	return file_store_idp_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetIdpMetadataXml() string {
+	if x != nil {
+		return x.IdpMetadataXml
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetIdpMetadataUrl() string {
+	if x != nil {
+		return x.IdpMetadataUrl
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_SAMLConfig) GetFieldMapping() *IdentityProviderConfig_FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
+type IdentityProviderConfig_LDAPConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The url of the LDAP server, e.g. "ldaps://ldap.example.com:636".
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The DN to bind with to search the users, the search is anonymous if empty.
+	BindDn       string `protobuf:"bytes,2,opt,name=bind_dn,json=bindDn,proto3" json:"bind_dn,omitempty"`
+	BindPassword string `protobuf:"bytes,3,opt,name=bind_password,json=bindPassword,proto3" json:"bind_password,omitempty"`
+	// The base DN to search the users under.
+	SearchBase string `protobuf:"bytes,4,opt,name=search_base,json=searchBase,proto3" json:"search_base,omitempty"`
+	// The filter to search the user, where "{username}" is replaced with the escaped username.
+	// Defaults to "(mail={username})".
+	UserFilter string `protobuf:"bytes,5,opt,name=user_filter,json=userFilter,proto3" json:"user_filter,omitempty"`
+	// The mapping of the user attributes, defaults to "mail" and "cn".
+	FieldMapping *IdentityProviderConfig_FieldMapping `protobuf:"bytes,6,opt,name=field_mapping,json=fieldMapping,proto3" json:"field_mapping,omitempty"`
+	// Whether to upgrade the connection with StartTLS, for the "ldap://" urls.
+	StartTls      bool `protobuf:"varint,7,opt,name=start_tls,json=startTls,proto3" json:"start_tls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) Reset() {
+	*x = IdentityProviderConfig_LDAPConfig{}
+	mi := &file_store_idp_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityProviderConfig_LDAPConfig) ProtoMessage() {}
+
+func (x *IdentityProviderConfig_LDAPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_idp_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityProviderConfig_LDAPConfig.ProtoReflect.Descriptor instead.
+func (*IdentityProviderConfig_LDAPConfig) Descriptor() ([]byte, []int) {
+	return file_store_idp_proto_rawDescGZIP(), []int{1, 4}
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetBindDn() string {
+	if x != nil {
+		return x.BindDn
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetBindPassword() string {
+	if x != nil {
+		return x.BindPassword
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetSearchBase() string {
+	if x != nil {
+		return x.SearchBase
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetUserFilter() string {
+	if x != nil {
+		return x.UserFilter
+	}
+	return ""
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetFieldMapping() *IdentityProviderConfig_FieldMapping {
+	if x != nil {
+		return x.FieldMapping
+	}
+	return nil
+}
+
+func (x *IdentityProviderConfig_LDAPConfig) GetStartTls() bool {
+	if x != nil {
+		return x.StartTls
+	}
+	return false
+}
+
 var File_store_idp_proto protoreflect.FileDescriptor
 
 const file_store_idp_proto_rawDesc = "" +
 	"\n" +
-	"\x0fstore/idp.proto\x12\vslash.store\"\xd7\x01\n" +
+	"\x0fstore/idp.proto\x12\vslash.store\"\xfe\x03\n" +
 	"\x10IdentityProvider\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x126\n" +
 	"\x04type\x18\x03 \x01(\x0e2\".slash.store.IdentityProvider.TypeR\x04type\x12;\n" +
-	"\x06config\x18\x04 \x01(\v2#.slash.store.IdentityProviderConfigR\x06config\"(\n" +
+	"\x06config\x18\x04 \x01(\v2#.slash.store.IdentityProviderConfigR\x06config\x12[\n" +
+	"\x12role_mapping_rules\x18\x05 \x03(\v2-.slash.store.IdentityProvider.RoleMappingRuleR\x10roleMappingRules\x122\n" +
+	"\x15allowed_email_domains\x18\x06 \x03(\tR\x13allowedEmailDomains\x1av\n" +
+	"\x0fRoleMappingRule\x12\x16\n" +
+	"\x06groups\x18\x01 \x03(\tR\x06groups\x12#\n" +
+	"\remail_domains\x18\x02 \x03(\tR\femailDomains\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x12\n" +
+	"\x04deny\x18\x04 \x01(\bR\x04deny\"F\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
-	"\x06OAUTH2\x10\x01\"\xdf\x03\n" +
+	"\x06OAUTH2\x10\x01\x12\b\n" +
+	"\x04OIDC\x10\x02\x12\b\n" +
+	"\x04SAML\x10\x03\x12\b\n" +
+	"\x04LDAP\x10\x04\"\xd5\v\n" +
 	"\x16IdentityProviderConfig\x12J\n" +
-	"\x06oauth2\x18\x01 \x01(\v20.slash.store.IdentityProviderConfig.OAuth2ConfigH\x00R\x06oauth2\x1aQ\n" +
+	"\x06oauth2\x18\x01 \x01(\v20.slash.store.IdentityProviderConfig.OAuth2ConfigH\x00R\x06oauth2\x12D\n" +
+	"\x04oidc\x18\x02 \x01(\v2..slash.store.IdentityProviderConfig.OIDCConfigH\x00R\x04oidc\x12D\n" +
+	"\x04saml\x18\x03 \x01(\v2..slash.store.IdentityProviderConfig.SAMLConfigH\x00R\x04saml\x12D\n" +
+	"\x04ldap\x18\x04 \x01(\v2..slash.store.IdentityProviderConfig.LDAPConfigH\x00R\x04ldap\x1ai\n" +
 	"\fFieldMapping\x12\x1e\n" +
 	"\n" +
 	"identifier\x18\x01 \x01(\tR\n" +
 	"identifier\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x1a\x9b\x02\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06groups\x18\x03 \x01(\tR\x06groups\x1a\x9b\x02\n" +
 	"\fOAuth2Config\x12\x1b\n" +
 	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
 	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12\x19\n" +
@@ -374,7 +805,33 @@ const file_store_idp_proto_rawDesc = "" +
 	"\ttoken_url\x18\x04 \x01(\tR\btokenUrl\x12\"\n" +
 	"\ruser_info_url\x18\x05 \x01(\tR\vuserInfoUrl\x12\x16\n" +
 	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x12U\n" +
-	"\rfield_mapping\x18\a \x01(\v20.slash.store.IdentityProviderConfig.FieldMappingR\ffieldMappingB\b\n" +
+	"\rfield_mapping\x18\a \x01(\v20.slash.store.IdentityProviderConfig.FieldMappingR\ffieldMapping\x1a\x9d\x02\n" +
+	"\n" +
+	"OIDCConfig\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\x12\x1d\n" +
+	"\n" +
+	"issuer_url\x18\x03 \x01(\tR\tissuerUrl\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12U\n" +
+	"\rfield_mapping\x18\x05 \x01(\v20.slash.store.IdentityProviderConfig.FieldMappingR\ffieldMapping\x12?\n" +
+	"\x1callow_missing_email_verified\x18\x06 \x01(\bR\x19allowMissingEmailVerified\x1a\xd4\x01\n" +
+	"\n" +
+	"SAMLConfig\x12(\n" +
+	"\x10idp_metadata_xml\x18\x01 \x01(\tR\x0eidpMetadataXml\x12(\n" +
+	"\x10idp_metadata_url\x18\x02 \x01(\tR\x0eidpMetadataUrl\x12\x1b\n" +
+	"\tentity_id\x18\x03 \x01(\tR\bentityId\x12U\n" +
+	"\rfield_mapping\x18\x04 \x01(\v20.slash.store.IdentityProviderConfig.FieldMappingR\ffieldMapping\x1a\x92\x02\n" +
+	"\n" +
+	"LDAPConfig\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x17\n" +
+	"\abind_dn\x18\x02 \x01(\tR\x06bindDn\x12#\n" +
+	"\rbind_password\x18\x03 \x01(\tR\fbindPassword\x12\x1f\n" +
+	"\vsearch_base\x18\x04 \x01(\tR\n" +
+	"searchBase\x12\x1f\n" +
+	"\vuser_filter\x18\x05 \x01(\tR\n" +
+	"userFilter\x12U\n" +
+	"\rfield_mapping\x18\x06 \x01(\v20.slash.store.IdentityProviderConfig.FieldMappingR\ffieldMapping\x12\x1b\n" +
+	"\tstart_tls\x18\a \x01(\bR\bstartTlsB\b\n" +
 	"\x06configB\x99\x01\n" +
 	"\x0fcom.slash.storeB\bIdpProtoP\x01Z/github.com/yourselfhosted/slash/proto/gen/store\xa2\x02\x03SSX\xaa\x02\vSlash.Store\xca\x02\vSlash\\Store\xe2\x02\x17Slash\\Store\\GPBMetadata\xea\x02\fSlash::Storeb\x06proto3"
 
@@ -391,24 +848,35 @@ func file_store_idp_proto_rawDescGZIP() []byte {
 }
 
 var file_store_idp_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_idp_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_store_idp_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_store_idp_proto_goTypes = []any{
 	(IdentityProvider_Type)(0),                  // 0: slash.store.IdentityProvider.Type
 	(*IdentityProvider)(nil),                    // 1: slash.store.IdentityProvider
 	(*IdentityProviderConfig)(nil),              // 2: slash.store.IdentityProviderConfig
-	(*IdentityProviderConfig_FieldMapping)(nil), // 3: slash.store.IdentityProviderConfig.FieldMapping
-	(*IdentityProviderConfig_OAuth2Config)(nil), // 4: slash.store.IdentityProviderConfig.OAuth2Config
+	(*IdentityProvider_RoleMappingRule)(nil),    // 3: slash.store.IdentityProvider.RoleMappingRule
+	(*IdentityProviderConfig_FieldMapping)(nil), // 4: slash.store.IdentityProviderConfig.FieldMapping
+	(*IdentityProviderConfig_OAuth2Config)(nil), // 5: slash.store.IdentityProviderConfig.OAuth2Config
+	(*IdentityProviderConfig_OIDCConfig)(nil),   // 6: slash.store.IdentityProviderConfig.OIDCConfig
+	(*IdentityProviderConfig_SAMLConfig)(nil),   // 7: slash.store.IdentityProviderConfig.SAMLConfig
+	(*IdentityProviderConfig_LDAPConfig)(nil),   // 8: slash.store.IdentityProviderConfig.LDAPConfig
 }
 var file_store_idp_proto_depIdxs = []int32{
-	0, // 0: slash.store.IdentityProvider.type:type_name -> slash.store.IdentityProvider.Type
-	2, // 1: slash.store.IdentityProvider.config:type_name -> slash.store.IdentityProviderConfig
-	4, // 2: slash.store.IdentityProviderConfig.oauth2:type_name -> slash.store.IdentityProviderConfig.OAuth2Config
-	3, // 3: slash.store.IdentityProviderConfig.OAuth2Config.field_mapping:type_name -> slash.store.IdentityProviderConfig.FieldMapping
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: slash.store.IdentityProvider.type:type_name -> slash.store.IdentityProvider.Type
+	2,  // 1: slash.store.IdentityProvider.config:type_name -> slash.store.IdentityProviderConfig
+	3,  // 2: slash.store.IdentityProvider.role_mapping_rules:type_name -> slash.store.IdentityProvider.RoleMappingRule
+	5,  // 3: slash.store.IdentityProviderConfig.oauth2:type_name -> slash.store.IdentityProviderConfig.OAuth2Config
+	6,  // 4: slash.store.IdentityProviderConfig.oidc:type_name -> slash.store.IdentityProviderConfig.OIDCConfig
+	7,  // 5: slash.store.IdentityProviderConfig.saml:type_name -> slash.store.IdentityProviderConfig.SAMLConfig
+	8,  // 6: slash.store.IdentityProviderConfig.ldap:type_name -> slash.store.IdentityProviderConfig.LDAPConfig
+	4,  // 7: slash.store.IdentityProviderConfig.OAuth2Config.field_mapping:type_name -> slash.store.IdentityProviderConfig.FieldMapping
+	4,  // 8: slash.store.IdentityProviderConfig.OIDCConfig.field_mapping:type_name -> slash.store.IdentityProviderConfig.FieldMapping
+	4,  // 9: slash.store.IdentityProviderConfig.SAMLConfig.field_mapping:type_name -> slash.store.IdentityProviderConfig.FieldMapping
+	4,  // 10: slash.store.IdentityProviderConfig.LDAPConfig.field_mapping:type_name -> slash.store.IdentityProviderConfig.FieldMapping
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_store_idp_proto_init() }
@@ -418,6 +886,9 @@ func file_store_idp_proto_init() {
 	}
 	file_store_idp_proto_msgTypes[1].OneofWrappers = []any{
 		(*IdentityProviderConfig_Oauth2)(nil),
+		(*IdentityProviderConfig_Oidc)(nil),
+		(*IdentityProviderConfig_Saml)(nil),
+		(*IdentityProviderConfig_Ldap)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -425,7 +896,7 @@ func file_store_idp_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_idp_proto_rawDesc), len(file_store_idp_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,18 +22,26 @@ const (
 )
 
 type Shortcut struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatorId     int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	CreatedTs     int64                  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
-	UpdatedTs     int64                  `protobuf:"varint,4,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
-	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Link          string                 `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
-	Title         string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
-	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
-	Description   string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
-	Visibility    Visibility             `protobuf:"varint,11,opt,name=visibility,proto3,enum=slash.store.Visibility" json:"visibility,omitempty"`
-	OgMetadata    *OpenGraphMetadata     `protobuf:"bytes,12,opt,name=og_metadata,json=ogMetadata,proto3" json:"og_metadata,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId   int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTs   int64                  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	UpdatedTs   int64                  `protobuf:"varint,4,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
+	RowStatus   RowStatus              `protobuf:"varint,5,opt,name=row_status,json=rowStatus,proto3,enum=slash.store.RowStatus" json:"row_status,omitempty"`
+	Name        string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Link        string                 `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
+	Title       string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	Tags        []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Description string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility  Visibility             `protobuf:"varint,11,opt,name=visibility,proto3,enum=slash.store.Visibility" json:"visibility,omitempty"`
+	OgMetadata  *OpenGraphMetadata     `protobuf:"bytes,12,opt,name=og_metadata,json=ogMetadata,proto3" json:"og_metadata,omitempty"`
+	// Whether the link is a template whose placeholders are expanded, otherwise its braces are literal.
+	LinkTemplate bool `protobuf:"varint,13,opt,name=link_template,json=linkTemplate,proto3" json:"link_template,omitempty"`
+	// The id of the user whose personal namespace the shortcut is in, or 0 if it's in the workspace namespace.
+	// The names are unique in a namespace.
+	NamespaceId int32 `protobuf:"varint,14,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// The id of the group owning the shortcut along with its creator, or 0 if there is none.
+	GroupId       int32 `protobuf:"varint,15,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -96,6 +104,13 @@ func (x *Shortcut) GetUpdatedTs() int64 {
 	return 0
 }
 
+func (x *Shortcut) GetRowStatus() RowStatus {
+	if x != nil {
+		return x.RowStatus
+	}
+	return RowStatus_ROW_STATUS_UNSPECIFIED
+}
+
 func (x *Shortcut) GetName() string {
 	if x != nil {
 		return x.Name
@@ -145,6 +160,27 @@ func (x *Shortcut) GetOgMetadata() *OpenGraphMetadata {
 	return nil
 }
 
+func (x *Shortcut) GetLinkTemplate() bool {
+	if x != nil {
+		return x.LinkTemplate
+	}
+	return false
+}
+
+func (x *Shortcut) GetNamespaceId() int32 {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return 0
+}
+
+func (x *Shortcut) GetGroupId() int32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
 type OpenGraphMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -205,11 +241,98 @@ func (x *OpenGraphMetadata) GetImage() string {
 	return ""
 }
 
+type ShortcutRevision struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShortcutId int32                  `protobuf:"varint,2,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	// The user who made the change.
+	CreatorId int32 `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTs int64 `protobuf:"varint,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// The shortcut before the change.
+	OldValue *Shortcut `protobuf:"bytes,5,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// The shortcut after the change.
+	NewValue      *Shortcut `protobuf:"bytes,6,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutRevision) Reset() {
+	*x = ShortcutRevision{}
+	mi := &file_store_shortcut_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutRevision) ProtoMessage() {}
+
+func (x *ShortcutRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutRevision.ProtoReflect.Descriptor instead.
+func (*ShortcutRevision) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShortcutRevision) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShortcutRevision) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ShortcutRevision) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ShortcutRevision) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *ShortcutRevision) GetOldValue() *Shortcut {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *ShortcutRevision) GetNewValue() *Shortcut {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
 var File_store_shortcut_proto protoreflect.FileDescriptor
 
 const file_store_shortcut_proto_rawDesc = "" +
 	"\n" +
-	"\x14store/shortcut.proto\x12\vslash.store\x1a\x12store/common.proto\"\xe5\x02\n" +
+	"\x14store/shortcut.proto\x12\vslash.store\x1a\x12store/common.proto\"\xff\x03\n" +
 	"\bShortcut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
 	"\n" +
@@ -217,7 +340,9 @@ const file_store_shortcut_proto_rawDesc = "" +
 	"\n" +
 	"created_ts\x18\x03 \x01(\x03R\tcreatedTs\x12\x1d\n" +
 	"\n" +
-	"updated_ts\x18\x04 \x01(\x03R\tupdatedTs\x12\x12\n" +
+	"updated_ts\x18\x04 \x01(\x03R\tupdatedTs\x125\n" +
+	"\n" +
+	"row_status\x18\x05 \x01(\x0e2\x16.slash.store.RowStatusR\trowStatus\x12\x12\n" +
 	"\x04name\x18\x06 \x01(\tR\x04name\x12\x12\n" +
 	"\x04link\x18\a \x01(\tR\x04link\x12\x14\n" +
 	"\x05title\x18\b \x01(\tR\x05title\x12\x12\n" +
@@ -228,11 +353,24 @@ const file_store_shortcut_proto_rawDesc = "" +
 	"visibility\x18\v \x01(\x0e2\x17.slash.store.VisibilityR\n" +
 	"visibility\x12?\n" +
 	"\vog_metadata\x18\f \x01(\v2\x1e.slash.store.OpenGraphMetadataR\n" +
-	"ogMetadata\"a\n" +
+	"ogMetadata\x12#\n" +
+	"\rlink_template\x18\r \x01(\bR\flinkTemplate\x12!\n" +
+	"\fnamespace_id\x18\x0e \x01(\x05R\vnamespaceId\x12\x19\n" +
+	"\bgroup_id\x18\x0f \x01(\x05R\agroupId\"a\n" +
 	"\x11OpenGraphMetadata\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05image\x18\x03 \x01(\tR\x05imageB\x9e\x01\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\"\xe9\x01\n" +
+	"\x10ShortcutRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vshortcut_id\x18\x02 \x01(\x05R\n" +
+	"shortcutId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\x05R\tcreatorId\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x04 \x01(\x03R\tcreatedTs\x122\n" +
+	"\told_value\x18\x05 \x01(\v2\x15.slash.store.ShortcutR\boldValue\x122\n" +
+	"\tnew_value\x18\x06 \x01(\v2\x15.slash.store.ShortcutR\bnewValueB\x9e\x01\n" +
 	"\x0fcom.slash.storeB\rShortcutProtoP\x01Z/github.com/yourselfhosted/slash/proto/gen/store\xa2\x02\x03SSX\xaa\x02\vSlash.Store\xca\x02\vSlash\\Store\xe2\x02\x17Slash\\Store\\GPBMetadata\xea\x02\fSlash::Storeb\x06proto3"
 
 var (
@@ -247,20 +385,25 @@ func file_store_shortcut_proto_rawDescGZIP() []byte {
 	return file_store_shortcut_proto_rawDescData
 }
 
-var file_store_shortcut_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_shortcut_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_store_shortcut_proto_goTypes = []any{
 	(*Shortcut)(nil),          // 0: slash.store.Shortcut
 	(*OpenGraphMetadata)(nil), // 1: slash.store.OpenGraphMetadata
-	(Visibility)(0),           // 2: slash.store.Visibility
+	(*ShortcutRevision)(nil),  // 2: slash.store.ShortcutRevision
+	(RowStatus)(0),            // 3: slash.store.RowStatus
+	(Visibility)(0),           // 4: slash.store.Visibility
 }
 var file_store_shortcut_proto_depIdxs = []int32{
-	2, // 0: slash.store.Shortcut.visibility:type_name -> slash.store.Visibility
-	1, // 1: slash.store.Shortcut.og_metadata:type_name -> slash.store.OpenGraphMetadata
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: slash.store.Shortcut.row_status:type_name -> slash.store.RowStatus
+	4, // 1: slash.store.Shortcut.visibility:type_name -> slash.store.Visibility
+	1, // 2: slash.store.Shortcut.og_metadata:type_name -> slash.store.OpenGraphMetadata
+	0, // 3: slash.store.ShortcutRevision.old_value:type_name -> slash.store.Shortcut
+	0, // 4: slash.store.ShortcutRevision.new_value:type_name -> slash.store.Shortcut
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_store_shortcut_proto_init() }
@@ -275,7 +418,7 @@ func file_store_shortcut_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_shortcut_proto_rawDesc), len(file_store_shortcut_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,6 +21,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type QueryMergeStrategy int32
+
+const (
+	// Unspecified strategy is treated as APPEND.
+	QueryMergeStrategy_QUERY_MERGE_STRATEGY_UNSPECIFIED QueryMergeStrategy = 0
+	// Append the request query params to the ones of the shortcut link.
+	QueryMergeStrategy_APPEND QueryMergeStrategy = 1
+	// Request query params replace the ones of the shortcut link with the same key.
+	QueryMergeStrategy_OVERRIDE QueryMergeStrategy = 2
+	// Drop the request query params.
+	QueryMergeStrategy_IGNORE QueryMergeStrategy = 3
+)
+
+// Enum value maps for QueryMergeStrategy.
+var (
+	QueryMergeStrategy_name = map[int32]string{
+		0: "QUERY_MERGE_STRATEGY_UNSPECIFIED",
+		1: "APPEND",
+		2: "OVERRIDE",
+		3: "IGNORE",
+	}
+	QueryMergeStrategy_value = map[string]int32{
+		"QUERY_MERGE_STRATEGY_UNSPECIFIED": 0,
+		"APPEND":                           1,
+		"OVERRIDE":                         2,
+		"IGNORE":                           3,
+	}
+)
+
+func (x QueryMergeStrategy) Enum() *QueryMergeStrategy {
+	p := new(QueryMergeStrategy)
+	*p = x
+	return p
+}
+
+func (x QueryMergeStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryMergeStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[0].Descriptor()
+}
+
+func (QueryMergeStrategy) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[0]
+}
+
+func (x QueryMergeStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryMergeStrategy.Descriptor instead.
+func (QueryMergeStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0}
+}
+
 type WorkspaceSettingKey int32
 
 const (
@@ -33,6 +89,8 @@ const (
 	WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED WorkspaceSettingKey = 3
 	// Workspace identity provider settings.
 	WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER WorkspaceSettingKey = 4
+	// Workspace role permission settings.
+	WorkspaceSettingKey_WORKSPACE_SETTING_ROLE_PERMISSION WorkspaceSettingKey = 5
 	// TODO: remove the following keys.
 	// The license key.
 	WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY WorkspaceSettingKey = 10
@@ -50,6 +108,7 @@ var (
 		2:  "WORKSPACE_SETTING_SECURITY",
 		3:  "WORKSPACE_SETTING_SHORTCUT_RELATED",
 		4:  "WORKSPACE_SETTING_IDENTITY_PROVIDER",
+		5:  "WORKSPACE_SETTING_ROLE_PERMISSION",
 		10: "WORKSPACE_SETTING_LICENSE_KEY",
 		11: "WORKSPACE_SETTING_SECRET_SESSION",
 		13: "WORKSPACE_SETTING_DEFAULT_VISIBILITY",
@@ -60,6 +119,7 @@ var (
 		"WORKSPACE_SETTING_SECURITY":           2,
 		"WORKSPACE_SETTING_SHORTCUT_RELATED":   3,
 		"WORKSPACE_SETTING_IDENTITY_PROVIDER":  4,
+		"WORKSPACE_SETTING_ROLE_PERMISSION":    5,
 		"WORKSPACE_SETTING_LICENSE_KEY":        10,
 		"WORKSPACE_SETTING_SECRET_SESSION":     11,
 		"WORKSPACE_SETTING_DEFAULT_VISIBILITY": 13,
@@ -77,11 +137,11 @@ func (x WorkspaceSettingKey) String() string {
 }
 
 func (WorkspaceSettingKey) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_workspace_setting_proto_enumTypes[0].Descriptor()
+	return file_store_workspace_setting_proto_enumTypes[1].Descriptor()
 }
 
 func (WorkspaceSettingKey) Type() protoreflect.EnumType {
-	return &file_store_workspace_setting_proto_enumTypes[0]
+	return &file_store_workspace_setting_proto_enumTypes[1]
 }
 
 func (x WorkspaceSettingKey) Number() protoreflect.EnumNumber {
@@ -90,7 +150,7 @@ func (x WorkspaceSettingKey) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WorkspaceSettingKey.Descriptor instead.
 func (WorkspaceSettingKey) EnumDescriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0}
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{1}
 }
 
 type WorkspaceSetting struct {
@@ -103,6 +163,7 @@ type WorkspaceSetting struct {
 	//	*WorkspaceSetting_Security
 	//	*WorkspaceSetting_ShortcutRelated
 	//	*WorkspaceSetting_IdentityProvider
+	//	*WorkspaceSetting_RolePermission
 	Value         isWorkspaceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -195,6 +256,15 @@ func (x *WorkspaceSetting) GetIdentityProvider() *WorkspaceSetting_IdentityProvi
 	return nil
 }
 
+func (x *WorkspaceSetting) GetRolePermission() *WorkspaceSetting_RolePermissionSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_RolePermission); ok {
+			return x.RolePermission
+		}
+	}
+	return nil
+}
+
 type isWorkspaceSetting_Value interface {
 	isWorkspaceSetting_Value()
 }
@@ -215,6 +285,10 @@ type WorkspaceSetting_IdentityProvider struct {
 	IdentityProvider *WorkspaceSetting_IdentityProviderSetting `protobuf:"bytes,6,opt,name=identity_provider,json=identityProvider,proto3,oneof"`
 }
 
+type WorkspaceSetting_RolePermission struct {
+	RolePermission *WorkspaceSetting_RolePermissionSetting `protobuf:"bytes,7,opt,name=role_permission,json=rolePermission,proto3,oneof"`
+}
+
 func (*WorkspaceSetting_General) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_Security) isWorkspaceSetting_Value() {}
@@ -223,6 +297,8 @@ func (*WorkspaceSetting_ShortcutRelated) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_IdentityProvider) isWorkspaceSetting_Value() {}
 
+func (*WorkspaceSetting_RolePermission) isWorkspaceSetting_Value() {}
+
 type WorkspaceSetting_GeneralSetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SecretSession string                 `protobuf:"bytes,1,opt,name=secret_session,json=secretSession,proto3" json:"secret_session,omitempty"`
@@ -295,8 +371,10 @@ type WorkspaceSetting_SecuritySetting struct {
 	state                    protoimpl.MessageState `protogen:"open.v1"`
 	DisallowUserRegistration bool                   `protobuf:"varint,1,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
 	DisallowPasswordAuth     bool                   `protobuf:"varint,2,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	// The SHA-256 hash of the bearer token of the SCIM provisioning endpoints, which are disabled if empty.
+	ScimTokenHash string `protobuf:"bytes,3,opt,name=scim_token_hash,json=scimTokenHash,proto3" json:"scim_token_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WorkspaceSetting_SecuritySetting) Reset() {
@@ -343,11 +421,29 @@ func (x *WorkspaceSetting_SecuritySetting) GetDisallowPasswordAuth() bool {
 	return false
 }
 
+func (x *WorkspaceSetting_SecuritySetting) GetScimTokenHash() string {
+	if x != nil {
+		return x.ScimTokenHash
+	}
+	return ""
+}
+
 type WorkspaceSetting_ShortcutRelatedSetting struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	DefaultVisibility Visibility             `protobuf:"varint,1,opt,name=default_visibility,json=defaultVisibility,proto3,enum=slash.store.Visibility" json:"default_visibility,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Whether to append the extra path after the shortcut name to the shortcut link.
+	// e.g. "/s/docs/api/v2" resolves to the link of "docs" with "/api/v2" appended.
+	EnablePathForwarding bool `protobuf:"varint,2,opt,name=enable_path_forwarding,json=enablePathForwarding,proto3" json:"enable_path_forwarding,omitempty"`
+	// How the query params of the request are merged into the shortcut link.
+	QueryMergeStrategy QueryMergeStrategy `protobuf:"varint,3,opt,name=query_merge_strategy,json=queryMergeStrategy,proto3,enum=slash.store.QueryMergeStrategy" json:"query_merge_strategy,omitempty"`
+	// The number of days the archived shortcuts are kept in the trash before they are purged.
+	// Defaults to 30 days if not set.
+	TrashRetentionDays int32 `protobuf:"varint,4,opt,name=trash_retention_days,json=trashRetentionDays,proto3" json:"trash_retention_days,omitempty"`
+	// The number of days the shortcut view activities are kept before they are compacted into the daily summaries.
+	// The activities are kept forever if not set.
+	ActivityRetentionDays int32 `protobuf:"varint,5,opt,name=activity_retention_days,json=activityRetentionDays,proto3" json:"activity_retention_days,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *WorkspaceSetting_ShortcutRelatedSetting) Reset() {
@@ -387,6 +483,34 @@ func (x *WorkspaceSetting_ShortcutRelatedSetting) GetDefaultVisibility() Visibil
 	return Visibility_VISIBILITY_UNSPECIFIED
 }
 
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetEnablePathForwarding() bool {
+	if x != nil {
+		return x.EnablePathForwarding
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetQueryMergeStrategy() QueryMergeStrategy {
+	if x != nil {
+		return x.QueryMergeStrategy
+	}
+	return QueryMergeStrategy_QUERY_MERGE_STRATEGY_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetTrashRetentionDays() int32 {
+	if x != nil {
+		return x.TrashRetentionDays
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetActivityRetentionDays() int32 {
+	if x != nil {
+		return x.ActivityRetentionDays
+	}
+	return 0
+}
+
 type WorkspaceSetting_IdentityProviderSetting struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	IdentityProviders []*IdentityProvider    `protobuf:"bytes,1,rep,name=identity_providers,json=identityProviders,proto3" json:"identity_providers,omitempty"`
@@ -431,38 +555,157 @@ func (x *WorkspaceSetting_IdentityProviderSetting) GetIdentityProviders() []*Ide
 	return nil
 }
 
+type WorkspaceSetting_RolePermissionSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The permissions granted to the roles, overriding the default ones.
+	// The ADMIN role always has all permissions and cannot be overridden.
+	RolePermissions []*WorkspaceSetting_RolePermissionSetting_RolePermission `protobuf:"bytes,1,rep,name=role_permissions,json=rolePermissions,proto3" json:"role_permissions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_RolePermissionSetting) Reset() {
+	*x = WorkspaceSetting_RolePermissionSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_RolePermissionSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_RolePermissionSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting_RolePermissionSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_RolePermissionSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_RolePermissionSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *WorkspaceSetting_RolePermissionSetting) GetRolePermissions() []*WorkspaceSetting_RolePermissionSetting_RolePermission {
+	if x != nil {
+		return x.RolePermissions
+	}
+	return nil
+}
+
+type WorkspaceSetting_RolePermissionSetting_RolePermission struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user role, e.g. "EDITOR".
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// The permissions of the role, e.g. "shortcuts.create".
+	Permissions   []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting_RolePermissionSetting_RolePermission) Reset() {
+	*x = WorkspaceSetting_RolePermissionSetting_RolePermission{}
+	mi := &file_store_workspace_setting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting_RolePermissionSetting_RolePermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting_RolePermissionSetting_RolePermission) ProtoMessage() {}
+
+func (x *WorkspaceSetting_RolePermissionSetting_RolePermission) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting_RolePermissionSetting_RolePermission.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting_RolePermissionSetting_RolePermission) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0, 4, 0}
+}
+
+func (x *WorkspaceSetting_RolePermissionSetting_RolePermission) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_RolePermissionSetting_RolePermission) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 var File_store_workspace_setting_proto protoreflect.FileDescriptor
 
 const file_store_workspace_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x1dstore/workspace_setting.proto\x12\vslash.store\x1a\x12store/common.proto\x1a\x0fstore/idp.proto\"\xae\a\n" +
+	"\x1dstore/workspace_setting.proto\x12\vslash.store\x1a\x12store/common.proto\x1a\x0fstore/idp.proto\"\xfb\v\n" +
 	"\x10WorkspaceSetting\x122\n" +
 	"\x03key\x18\x01 \x01(\x0e2 .slash.store.WorkspaceSettingKeyR\x03key\x12\x10\n" +
 	"\x03raw\x18\x02 \x01(\tR\x03raw\x12H\n" +
 	"\ageneral\x18\x03 \x01(\v2,.slash.store.WorkspaceSetting.GeneralSettingH\x00R\ageneral\x12K\n" +
 	"\bsecurity\x18\x04 \x01(\v2-.slash.store.WorkspaceSetting.SecuritySettingH\x00R\bsecurity\x12a\n" +
 	"\x10shortcut_related\x18\x05 \x01(\v24.slash.store.WorkspaceSetting.ShortcutRelatedSettingH\x00R\x0fshortcutRelated\x12d\n" +
-	"\x11identity_provider\x18\x06 \x01(\v25.slash.store.WorkspaceSetting.IdentityProviderSettingH\x00R\x10identityProvider\x1a\x97\x01\n" +
+	"\x11identity_provider\x18\x06 \x01(\v25.slash.store.WorkspaceSetting.IdentityProviderSettingH\x00R\x10identityProvider\x12^\n" +
+	"\x0frole_permission\x18\a \x01(\v23.slash.store.WorkspaceSetting.RolePermissionSettingH\x00R\x0erolePermission\x1a\x97\x01\n" +
 	"\x0eGeneralSetting\x12%\n" +
 	"\x0esecret_session\x18\x01 \x01(\tR\rsecretSession\x12\x1f\n" +
 	"\vlicense_key\x18\x02 \x01(\tR\n" +
 	"licenseKey\x12!\n" +
 	"\finstance_url\x18\x03 \x01(\tR\vinstanceUrl\x12\x1a\n" +
-	"\bbranding\x18\x04 \x01(\fR\bbranding\x1a\x85\x01\n" +
+	"\bbranding\x18\x04 \x01(\fR\bbranding\x1a\xad\x01\n" +
 	"\x0fSecuritySetting\x12<\n" +
 	"\x1adisallow_user_registration\x18\x01 \x01(\bR\x18disallowUserRegistration\x124\n" +
-	"\x16disallow_password_auth\x18\x02 \x01(\bR\x14disallowPasswordAuth\x1a`\n" +
+	"\x16disallow_password_auth\x18\x02 \x01(\bR\x14disallowPasswordAuth\x12&\n" +
+	"\x0fscim_token_hash\x18\x03 \x01(\tR\rscimTokenHash\x1a\xd3\x02\n" +
 	"\x16ShortcutRelatedSetting\x12F\n" +
-	"\x12default_visibility\x18\x01 \x01(\x0e2\x17.slash.store.VisibilityR\x11defaultVisibility\x1ag\n" +
+	"\x12default_visibility\x18\x01 \x01(\x0e2\x17.slash.store.VisibilityR\x11defaultVisibility\x124\n" +
+	"\x16enable_path_forwarding\x18\x02 \x01(\bR\x14enablePathForwarding\x12Q\n" +
+	"\x14query_merge_strategy\x18\x03 \x01(\x0e2\x1f.slash.store.QueryMergeStrategyR\x12queryMergeStrategy\x120\n" +
+	"\x14trash_retention_days\x18\x04 \x01(\x05R\x12trashRetentionDays\x126\n" +
+	"\x17activity_retention_days\x18\x05 \x01(\x05R\x15activityRetentionDays\x1ag\n" +
 	"\x17IdentityProviderSetting\x12L\n" +
-	"\x12identity_providers\x18\x01 \x03(\v2\x1d.slash.store.IdentityProviderR\x11identityProvidersB\a\n" +
-	"\x05value*\xbf\x02\n" +
+	"\x12identity_providers\x18\x01 \x03(\v2\x1d.slash.store.IdentityProviderR\x11identityProviders\x1a\xce\x01\n" +
+	"\x15RolePermissionSetting\x12m\n" +
+	"\x10role_permissions\x18\x01 \x03(\v2B.slash.store.WorkspaceSetting.RolePermissionSetting.RolePermissionR\x0frolePermissions\x1aF\n" +
+	"\x0eRolePermission\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12 \n" +
+	"\vpermissions\x18\x02 \x03(\tR\vpermissionsB\a\n" +
+	"\x05value*`\n" +
+	"\x12QueryMergeStrategy\x12$\n" +
+	" QUERY_MERGE_STRATEGY_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06APPEND\x10\x01\x12\f\n" +
+	"\bOVERRIDE\x10\x02\x12\n" +
+	"\n" +
+	"\x06IGNORE\x10\x03*\xe6\x02\n" +
 	"\x13WorkspaceSettingKey\x12%\n" +
 	"!WORKSPACE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19WORKSPACE_SETTING_GENERAL\x10\x01\x12\x1e\n" +
 	"\x1aWORKSPACE_SETTING_SECURITY\x10\x02\x12&\n" +
 	"\"WORKSPACE_SETTING_SHORTCUT_RELATED\x10\x03\x12'\n" +
-	"#WORKSPACE_SETTING_IDENTITY_PROVIDER\x10\x04\x12!\n" +
+	"#WORKSPACE_SETTING_IDENTITY_PROVIDER\x10\x04\x12%\n" +
+	"!WORKSPACE_SETTING_ROLE_PERMISSION\x10\x05\x12!\n" +
 	"\x1dWORKSPACE_SETTING_LICENSE_KEY\x10\n" +
 	"\x12$\n" +
 	" WORKSPACE_SETTING_SECRET_SESSION\x10\v\x12(\n" +
@@ -481,31 +724,37 @@ func file_store_workspace_setting_proto_rawDescGZIP() []byte {
 	return file_store_workspace_setting_proto_rawDescData
 }
 
-var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_store_workspace_setting_proto_goTypes = []any{
-	(WorkspaceSettingKey)(0),                         // 0: slash.store.WorkspaceSettingKey
-	(*WorkspaceSetting)(nil),                         // 1: slash.store.WorkspaceSetting
-	(*WorkspaceSetting_GeneralSetting)(nil),          // 2: slash.store.WorkspaceSetting.GeneralSetting
-	(*WorkspaceSetting_SecuritySetting)(nil),         // 3: slash.store.WorkspaceSetting.SecuritySetting
-	(*WorkspaceSetting_ShortcutRelatedSetting)(nil),  // 4: slash.store.WorkspaceSetting.ShortcutRelatedSetting
-	(*WorkspaceSetting_IdentityProviderSetting)(nil), // 5: slash.store.WorkspaceSetting.IdentityProviderSetting
-	(Visibility)(0),                                  // 6: slash.store.Visibility
-	(*IdentityProvider)(nil),                         // 7: slash.store.IdentityProvider
+	(QueryMergeStrategy)(0),                                       // 0: slash.store.QueryMergeStrategy
+	(WorkspaceSettingKey)(0),                                      // 1: slash.store.WorkspaceSettingKey
+	(*WorkspaceSetting)(nil),                                      // 2: slash.store.WorkspaceSetting
+	(*WorkspaceSetting_GeneralSetting)(nil),                       // 3: slash.store.WorkspaceSetting.GeneralSetting
+	(*WorkspaceSetting_SecuritySetting)(nil),                      // 4: slash.store.WorkspaceSetting.SecuritySetting
+	(*WorkspaceSetting_ShortcutRelatedSetting)(nil),               // 5: slash.store.WorkspaceSetting.ShortcutRelatedSetting
+	(*WorkspaceSetting_IdentityProviderSetting)(nil),              // 6: slash.store.WorkspaceSetting.IdentityProviderSetting
+	(*WorkspaceSetting_RolePermissionSetting)(nil),                // 7: slash.store.WorkspaceSetting.RolePermissionSetting
+	(*WorkspaceSetting_RolePermissionSetting_RolePermission)(nil), // 8: slash.store.WorkspaceSetting.RolePermissionSetting.RolePermission
+	(Visibility)(0),                                               // 9: slash.store.Visibility
+	(*IdentityProvider)(nil),                                      // 10: slash.store.IdentityProvider
 }
 var file_store_workspace_setting_proto_depIdxs = []int32{
-	0, // 0: slash.store.WorkspaceSetting.key:type_name -> slash.store.WorkspaceSettingKey
-	2, // 1: slash.store.WorkspaceSetting.general:type_name -> slash.store.WorkspaceSetting.GeneralSetting
-	3, // 2: slash.store.WorkspaceSetting.security:type_name -> slash.store.WorkspaceSetting.SecuritySetting
-	4, // 3: slash.store.WorkspaceSetting.shortcut_related:type_name -> slash.store.WorkspaceSetting.ShortcutRelatedSetting
-	5, // 4: slash.store.WorkspaceSetting.identity_provider:type_name -> slash.store.WorkspaceSetting.IdentityProviderSetting
-	6, // 5: slash.store.WorkspaceSetting.ShortcutRelatedSetting.default_visibility:type_name -> slash.store.Visibility
-	7, // 6: slash.store.WorkspaceSetting.IdentityProviderSetting.identity_providers:type_name -> slash.store.IdentityProvider
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	1,  // 0: slash.store.WorkspaceSetting.key:type_name -> slash.store.WorkspaceSettingKey
+	3,  // 1: slash.store.WorkspaceSetting.general:type_name -> slash.store.WorkspaceSetting.GeneralSetting
+	4,  // 2: slash.store.WorkspaceSetting.security:type_name -> slash.store.WorkspaceSetting.SecuritySetting
+	5,  // 3: slash.store.WorkspaceSetting.shortcut_related:type_name -> slash.store.WorkspaceSetting.ShortcutRelatedSetting
+	6,  // 4: slash.store.WorkspaceSetting.identity_provider:type_name -> slash.store.WorkspaceSetting.IdentityProviderSetting
+	7,  // 5: slash.store.WorkspaceSetting.role_permission:type_name -> slash.store.WorkspaceSetting.RolePermissionSetting
+	9,  // 6: slash.store.WorkspaceSetting.ShortcutRelatedSetting.default_visibility:type_name -> slash.store.Visibility
+	0,  // 7: slash.store.WorkspaceSetting.ShortcutRelatedSetting.query_merge_strategy:type_name -> slash.store.QueryMergeStrategy
+	10, // 8: slash.store.WorkspaceSetting.IdentityProviderSetting.identity_providers:type_name -> slash.store.IdentityProvider
+	8,  // 9: slash.store.WorkspaceSetting.RolePermissionSetting.role_permissions:type_name -> slash.store.WorkspaceSetting.RolePermissionSetting.RolePermission
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_store_workspace_setting_proto_init() }
@@ -520,14 +769,15 @@ func file_store_workspace_setting_proto_init() {
 		(*WorkspaceSetting_Security)(nil),
 		(*WorkspaceSetting_ShortcutRelated)(nil),
 		(*WorkspaceSetting_IdentityProvider)(nil),
+		(*WorkspaceSetting_RolePermission)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_workspace_setting_proto_rawDesc), len(file_store_workspace_setting_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -67,8 +67,14 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 	if user == nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user ID %q not exists in the access token", userID)
 	}
-	if isOnlyForAdminAllowedMethod(serverInfo.FullMethod) && user.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "user ID %q is not admin", userID)
+	if permission, ok := getMethodPermission(serverInfo.FullMethod); ok {
+		hasPermission, err := in.Store.HasPermission(ctx, user, permission)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check permission")
+		}
+		if !hasPermission {
+			return nil, status.Errorf(codes.PermissionDenied, "user ID %d does not have permission %q", userID, permission)
+		}
 	}
 
 	// Stores userID into context.
@@ -76,6 +82,12 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 	return handler(childCtx, request)
 }
 
+// Authenticate validates the access token and returns the ID of the user it belongs to.
+// It is also used by the HTTP routes that are served outside of the gRPC server.
+func (in *GRPCAuthInterceptor) Authenticate(ctx context.Context, accessToken string) (int32, error) {
+	return in.authenticate(ctx, accessToken)
+}
+
 func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken string) (int32, error) {
 	if accessToken == "" {
 		return 0, status.Errorf(codes.Unauthenticated, "access token not found")
@@ -142,16 +154,21 @@ func getTokenFromMetadata(md metadata.MD) (string, error) {
 		return authHeaderParts[1], nil
 	}
 	// Try to get the token from the cookie header.
-	var accessToken string
+	return getCookieFromMetadata(md, AccessTokenCookieName), nil
+}
+
+// getCookieFromMetadata returns the value of the named cookie from the cookie headers, or empty if not found.
+func getCookieFromMetadata(md metadata.MD, name string) string {
+	var value string
 	for _, t := range append(md.Get("grpcgateway-cookie"), md.Get("cookie")...) {
 		header := http.Header{}
 		header.Add("Cookie", t)
 		request := http.Request{Header: header}
-		if v, _ := request.Cookie(AccessTokenCookieName); v != nil {
-			accessToken = v.Value
+		if v, _ := request.Cookie(name); v != nil {
+			value = v.Value
 		}
 	}
-	return accessToken, nil
+	return value
 }
 
 func audienceContains(audience jwt.ClaimStrings, token string) bool {
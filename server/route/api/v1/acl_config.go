@@ -1,6 +1,10 @@
 package v1
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/yourselfhosted/slash/store"
+)
 
 var allowedMethodsWhenUnauthorized = map[string]bool{
 	"/slash.api.v1.WorkspaceService/GetWorkspaceProfile":  true,
@@ -8,6 +12,7 @@ var allowedMethodsWhenUnauthorized = map[string]bool{
 	"/slash.api.v1.AuthService/GetAuthStatus":             true,
 	"/slash.api.v1.AuthService/SignIn":                    true,
 	"/slash.api.v1.AuthService/SignInWithSSO":             true,
+	"/slash.api.v1.AuthService/AuthorizeSSO":              true,
 	"/slash.api.v1.AuthService/SignUp":                    true,
 	"/slash.api.v1.AuthService/SignOut":                   true,
 	"/slash.api.v1.ShortcutService/GetShortcut":           true,
@@ -23,14 +28,28 @@ func isUnauthorizeAllowedMethod(methodName string) bool {
 	return allowedMethodsWhenUnauthorized[methodName]
 }
 
-var allowedMethodsOnlyForAdmin = map[string]bool{
-	"/slash.api.v1.UserService/CreateUser":                  true,
-	"/slash.api.v1.UserService/DeleteUser":                  true,
-	"/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting": true,
-	"/slash.api.v1.SubscriptionService/UpdateSubscription":  true,
+// methodPermissions maps the methods to the permissions required to call them.
+// The methods not listed here are allowed for all authenticated users, and may check permissions in their handlers.
+var methodPermissions = map[string]store.Permission{
+	"/slash.api.v1.UserService/CreateUser":                    store.PermissionUsersManage,
+	"/slash.api.v1.UserService/DeleteUser":                    store.PermissionUsersManage,
+	"/slash.api.v1.UserService/TransferOwnership":             store.PermissionUsersManage,
+	"/slash.api.v1.WorkspaceService/UpdateWorkspaceSetting":   store.PermissionWorkspaceManage,
+	"/slash.api.v1.WorkspaceService/GetWorkspaceAnalytics":    store.PermissionAnalyticsView,
+	"/slash.api.v1.WorkspaceService/ExportWorkspace":          store.PermissionWorkspaceManage,
+	"/slash.api.v1.WorkspaceService/ImportWorkspace":          store.PermissionWorkspaceManage,
+	"/slash.api.v1.WorkspaceService/ListAuditLogs":            store.PermissionAuditLogsView,
+	"/slash.api.v1.WorkspaceService/GetActivityRecorderStats": store.PermissionWorkspaceManage,
+	"/slash.api.v1.WorkspaceService/RotateSCIMToken":          store.PermissionWorkspaceManage,
+	"/slash.api.v1.SubscriptionService/UpdateSubscription":    store.PermissionWorkspaceManage,
+	"/slash.api.v1.ShortcutService/CreateShortcut":            store.PermissionShortcutsCreate,
+	"/slash.api.v1.ShortcutService/ImportShortcuts":           store.PermissionShortcutsCreate,
+	"/slash.api.v1.CollectionService/CreateCollection":        store.PermissionCollectionsCreate,
+	"/slash.api.v1.GroupService/CreateGroup":                  store.PermissionGroupsCreate,
 }
 
-// isOnlyForAdminAllowedMethod returns true if the method is allowed to be called only by admin.
-func isOnlyForAdminAllowedMethod(methodName string) bool {
-	return allowedMethodsOnlyForAdmin[methodName]
+// getMethodPermission returns the permission required to call the method, if any.
+func getMethodPermission(methodName string) (store.Permission, bool) {
+	permission, ok := methodPermissions[methodName]
+	return permission, ok
 }
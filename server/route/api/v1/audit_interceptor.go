@@ -0,0 +1,257 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// auditedMethodVerbs are the verbs of the methods recorded in the audit logs, i.e. the mutating ones.
+// ExportWorkspace is recorded too, as the archive contains the secrets of the workspace.
+var auditedMethodVerbs = []string{"Create", "Update", "Delete", "Undelete", "Restore", "Import", "Export", "Transfer", "Rotate", "SignIn", "SignUp", "SignOut"}
+
+// auditedService is the activity type and resource name prefix of the methods of a service.
+type auditedService struct {
+	activityType   store.ActivityType
+	resourcePrefix string
+}
+
+var auditedServices = map[string]auditedService{
+	"slash.api.v1.AuthService":         {activityType: store.ActivityAuditAuth, resourcePrefix: UserNamePrefix},
+	"slash.api.v1.WorkspaceService":    {activityType: store.ActivityAuditWorkspace, resourcePrefix: "workspace"},
+	"slash.api.v1.SubscriptionService": {activityType: store.ActivityAuditSubscription, resourcePrefix: "subscription"},
+	"slash.api.v1.UserService":         {activityType: store.ActivityAuditUser, resourcePrefix: UserNamePrefix},
+	"slash.api.v1.UserSettingService":  {activityType: store.ActivityAuditUserSetting, resourcePrefix: UserNamePrefix},
+	"slash.api.v1.ShortcutService":     {activityType: store.ActivityAuditShortcut, resourcePrefix: "shortcuts/"},
+	"slash.api.v1.CollectionService":   {activityType: store.ActivityAuditCollection, resourcePrefix: "collections/"},
+	"slash.api.v1.GroupService":        {activityType: store.ActivityAuditGroup, resourcePrefix: "groups/"},
+}
+
+// maskedRequestFields are the fields of the requests masked in the audit logs, in the protobuf field names.
+var maskedRequestFields = map[string]bool{
+	"password":      true,
+	"access_token":  true,
+	"client_secret": true,
+	"bind_password": true,
+	"license_key":   true,
+	"content":       true,
+	"branding":      true,
+}
+
+const maskedValue = "******"
+
+// AuditInterceptor records the mutating API calls as the audit activities.
+// It runs after the authentication, so the actor is known from the context.
+type AuditInterceptor struct {
+	Store *store.Store
+}
+
+func NewAuditInterceptor(store *store.Store) *AuditInterceptor {
+	return &AuditInterceptor{
+		Store: store,
+	}
+}
+
+func (in *AuditInterceptor) AuditInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, request)
+	activityType, resourcePrefix, ok := getAuditedMethod(serverInfo.FullMethod)
+	if !ok {
+		return resp, err
+	}
+	if auditErr := in.audit(ctx, serverInfo.FullMethod, activityType, resourcePrefix, request, resp, err); auditErr != nil {
+		// The failure of the audit doesn't fail the call, which has already been handled.
+		slog.Error("failed to create audit log", slog.String("method", serverInfo.FullMethod), slog.String("error", auditErr.Error()))
+	}
+	return resp, err
+}
+
+func (in *AuditInterceptor) audit(ctx context.Context, fullMethod string, activityType store.ActivityType, resourcePrefix string, request, resp any, err error) error {
+	requestMessage, _ := request.(proto.Message)
+	responseMessage, _ := resp.(proto.Message)
+	actorID, _ := ctx.Value(userIDContextKey).(int32)
+	if user, ok := resp.(*v1pb.User); ok && actorID == 0 && err == nil {
+		// The caller of sign in and sign up is the user in the response.
+		actorID = user.Id
+	}
+
+	requestJSON, maskErr := maskAuditRequest(requestMessage)
+	if maskErr != nil {
+		return maskErr
+	}
+	code := status.Code(err)
+	ip, userAgent := getClientInfo(ctx)
+	payload, marshalErr := protojson.Marshal(&storepb.ActivityAuditPayload{
+		Method:    fullMethod,
+		Resource:  getAuditResource(resourcePrefix, requestMessage, responseMessage),
+		Request:   requestJSON,
+		Ip:        ip,
+		UserAgent: userAgent,
+		Code:      code.String(),
+	})
+	if marshalErr != nil {
+		return marshalErr
+	}
+	level := store.ActivityInfo
+	switch code {
+	case codes.OK:
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable, codes.DeadlineExceeded:
+		level = store.ActivityError
+	default:
+		level = store.ActivityWarn
+	}
+	if _, createErr := in.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: actorID,
+		Type:      activityType,
+		Level:     level,
+		Payload:   string(payload),
+	}); createErr != nil {
+		return createErr
+	}
+	return nil
+}
+
+// getAuditedMethod returns the activity type and the resource name prefix of the method if it's audited.
+func getAuditedMethod(fullMethod string) (store.ActivityType, string, bool) {
+	serviceName, methodName, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "", "", false
+	}
+	service, ok := auditedServices[serviceName]
+	if !ok {
+		return "", "", false
+	}
+	for _, verb := range auditedMethodVerbs {
+		if strings.HasPrefix(methodName, verb) {
+			if strings.Contains(methodName, "AccessToken") {
+				return store.ActivityAuditUserAccessToken, service.resourcePrefix, true
+			}
+			return service.activityType, service.resourcePrefix, true
+		}
+	}
+	return "", "", false
+}
+
+// getAuditResource returns the name of the resource, with the id from the request or the response, e.g. "shortcuts/1".
+func getAuditResource(resourcePrefix string, request, response proto.Message) string {
+	if !strings.HasSuffix(resourcePrefix, "/") {
+		return resourcePrefix
+	}
+	id := getMessageID(request)
+	if id == 0 {
+		id = getMessageID(response)
+	}
+	if id == 0 {
+		return strings.TrimSuffix(resourcePrefix, "/")
+	}
+	return fmt.Sprintf("%s%d", resourcePrefix, id)
+}
+
+// getMessageID returns the int32 id field of the message, or of the first message field that has one,
+// e.g. the shortcut of UpdateShortcutRequest.
+func getMessageID(message proto.Message) int32 {
+	if message == nil {
+		return 0
+	}
+	m := message.ProtoReflect()
+	if !m.IsValid() {
+		return 0
+	}
+	fields := m.Descriptor().Fields()
+	if field := fields.ByName("id"); field != nil && field.Kind() == protoreflect.Int32Kind {
+		if id := int32(m.Get(field).Int()); id != 0 {
+			return id
+		}
+	}
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() || !m.Has(field) {
+			continue
+		}
+		nested := m.Get(field).Message()
+		if idField := nested.Descriptor().Fields().ByName("id"); idField != nil && idField.Kind() == protoreflect.Int32Kind {
+			if id := int32(nested.Get(idField).Int()); id != 0 {
+				return id
+			}
+		}
+	}
+	return 0
+}
+
+// maskAuditRequest marshals the request into JSON, with the values of the sensitive fields masked.
+func maskAuditRequest(request proto.Message) (string, error) {
+	if request == nil {
+		return "{}", nil
+	}
+	bytes, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	var value any
+	if err := json.Unmarshal(bytes, &value); err != nil {
+		return "", err
+	}
+	masked, err := json.Marshal(maskJSONValue(value))
+	if err != nil {
+		return "", err
+	}
+	return string(masked), nil
+}
+
+func maskJSONValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, fieldValue := range v {
+			if maskedRequestFields[key] {
+				v[key] = maskedValue
+				continue
+			}
+			v[key] = maskJSONValue(fieldValue)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = maskJSONValue(item)
+		}
+	}
+	return value
+}
+
+// getClientInfo returns the IP address and the user agent of the client.
+// The requests proxied by the gRPC-Gateway carry them in the forwarded metadata.
+func getClientInfo(ctx context.Context) (string, string) {
+	ip, userAgent := "", ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("x-forwarded-for"); len(values) > 0 {
+			ip = strings.TrimSpace(strings.Split(values[0], ",")[0])
+		}
+		if values := md.Get("grpcgateway-user-agent"); len(values) > 0 {
+			userAgent = values[0]
+		} else if values := md.Get("user-agent"); len(values) > 0 {
+			userAgent = values[0]
+		}
+	}
+	if ip == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			ip = p.Addr.String()
+			if host, _, err := net.SplitHostPort(ip); err == nil {
+				ip = host
+			}
+		}
+	}
+	return ip, userAgent
+}
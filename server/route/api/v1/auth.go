@@ -22,6 +22,8 @@ const (
 	CookieExpDuration = AccessTokenDuration - 1*time.Minute
 	// AccessTokenCookieName is the cookie name of access token.
 	AccessTokenCookieName = "slash.access-token"
+	// SSOStateCookieName is the cookie name of the state of the pending SSO sign in.
+	SSOStateCookieName = "slash.sso-state"
 )
 
 type ClaimsMessage struct {
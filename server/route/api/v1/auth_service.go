@@ -2,10 +2,16 @@ package v1
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
+	xoauth2 "golang.org/x/oauth2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -14,7 +20,9 @@ import (
 
 	"github.com/yourselfhosted/slash/internal/util"
 	"github.com/yourselfhosted/slash/plugin/idp"
+	"github.com/yourselfhosted/slash/plugin/idp/ldap"
 	"github.com/yourselfhosted/slash/plugin/idp/oauth2"
+	"github.com/yourselfhosted/slash/plugin/idp/oidc"
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/service/license"
@@ -23,8 +31,24 @@ import (
 
 const (
 	unmatchedEmailAndPasswordError = "unmatched email and password"
+	// ssoAuthRequestDuration is the duration for the user to sign in at the SSO provider.
+	ssoAuthRequestDuration = 10 * time.Minute
 )
 
+// ssoAuthRequest is a pending SSO sign in started by AuthorizeSSO.
+type ssoAuthRequest struct {
+	idpID        string
+	redirectURI  string
+	nonce        string
+	codeVerifier string
+	expireTime   time.Time
+	// samlRequestID is the id of the SAML authentication request, cleared once responded.
+	samlRequestID string
+	// The one-time code and the user information of the verified SAML response.
+	code     string
+	userInfo *idp.IdentityProviderUserInfo
+}
+
 func (s *APIV1Service) GetAuthStatus(ctx context.Context, _ *v1pb.GetAuthStatusRequest) (*v1pb.User, error) {
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
@@ -37,6 +61,10 @@ func (s *APIV1Service) GetAuthStatus(ctx context.Context, _ *v1pb.GetAuthStatusR
 }
 
 func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest) (*v1pb.User, error) {
+	if request.IdpId != "" {
+		return s.signInWithLDAP(ctx, request)
+	}
+
 	user, err := s.Store.GetUser(ctx, &store.FindUser{
 		Email: &request.Email,
 	})
@@ -55,7 +83,7 @@ func (s *APIV1Service) SignIn(ctx context.Context, request *v1pb.SignInRequest)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get workspace security setting: %v", err)
 	}
-	if workspaceSecuritySetting.DisallowPasswordAuth && user.Role == store.RoleUser {
+	if workspaceSecuritySetting.DisallowPasswordAuth && user.Role != store.RoleAdmin {
 		return nil, status.Errorf(codes.PermissionDenied, "password authentication is not allowed")
 	}
 	if user.RowStatus == storepb.RowStatus_ARCHIVED {
@@ -73,25 +101,16 @@ func (s *APIV1Service) SignInWithSSO(ctx context.Context, request *v1pb.SignInWi
 		return nil, status.Errorf(codes.PermissionDenied, "SSO is not available in the current plan")
 	}
 
-	identityProviderSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
-		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
-	})
+	identityProvider, err := s.getIdentityProvider(ctx, request.IdpId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %s", err)
-	}
-	if identityProviderSetting == nil || identityProviderSetting.GetIdentityProvider() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "identity provider not found")
+		return nil, err
 	}
-	var identityProvider *storepb.IdentityProvider
-	for _, idp := range identityProviderSetting.GetIdentityProvider().IdentityProviders {
-		if idp.Id == request.IdpId {
-			identityProvider = idp
-			break
+	var authRequest *ssoAuthRequest
+	if request.State != "" || identityProvider.Type != storepb.IdentityProvider_OAUTH2 {
+		if authRequest, err = s.consumeSSOAuthRequest(ctx, request.IdpId, request.State); err != nil {
+			return nil, err
 		}
 	}
-	if identityProvider == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "identity provider not found")
-	}
 
 	var userInfo *idp.IdentityProviderUserInfo
 	if identityProvider.Type == storepb.IdentityProvider_OAUTH2 {
@@ -107,50 +126,277 @@ func (s *APIV1Service) SignInWithSSO(ctx context.Context, request *v1pb.SignInWi
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get user info, err: %s", err)
 		}
+	} else if identityProvider.Type == storepb.IdentityProvider_OIDC {
+		oidcIdentityProvider, err := oidc.NewIdentityProvider(identityProvider.Config.GetOidc())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create oidc identity provider, err: %s", err)
+		}
+		providerMetadata, err := oidcIdentityProvider.Discover(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to discover oidc provider, err: %s", err)
+		}
+		token, err := oidcIdentityProvider.ExchangeToken(ctx, providerMetadata, authRequest.redirectURI, request.Code, authRequest.codeVerifier)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to exchange token, err: %s", err)
+		}
+		claims, err := oidcIdentityProvider.VerifyIDToken(ctx, providerMetadata, token.IDToken, authRequest.nonce)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "failed to verify id token, err: %s", err)
+		}
+		userInfo, err = oidcIdentityProvider.UserInfo(ctx, providerMetadata, token, claims)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user info, err: %s", err)
+		}
+	} else if identityProvider.Type == storepb.IdentityProvider_SAML {
+		// The SAML response was verified by the ACS endpoint, which redirected the user with the code.
+		if authRequest.userInfo == nil || subtle.ConstantTimeCompare([]byte(authRequest.code), []byte(request.Code)) != 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid code")
+		}
+		userInfo = authRequest.userInfo
+	} else {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported identity provider type %s", identityProvider.Type)
+	}
+
+	user, err := s.findOrCreateSSOUser(ctx, identityProvider, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.doSignIn(ctx, user, time.Now().Add(AccessTokenDuration)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign in, err: %s", err)
+	}
+	return convertUserFromStore(user), nil
+}
+
+// signInWithLDAP signs in the user with the credentials checked against the LDAP provider, where the email
+// of the request is the username to search. The password authentication setting does not apply to it.
+func (s *APIV1Service) signInWithLDAP(ctx context.Context, request *v1pb.SignInRequest) (*v1pb.User, error) {
+	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeSSO) {
+		return nil, status.Errorf(codes.PermissionDenied, "SSO is not available in the current plan")
+	}
+
+	identityProvider, err := s.getIdentityProvider(ctx, request.IdpId)
+	if err != nil {
+		return nil, err
+	}
+	if identityProvider.Type != storepb.IdentityProvider_LDAP {
+		return nil, status.Errorf(codes.InvalidArgument, "identity provider %q is not a LDAP one", request.IdpId)
+	}
+	ldapIdentityProvider, err := ldap.NewIdentityProvider(identityProvider.Config.GetLdap())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create ldap identity provider, err: %s", err)
+	}
+	userInfo, err := ldapIdentityProvider.Authenticate(request.Email, request.Password)
+	if err != nil {
+		if errors.Is(err, ldap.ErrInvalidCredentials) {
+			return nil, status.Errorf(codes.InvalidArgument, unmatchedEmailAndPasswordError)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to authenticate with ldap, err: %s", err)
+	}
+
+	user, err := s.findOrCreateSSOUser(ctx, identityProvider, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.doSignIn(ctx, user, time.Now().Add(AccessTokenDuration)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign in, err: %s", err)
 	}
+	return convertUserFromStore(user), nil
+}
 
+// findOrCreateSSOUser returns the user of the email identified by the identity provider, which is
+// created on the first sign in if its email domain is allowed. The role mapping rules and the nickname
+// are applied on every sign in.
+func (s *APIV1Service) findOrCreateSSOUser(ctx context.Context, identityProvider *storepb.IdentityProvider, userInfo *idp.IdentityProviderUserInfo) (*store.User, error) {
 	email := userInfo.Identifier
 	if !util.ValidateEmail(email) {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid email address")
 	}
+	// The role is only managed by the matching rule, if any.
+	var role *store.Role
+	if rule := idp.FindRoleMappingRule(identityProvider.RoleMappingRules, userInfo); rule != nil {
+		if rule.Deny {
+			return nil, status.Errorf(codes.PermissionDenied, "sign in is denied by the identity provider rules")
+		}
+		mappedRole := store.Role(rule.Role)
+		role = &mappedRole
+	}
+
 	user, err := s.Store.GetUser(ctx, &store.FindUser{
 		Email: &email,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user, err: %s", err)
 	}
-	if user == nil {
-		if err := s.checkSeatAvailability(ctx); err != nil {
-			return nil, err
+	if user != nil {
+		if user.RowStatus == storepb.RowStatus_ARCHIVED {
+			return nil, status.Errorf(codes.PermissionDenied, "user has been archived")
+		}
+		update := &store.UpdateUser{
+			ID: user.ID,
+		}
+		if role != nil && *role != user.Role {
+			isLastAdmin, err := s.isLastAdmin(ctx, user)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check admins, err: %s", err)
+			}
+			// Demoting the last admin would leave nobody to manage the workspace.
+			if isLastAdmin {
+				slog.Warn("keep the role of the last admin", slog.String("email", user.Email), slog.String("role", string(*role)))
+			} else {
+				update.Role = role
+			}
 		}
-		userCreate := &store.User{
-			Email:    email,
-			Nickname: userInfo.DisplayName,
-			// The new signup user should be normal user by default.
-			Role: store.RoleUser,
+		// The display name falls back to the identifier, which should not override the nickname.
+		if userInfo.DisplayName != userInfo.Identifier && userInfo.DisplayName != user.Nickname {
+			update.Nickname = &userInfo.DisplayName
 		}
-		password, err := util.RandomString(20)
+		if update.Role == nil && update.Nickname == nil {
+			return user, nil
+		}
+		user, err = s.Store.UpdateUser(ctx, update)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to generate random password, err: %s", err)
+			return nil, status.Errorf(codes.Internal, "failed to update user, err: %s", err)
 		}
-		passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		return user, nil
+	}
+
+	if !idp.IsEmailDomainAllowed(identityProvider.AllowedEmailDomains, email) {
+		return nil, status.Errorf(codes.PermissionDenied, "email domain is not allowed to sign up")
+	}
+	if err := s.checkSeatAvailability(ctx); err != nil {
+		return nil, err
+	}
+	userCreate := &store.User{
+		Email:    email,
+		Nickname: userInfo.DisplayName,
+		// The new signup user should be normal user by default.
+		Role: store.RoleUser,
+	}
+	if role != nil {
+		userCreate.Role = *role
+	}
+	password, err := util.RandomString(20)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate random password, err: %s", err)
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate password hash, err: %s", err)
+	}
+	userCreate.PasswordHash = string(passwordHash)
+	user, err = s.Store.CreateUser(ctx, userCreate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create user, err: %s", err)
+	}
+	return user, nil
+}
+
+// isLastAdmin returns whether the user is the only active admin of the workspace.
+func (s *APIV1Service) isLastAdmin(ctx context.Context, user *store.User) (bool, error) {
+	if user.Role != store.RoleAdmin {
+		return false, nil
+	}
+	adminRole := store.RoleAdmin
+	admins, err := s.Store.ListUsers(ctx, &store.FindUser{
+		Role: &adminRole,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, admin := range admins {
+		if admin.ID != user.ID && admin.RowStatus == storepb.RowStatus_NORMAL {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (s *APIV1Service) AuthorizeSSO(ctx context.Context, request *v1pb.AuthorizeSSORequest) (*v1pb.AuthorizeSSOResponse, error) {
+	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeSSO) {
+		return nil, status.Errorf(codes.PermissionDenied, "SSO is not available in the current plan")
+	}
+	if request.RedirectUri == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "redirect uri is required")
+	}
+	identityProvider, err := s.getIdentityProvider(ctx, request.IdpId)
+	if err != nil {
+		return nil, err
+	}
+	generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace general setting, err: %s", err)
+	}
+	baseURL, err := checkSSORedirectURI(request.RedirectUri, generalSetting.InstanceUrl, getRequestHost(ctx))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid redirect uri, err: %s", err)
+	}
+
+	authRequest := &ssoAuthRequest{
+		idpID:        identityProvider.Id,
+		redirectURI:  request.RedirectUri,
+		codeVerifier: xoauth2.GenerateVerifier(),
+		expireTime:   time.Now().Add(ssoAuthRequestDuration),
+	}
+	state, err := util.RandomString(32)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate state, err: %s", err)
+	}
+	if authRequest.nonce, err = util.RandomString(32); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate nonce, err: %s", err)
+	}
+
+	var authorizationURL string
+	if identityProvider.Type == storepb.IdentityProvider_OAUTH2 {
+		oauth2Config := identityProvider.Config.GetOauth2()
+		authorizationURL = (&xoauth2.Config{
+			ClientID:    oauth2Config.ClientId,
+			RedirectURL: request.RedirectUri,
+			Scopes:      oauth2Config.Scopes,
+			Endpoint:    xoauth2.Endpoint{AuthURL: oauth2Config.AuthUrl},
+		}).AuthCodeURL(state)
+	} else if identityProvider.Type == storepb.IdentityProvider_OIDC {
+		oidcIdentityProvider, err := oidc.NewIdentityProvider(identityProvider.Config.GetOidc())
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to generate password hash, err: %s", err)
+			return nil, status.Errorf(codes.Internal, "failed to create oidc identity provider, err: %s", err)
 		}
-		userCreate.PasswordHash = string(passwordHash)
-		user, err = s.Store.CreateUser(ctx, userCreate)
+		providerMetadata, err := oidcIdentityProvider.Discover(ctx)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create user, err: %s", err)
+			return nil, status.Errorf(codes.Internal, "failed to discover oidc provider, err: %s", err)
 		}
-	}
-	if user.RowStatus == storepb.RowStatus_ARCHIVED {
-		return nil, status.Errorf(codes.PermissionDenied, "user has been archived")
+		authorizationURL = oidcIdentityProvider.AuthCodeURL(providerMetadata, request.RedirectUri, state, authRequest.nonce, authRequest.codeVerifier)
+	} else if identityProvider.Type == storepb.IdentityProvider_SAML {
+		samlIdentityProvider, err := s.newSAMLIdentityProvider(ctx, identityProvider.Id, baseURL)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create saml identity provider, err: %s", err)
+		}
+		if authorizationURL, authRequest.samlRequestID, err = samlIdentityProvider.AuthnRequestURL(state); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to make authentication request, err: %s", err)
+		}
+	} else {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported identity provider type %s", identityProvider.Type)
 	}
 
-	if err := s.doSignIn(ctx, user, time.Now().Add(AccessTokenDuration)); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to sign in, err: %s", err)
+	// Drop the expired requests of the users who never came back.
+	s.ssoAuthRequests.Range(func(key, value any) bool {
+		if value.(*ssoAuthRequest).expireTime.Before(time.Now()) {
+			s.ssoAuthRequests.Delete(key)
+		}
+		return true
+	})
+	s.ssoAuthRequests.Store(state, authRequest)
+	// Bind the state to the browser, so that the sign in cannot be completed from another one.
+	cookie := fmt.Sprintf("%s=%s; Path=/; Max-Age=%d; HttpOnly; SameSite=Lax", SSOStateCookieName, state, int(ssoAuthRequestDuration.Seconds()))
+	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
+		"Set-Cookie": cookie,
+	})); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
 	}
-	return convertUserFromStore(user), nil
+	return &v1pb.AuthorizeSSOResponse{
+		AuthorizationUrl: authorizationURL,
+		State:            state,
+	}, nil
 }
 
 func (s *APIV1Service) SignUp(ctx context.Context, request *v1pb.SignUpRequest) (*v1pb.User, error) {
@@ -198,6 +444,83 @@ func (s *APIV1Service) SignUp(ctx context.Context, request *v1pb.SignUpRequest)
 	return convertUserFromStore(user), nil
 }
 
+func (s *APIV1Service) getIdentityProvider(ctx context.Context, idpID string) (*storepb.IdentityProvider, error) {
+	identityProviderSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %s", err)
+	}
+	if identityProviderSetting == nil || identityProviderSetting.GetIdentityProvider() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "identity provider not found")
+	}
+	for _, identityProvider := range identityProviderSetting.GetIdentityProvider().IdentityProviders {
+		if identityProvider.Id == idpID {
+			return identityProvider, nil
+		}
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "identity provider not found")
+}
+
+// checkSSORedirectURI checks that the redirect uri is on the instance url, or on the host of the request if the
+// instance url is not set, so that the SSO code is never sent to another site. It returns the base url of the
+// instance, where the scheme of the redirect uri stands for the one of the request, which is unknown behind proxies.
+func checkSSORedirectURI(redirectURI, instanceURL, requestHost string) (string, error) {
+	redirectURL, err := url.Parse(redirectURI)
+	if err != nil {
+		return "", err
+	}
+	if (redirectURL.Scheme != "http" && redirectURL.Scheme != "https") || redirectURL.Host == "" {
+		return "", errors.New("the redirect uri must be an absolute http url")
+	}
+	if instanceURL != "" {
+		baseURL, err := url.Parse(instanceURL)
+		if err != nil {
+			return "", errors.Wrap(err, "invalid instance url")
+		}
+		if !strings.EqualFold(redirectURL.Scheme, baseURL.Scheme) || !strings.EqualFold(redirectURL.Host, baseURL.Host) {
+			return "", errors.Errorf("the redirect uri must be on the instance url %s", instanceURL)
+		}
+		return instanceURL, nil
+	}
+	if requestHost == "" || !strings.EqualFold(redirectURL.Host, requestHost) {
+		return "", errors.New("the redirect uri must be on the requested host")
+	}
+	return fmt.Sprintf("%s://%s", redirectURL.Scheme, requestHost), nil
+}
+
+// getRequestHost returns the host requested by the client, i.e. the authority of the gRPC-Web request.
+// The forwarded host is set by the client and never trusted, so the instance url must be set to sign in
+// through the gRPC-Gateway or a proxy rewriting the host.
+func getRequestHost(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(":authority"); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// consumeSSOAuthRequest returns the pending auth request of the state, which must be the one bound to
+// the browser by AuthorizeSSO. The auth request can only be used once.
+func (s *APIV1Service) consumeSSOAuthRequest(ctx context.Context, idpID, state string) (*ssoAuthRequest, error) {
+	if state == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "state is required")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if getCookieFromMetadata(md, SSOStateCookieName) != state {
+		return nil, status.Errorf(codes.InvalidArgument, "state mismatch")
+	}
+	value, ok := s.ssoAuthRequests.LoadAndDelete(state)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid state")
+	}
+	authRequest := value.(*ssoAuthRequest)
+	if authRequest.idpID != idpID || authRequest.expireTime.Before(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid state")
+	}
+	return authRequest, nil
+}
+
 func (s *APIV1Service) doSignIn(ctx context.Context, user *store.User, expireTime time.Time) error {
 	accessToken, err := GenerateAccessToken(user.Email, user.ID, expireTime, []byte(s.Secret))
 	if err != nil {
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/yourselfhosted/slash/plugin/idp"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+	teststore "github.com/yourselfhosted/slash/store/test"
+)
+
+func TestCheckSSORedirectURI(t *testing.T) {
+	tests := []struct {
+		name        string
+		redirectURI string
+		instanceURL string
+		requestHost string
+		baseURL     string
+		wantErr     bool
+	}{
+		{
+			name:        "instance url",
+			redirectURI: "https://slash.example.com/auth/callback",
+			instanceURL: "https://slash.example.com",
+			requestHost: "localhost:5231",
+			baseURL:     "https://slash.example.com",
+		},
+		{
+			name:        "request host",
+			redirectURI: "http://localhost:5231/auth/callback",
+			requestHost: "LOCALHOST:5231",
+			baseURL:     "http://LOCALHOST:5231",
+		},
+		{
+			name:        "foreign host with instance url",
+			redirectURI: "https://evil.example.com/auth/callback",
+			instanceURL: "https://slash.example.com",
+			requestHost: "evil.example.com",
+			wantErr:     true,
+		},
+		{
+			name:        "foreign host with request host",
+			redirectURI: "https://evil.example.com/auth/callback",
+			requestHost: "slash.example.com",
+			wantErr:     true,
+		},
+		{
+			name:        "scheme mismatch",
+			redirectURI: "http://slash.example.com/auth/callback",
+			instanceURL: "https://slash.example.com",
+			wantErr:     true,
+		},
+		{
+			name:        "no request host",
+			redirectURI: "https://slash.example.com/auth/callback",
+			wantErr:     true,
+		},
+		{
+			name:        "relative",
+			redirectURI: "/auth/callback",
+			requestHost: "slash.example.com",
+			wantErr:     true,
+		},
+		{
+			name:        "javascript",
+			redirectURI: "javascript://slash.example.com/%0Aalert(1)",
+			requestHost: "slash.example.com",
+			wantErr:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			baseURL, err := checkSSORedirectURI(test.redirectURI, test.instanceURL, test.requestHost)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.baseURL, baseURL)
+		})
+	}
+}
+
+func TestGetRequestHost(t *testing.T) {
+	// The forwarded host is set by the client.
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		":authority", "slash.example.com",
+		"x-forwarded-host", "evil.example.com",
+	))
+	require.Equal(t, "slash.example.com", getRequestHost(ctx))
+	require.Equal(t, "", getRequestHost(context.Background()))
+}
+
+func TestFindOrCreateSSOUserRole(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+	s := &APIV1Service{Store: ts}
+	createUser := func(email string, role store.Role) {
+		_, err := ts.CreateUser(ctx, &store.User{Email: email, Nickname: email, Role: role})
+		require.NoError(t, err)
+	}
+	signIn := func(email string, groups ...string) store.Role {
+		user, err := s.findOrCreateSSOUser(ctx, &storepb.IdentityProvider{
+			RoleMappingRules: []*storepb.IdentityProvider_RoleMappingRule{
+				{Groups: []string{"editors"}, Role: string(store.RoleEditor)},
+			},
+		}, &idp.IdentityProviderUserInfo{Identifier: email, DisplayName: email, Groups: groups})
+		require.NoError(t, err)
+		return user.Role
+	}
+	createUser("alice@example.com", store.RoleAdmin)
+	createUser("bob@example.com", store.RoleViewer)
+
+	// The users matching no rule keep their role.
+	require.Equal(t, store.RoleViewer, signIn("bob@example.com"))
+	require.Equal(t, store.RoleAdmin, signIn("alice@example.com"))
+	require.Equal(t, store.RoleEditor, signIn("bob@example.com", "editors"))
+	// The last admin is never demoted.
+	require.Equal(t, store.RoleAdmin, signIn("alice@example.com", "editors"))
+	createUser("carol@example.com", store.RoleAdmin)
+	require.Equal(t, store.RoleEditor, signIn("alice@example.com", "editors"))
+}
@@ -16,7 +16,25 @@ import (
 )
 
 func (s *APIV1Service) ListCollections(ctx context.Context, _ *v1pb.ListCollectionsRequest) (*v1pb.ListCollectionsResponse, error) {
-	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{})
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	collectionFind := &store.FindCollection{}
+	canViewPrivate, err := s.Store.HasPermission(ctx, user, store.PermissionCollectionsViewPrivate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if !canViewPrivate {
+		// Users only see the private collections created by themselves or owned by their groups.
+		groupIDs, err := s.Store.ListUserGroupIDs(ctx, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list user groups: %v", err)
+		}
+		collectionFind.PrivateCreatorID = &user.ID
+		collectionFind.PrivateGroupIDList = groupIDs
+	}
+	collections, err := s.Store.ListCollections(ctx, collectionFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get collection list, err: %v", err)
 	}
@@ -47,7 +65,11 @@ func (s *APIV1Service) GetCollection(ctx context.Context, request *v1pb.GetColle
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user == nil && collection.Visibility != storepb.Visibility_PUBLIC {
+	visible, err := s.isVisibleToUser(ctx, user, store.PermissionCollectionsViewPrivate, collection.Visibility, collection.CreatorId, collection.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check collection visibility: %v", err)
+	}
+	if !visible {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 	return convertCollectionFromStore(collection), nil
@@ -68,7 +90,11 @@ func (s *APIV1Service) GetCollectionByName(ctx context.Context, request *v1pb.Ge
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user == nil && collection.Visibility != storepb.Visibility_PUBLIC {
+	visible, err := s.isVisibleToUser(ctx, user, store.PermissionCollectionsViewPrivate, collection.Visibility, collection.CreatorId, collection.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check collection visibility: %v", err)
+	}
+	if !visible {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 	return convertCollectionFromStore(collection), nil
@@ -101,6 +127,10 @@ func (s *APIV1Service) CreateCollection(ctx context.Context, request *v1pb.Creat
 		Description: request.Collection.Description,
 		ShortcutIds: request.Collection.ShortcutIds,
 		Visibility:  convertVisibilityToStorepb(request.Collection.Visibility),
+		GroupId:     request.Collection.GroupId,
+	}
+	if err := s.validateOwnerGroup(ctx, user, collectionCreate.GroupId); err != nil {
+		return nil, err
 	}
 	collection, err := s.Store.CreateCollection(ctx, collectionCreate)
 	if err != nil {
@@ -128,7 +158,11 @@ func (s *APIV1Service) UpdateCollection(ctx context.Context, request *v1pb.Updat
 	if collection == nil {
 		return nil, status.Errorf(codes.NotFound, "collection not found")
 	}
-	if collection.CreatorId != user.ID && user.Role != store.RoleAdmin {
+	editable, err := s.canEdit(ctx, user, store.PermissionCollectionsEditAll, store.PermissionCollectionsViewPrivate, collection.Visibility, collection.CreatorId, collection.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check collection permission: %v", err)
+	}
+	if !editable {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
@@ -148,6 +182,11 @@ func (s *APIV1Service) UpdateCollection(ctx context.Context, request *v1pb.Updat
 		case "visibility":
 			visibility := convertVisibilityToStorepb(request.Collection.Visibility)
 			update.Visibility = &visibility
+		case "group_id":
+			if err := s.validateOwnerGroup(ctx, user, request.Collection.GroupId); err != nil {
+				return nil, err
+			}
+			update.GroupID = &request.Collection.GroupId
 		}
 	}
 	collection, err = s.Store.UpdateCollection(ctx, update)
@@ -172,7 +211,11 @@ func (s *APIV1Service) DeleteCollection(ctx context.Context, request *v1pb.Delet
 	if collection == nil {
 		return nil, status.Errorf(codes.NotFound, "collection not found")
 	}
-	if collection.CreatorId != user.ID && user.Role != store.RoleAdmin {
+	editable, err := s.canEdit(ctx, user, store.PermissionCollectionsEditAll, store.PermissionCollectionsViewPrivate, collection.Visibility, collection.CreatorId, collection.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check collection permission: %v", err)
+	}
+	if !editable {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
@@ -196,5 +239,6 @@ func convertCollectionFromStore(collection *storepb.Collection) *v1pb.Collection
 		Description: collection.Description,
 		ShortcutIds: collection.ShortcutIds,
 		Visibility:  convertVisibilityFromStorepb(collection.Visibility),
+		GroupId:     collection.GroupId,
 	}
 }
@@ -2,6 +2,12 @@ package v1
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
@@ -22,6 +28,70 @@ func getCurrentUser(ctx context.Context, s *store.Store) (*store.User, error) {
 	return user, nil
 }
 
+// isVisibleToUser returns true if the shortcut or collection with the visibility is visible to the user,
+// who is nil if not signed in. The private ones are only visible to their creators, the members of
+// their groups and the users with the view-private permission of their kind.
+func (s *APIV1Service) isVisibleToUser(ctx context.Context, user *store.User, viewPrivatePermission store.Permission, visibility storepb.Visibility, creatorID, groupID int32) (bool, error) {
+	switch visibility {
+	case storepb.Visibility_PUBLIC:
+		return true, nil
+	case storepb.Visibility_PRIVATE:
+		if user == nil {
+			return false, nil
+		}
+		if user.ID == creatorID {
+			return true, nil
+		}
+		canViewPrivate, err := s.Store.HasPermission(ctx, user, viewPrivatePermission)
+		if err != nil {
+			return false, err
+		}
+		if canViewPrivate {
+			return true, nil
+		}
+		if groupID == 0 {
+			return false, nil
+		}
+		member, err := s.Store.GetGroupMember(ctx, groupID, user.ID)
+		if err != nil {
+			return false, err
+		}
+		return member != nil, nil
+	default:
+		return user != nil, nil
+	}
+}
+
+// canEdit returns true if the user can edit the shortcut or collection, i.e. the user is its creator,
+// an editor of its group, or has the edit-all permission of its kind. The edit-all permission does
+// not apply to the private ones the user cannot see.
+func (s *APIV1Service) canEdit(ctx context.Context, user *store.User, editAllPermission, viewPrivatePermission store.Permission, visibility storepb.Visibility, creatorID, groupID int32) (bool, error) {
+	if user.ID == creatorID {
+		return true, nil
+	}
+	canEditAll, err := s.Store.HasPermission(ctx, user, editAllPermission)
+	if err != nil {
+		return false, err
+	}
+	if canEditAll {
+		visible, err := s.isVisibleToUser(ctx, user, viewPrivatePermission, visibility, creatorID, groupID)
+		if err != nil {
+			return false, err
+		}
+		if visible {
+			return true, nil
+		}
+	}
+	if groupID == 0 {
+		return false, nil
+	}
+	member, err := s.Store.GetGroupMember(ctx, groupID, user.ID)
+	if err != nil {
+		return false, err
+	}
+	return member != nil && member.Role == store.GroupRoleEditor, nil
+}
+
 func convertStateFromRowStatus(rowStatus storepb.RowStatus) v1pb.State {
 	switch rowStatus {
 	case storepb.RowStatus_NORMAL:
@@ -51,6 +121,8 @@ func convertVisibilityFromStorepb(visibility storepb.Visibility) v1pb.Visibility
 		return v1pb.Visibility_WORKSPACE
 	case storepb.Visibility_PUBLIC:
 		return v1pb.Visibility_PUBLIC
+	case storepb.Visibility_PRIVATE:
+		return v1pb.Visibility_PRIVATE
 	default:
 		return v1pb.Visibility_VISIBILITY_UNSPECIFIED
 	}
@@ -62,7 +134,39 @@ func convertVisibilityToStorepb(visibility v1pb.Visibility) storepb.Visibility {
 		return storepb.Visibility_WORKSPACE
 	case v1pb.Visibility_PUBLIC:
 		return storepb.Visibility_PUBLIC
+	case v1pb.Visibility_PRIVATE:
+		return storepb.Visibility_PRIVATE
 	default:
 		return storepb.Visibility_VISIBILITY_UNSPECIFIED
 	}
 }
+
+func marshalPageToken(pageToken *v1pb.PageToken) (string, error) {
+	b, err := proto.Marshal(pageToken)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal page token")
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// hashPageQuery returns the hash of the query params binding a page token, so that the token
+// can't be reused with other params.
+func hashPageQuery(params ...string) string {
+	h := sha256.New()
+	for _, param := range params {
+		h.Write([]byte(param))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func unmarshalPageToken(s string, pageToken *v1pb.PageToken) error {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode page token")
+	}
+	if err := proto.Unmarshal(b, pageToken); err != nil {
+		return errors.Wrap(err, "failed to unmarshal page token")
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package v1
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+var (
+	filterConditionRegexp  = regexp.MustCompile(`^([a-z_]+)\s*(==|>=|<=|>|<)\s*(.+)$`)
+	filterStartsWithRegexp = regexp.MustCompile(`^([a-z_]+)\.startsWith\((.+)\)$`)
+)
+
+// parseShortcutFilter applies the filter expression of ListShortcutsRequest to the find.
+// The filter is a list of conditions joined by "&&", e.g. `creator_id == 101 && name.startsWith("gh")`.
+func parseShortcutFilter(filter string, find *store.FindShortcut) error {
+	for _, condition := range splitFilterConditions(filter) {
+		if matches := filterStartsWithRegexp.FindStringSubmatch(condition); matches != nil {
+			if matches[1] != "name" {
+				return errors.Errorf("unsupported function startsWith on field %q", matches[1])
+			}
+			prefix, err := strconv.Unquote(strings.TrimSpace(matches[2]))
+			if err != nil {
+				return errors.Errorf("invalid string value %s", matches[2])
+			}
+			find.NamePrefix = &prefix
+			continue
+		}
+
+		matches := filterConditionRegexp.FindStringSubmatch(condition)
+		if matches == nil {
+			return errors.Errorf("invalid condition %q", condition)
+		}
+		field, operator, value := matches[1], matches[2], strings.TrimSpace(matches[3])
+		switch field {
+		case "creator_id":
+			if operator != "==" {
+				return errors.Errorf("unsupported operator %s for field %s", operator, field)
+			}
+			creatorID, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return errors.Errorf("invalid integer value %s", value)
+			}
+			id := int32(creatorID)
+			find.CreatorID = &id
+		case "tag", "visibility", "state":
+			if operator != "==" {
+				return errors.Errorf("unsupported operator %s for field %s", operator, field)
+			}
+			str, err := strconv.Unquote(value)
+			if err != nil {
+				return errors.Errorf("invalid string value %s", value)
+			}
+			if field == "tag" {
+				find.Tag = &str
+				continue
+			}
+			if field == "state" {
+				state, ok := v1pb.State_value[str]
+				if !ok || state == int32(v1pb.State_STATE_UNSPECIFIED) {
+					return errors.Errorf("invalid state %s", value)
+				}
+				rowStatus := ConvertStateToRowStatus(v1pb.State(state))
+				find.RowStatus = &rowStatus
+				continue
+			}
+			visibility, ok := v1pb.Visibility_value[str]
+			if !ok || visibility == int32(v1pb.Visibility_VISIBILITY_UNSPECIFIED) {
+				return errors.Errorf("invalid visibility %s", value)
+			}
+			find.VisibilityList = append(find.VisibilityList, convertVisibilityToStorepb(v1pb.Visibility(visibility)))
+		case "created_time", "updated_time":
+			str, err := strconv.Unquote(value)
+			if err != nil {
+				return errors.Errorf("invalid string value %s", value)
+			}
+			t, err := time.Parse(time.RFC3339, str)
+			if err != nil {
+				return errors.Errorf("invalid RFC 3339 time %s", value)
+			}
+			after, before := &find.CreatedTsAfter, &find.CreatedTsBefore
+			if field == "updated_time" {
+				after, before = &find.UpdatedTsAfter, &find.UpdatedTsBefore
+			}
+			// The store compares the timestamps exclusively in seconds.
+			ts := t.Unix()
+			switch operator {
+			case ">":
+				*after = &ts
+			case ">=":
+				ts--
+				*after = &ts
+			case "<":
+				*before = &ts
+			case "<=":
+				ts++
+				*before = &ts
+			default:
+				return errors.Errorf("unsupported operator %s for field %s", operator, field)
+			}
+		default:
+			return errors.Errorf("unsupported field %q", field)
+		}
+	}
+	return nil
+}
+
+// parseAuditLogFilter applies the filter expression of ListAuditLogsRequest to the find.
+// The filter is a list of conditions joined by "&&", e.g. `actor_id == 101 && type == "audit.shortcut"`.
+func parseAuditLogFilter(filter string, find *store.FindActivity) error {
+	for _, condition := range splitFilterConditions(filter) {
+		matches := filterConditionRegexp.FindStringSubmatch(condition)
+		if matches == nil {
+			return errors.Errorf("invalid condition %q", condition)
+		}
+		field, operator, value := matches[1], matches[2], strings.TrimSpace(matches[3])
+		if field == "created_time" {
+			str, err := strconv.Unquote(value)
+			if err != nil {
+				return errors.Errorf("invalid string value %s", value)
+			}
+			t, err := time.Parse(time.RFC3339, str)
+			if err != nil {
+				return errors.Errorf("invalid RFC 3339 time %s", value)
+			}
+			// The store compares the timestamps exclusively in seconds.
+			ts := t.Unix()
+			switch operator {
+			case ">":
+				find.CreatedTsAfter = &ts
+			case ">=":
+				ts--
+				find.CreatedTsAfter = &ts
+			case "<":
+				find.CreatedTsBefore = &ts
+			case "<=":
+				ts++
+				find.CreatedTsBefore = &ts
+			default:
+				return errors.Errorf("unsupported operator %s for field %s", operator, field)
+			}
+			continue
+		}
+
+		if operator != "==" {
+			return errors.Errorf("unsupported operator %s for field %s", operator, field)
+		}
+		switch field {
+		case "actor_id":
+			actorID, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return errors.Errorf("invalid integer value %s", value)
+			}
+			id := int32(actorID)
+			find.CreatorID = &id
+		case "type", "method", "resource", "code":
+			str, err := strconv.Unquote(value)
+			if err != nil {
+				return errors.Errorf("invalid string value %s", value)
+			}
+			switch field {
+			case "type":
+				activityType := store.ActivityType(str)
+				if !slices.Contains(store.AuditActivityTypes, activityType) {
+					return errors.Errorf("invalid type %s", value)
+				}
+				find.TypeList = []store.ActivityType{activityType}
+			case "method":
+				find.PayloadMethod = &str
+			case "resource":
+				find.PayloadResource = &str
+			case "code":
+				find.PayloadCode = &str
+			}
+		default:
+			return errors.Errorf("unsupported field %q", field)
+		}
+	}
+	return nil
+}
+
+// splitFilterConditions splits the filter by "&&" outside of the quoted strings.
+func splitFilterConditions(filter string) []string {
+	conditions := []string{}
+	inQuote, escaped, start := false, false, 0
+	for i := 0; i < len(filter); i++ {
+		switch c := filter[i]; {
+		case escaped:
+			escaped = false
+		case c == '\\' && inQuote:
+			escaped = true
+		case c == '"':
+			inQuote = !inQuote
+		case !inQuote && strings.HasPrefix(filter[i:], "&&"):
+			conditions = append(conditions, filter[start:i])
+			start = i + 2
+			i++
+		}
+	}
+	conditions = append(conditions, filter[start:])
+
+	result := []string{}
+	for _, condition := range conditions {
+		if condition = strings.TrimSpace(condition); condition != "" {
+			result = append(result, condition)
+		}
+	}
+	return result
+}
+
+// parseShortcutOrderBy applies the order_by of ListShortcutsRequest to the find.
+func parseShortcutOrderBy(orderBy string, find *store.FindShortcut) error {
+	fields := strings.Fields(orderBy)
+	if len(fields) == 0 {
+		return nil
+	}
+	if len(fields) > 2 {
+		return errors.Errorf("invalid order by %q", orderBy)
+	}
+	switch fields[0] {
+	case "created_time":
+		find.OrderBy = store.ShortcutOrderByCreatedTs
+	case "updated_time":
+		find.OrderBy = store.ShortcutOrderByUpdatedTs
+	case "name":
+		find.OrderBy = store.ShortcutOrderByName
+	default:
+		return errors.Errorf("unsupported order by field %q", fields[0])
+	}
+	if len(fields) == 2 {
+		switch strings.ToLower(fields[1]) {
+		case "asc":
+			find.OrderAsc = true
+		case "desc":
+			find.OrderAsc = false
+		default:
+			return errors.Errorf("invalid order direction %q", fields[1])
+		}
+	}
+	return nil
+}
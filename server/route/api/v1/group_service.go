@@ -0,0 +1,319 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (s *APIV1Service) ListGroups(ctx context.Context, _ *v1pb.ListGroupsRequest) (*v1pb.ListGroupsResponse, error) {
+	groups, err := s.Store.ListGroups(ctx, &store.FindGroup{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+	members, err := s.Store.ListGroupMembers(ctx, &store.FindGroupMember{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list group members: %v", err)
+	}
+
+	groupMessages := []*v1pb.Group{}
+	for _, group := range groups {
+		groupMessages = append(groupMessages, convertGroupFromStore(group, members))
+	}
+	response := &v1pb.ListGroupsResponse{
+		Groups: groupMessages,
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetGroup(ctx context.Context, request *v1pb.GetGroupRequest) (*v1pb.Group, error) {
+	group, err := s.Store.GetGroup(ctx, &store.FindGroup{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	if group == nil {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	return s.convertGroupWithMembers(ctx, group)
+}
+
+func (s *APIV1Service) CreateGroup(ctx context.Context, request *v1pb.CreateGroupRequest) (*v1pb.Group, error) {
+	if request.Group == nil || request.Group.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	members, err := s.validateGroupMembers(ctx, request.Group.Members)
+	if err != nil {
+		return nil, err
+	}
+	// The creator is always an editor of the group.
+	members[user.ID] = store.GroupRoleEditor
+	if err := s.checkGroupNameAvailable(ctx, request.Group.Name, 0); err != nil {
+		return nil, err
+	}
+
+	group, err := s.Store.CreateGroup(ctx, &store.Group{
+		Name:        request.Group.Name,
+		Description: request.Group.Description,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create group: %v", err)
+	}
+	for userID, role := range members {
+		if _, err := s.Store.UpsertGroupMember(ctx, &store.GroupMember{
+			GroupID: group.ID,
+			UserID:  userID,
+			Role:    role,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to add group member: %v", err)
+		}
+	}
+	return s.convertGroupWithMembers(ctx, group)
+}
+
+func (s *APIV1Service) UpdateGroup(ctx context.Context, request *v1pb.UpdateGroupRequest) (*v1pb.Group, error) {
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "updateMask is required")
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	group, err := s.Store.GetGroup(ctx, &store.FindGroup{
+		ID: &request.Group.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	if group == nil {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	if err := s.checkGroupEditor(ctx, user, group.ID); err != nil {
+		return nil, err
+	}
+
+	updatedTs := time.Now().Unix()
+	update := &store.UpdateGroup{
+		ID:        group.ID,
+		UpdatedTs: &updatedTs,
+	}
+	var members map[int32]store.GroupRole
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "name":
+			if request.Group.Name == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "name is required")
+			}
+			if err := s.checkGroupNameAvailable(ctx, request.Group.Name, group.ID); err != nil {
+				return nil, err
+			}
+			update.Name = &request.Group.Name
+		case "description":
+			update.Description = &request.Group.Description
+		case "members":
+			if members, err = s.validateGroupMembers(ctx, request.Group.Members); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if members != nil {
+		existingMembers, err := s.Store.ListGroupMembers(ctx, &store.FindGroupMember{
+			GroupID: &group.ID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list group members: %v", err)
+		}
+		for _, member := range existingMembers {
+			if _, ok := members[member.UserID]; ok {
+				continue
+			}
+			if err := s.Store.DeleteGroupMember(ctx, &store.DeleteGroupMember{
+				GroupID: group.ID,
+				UserID:  member.UserID,
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to remove group member: %v", err)
+			}
+		}
+		for userID, role := range members {
+			if _, err := s.Store.UpsertGroupMember(ctx, &store.GroupMember{
+				GroupID: group.ID,
+				UserID:  userID,
+				Role:    role,
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update group member: %v", err)
+			}
+		}
+	}
+	group, err = s.Store.UpdateGroup(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update group: %v", err)
+	}
+	return s.convertGroupWithMembers(ctx, group)
+}
+
+func (s *APIV1Service) DeleteGroup(ctx context.Context, request *v1pb.DeleteGroupRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	group, err := s.Store.GetGroup(ctx, &store.FindGroup{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	if group == nil {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	if err := s.checkGroupEditor(ctx, user, group.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.DeleteGroup(ctx, &store.DeleteGroup{ID: group.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete group: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkGroupEditor returns a PermissionDenied error unless the user is an editor of the group or can manage all groups.
+func (s *APIV1Service) checkGroupEditor(ctx context.Context, user *store.User, groupID int32) error {
+	canManage, err := s.Store.HasPermission(ctx, user, store.PermissionGroupsManage)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if canManage {
+		return nil
+	}
+	member, err := s.Store.GetGroupMember(ctx, groupID, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get group member: %v", err)
+	}
+	if member == nil || member.Role != store.GroupRoleEditor {
+		return status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return nil
+}
+
+// validateOwnerGroup checks that the user can make the group own a shortcut or collection,
+// i.e. the group exists and the user is an editor of it or can manage all groups. The group 0 means no group.
+func (s *APIV1Service) validateOwnerGroup(ctx context.Context, user *store.User, groupID int32) error {
+	if groupID == 0 {
+		return nil
+	}
+	group, err := s.Store.GetGroup(ctx, &store.FindGroup{
+		ID: &groupID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	if group == nil {
+		return status.Errorf(codes.InvalidArgument, "group %d not found", groupID)
+	}
+	return s.checkGroupEditor(ctx, user, groupID)
+}
+
+func (s *APIV1Service) checkGroupNameAvailable(ctx context.Context, name string, groupID int32) error {
+	existingGroup, err := s.Store.GetGroup(ctx, &store.FindGroup{
+		Name: &name,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get group by name: %v", err)
+	}
+	if existingGroup != nil && existingGroup.ID != groupID {
+		return status.Errorf(codes.AlreadyExists, "group %q already exists", name)
+	}
+	return nil
+}
+
+// validateGroupMembers returns the roles of the members by user id, after checking the users exist.
+func (s *APIV1Service) validateGroupMembers(ctx context.Context, members []*v1pb.Group_Member) (map[int32]store.GroupRole, error) {
+	roles := map[int32]store.GroupRole{}
+	for _, member := range members {
+		if _, ok := roles[member.UserId]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate member %d", member.UserId)
+		}
+		role, err := convertGroupRoleToStore(member.Role)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid role of member %d: %v", member.UserId, err)
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &member.UserId,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "user %d not found", member.UserId)
+		}
+		roles[member.UserId] = role
+	}
+	return roles, nil
+}
+
+func (s *APIV1Service) convertGroupWithMembers(ctx context.Context, group *store.Group) (*v1pb.Group, error) {
+	members, err := s.Store.ListGroupMembers(ctx, &store.FindGroupMember{
+		GroupID: &group.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list group members: %v", err)
+	}
+	return convertGroupFromStore(group, members), nil
+}
+
+// convertGroupFromStore converts the group with its members out of the given ones.
+func convertGroupFromStore(group *store.Group, members []*store.GroupMember) *v1pb.Group {
+	groupMessage := &v1pb.Group{
+		Id:          group.ID,
+		CreatedTime: timestamppb.New(time.Unix(group.CreatedTs, 0)),
+		UpdatedTime: timestamppb.New(time.Unix(group.UpdatedTs, 0)),
+		Name:        group.Name,
+		Description: group.Description,
+		Members:     []*v1pb.Group_Member{},
+	}
+	for _, member := range members {
+		if member.GroupID != group.ID {
+			continue
+		}
+		groupMessage.Members = append(groupMessage.Members, &v1pb.Group_Member{
+			UserId: member.UserID,
+			Role:   convertGroupRoleFromStore(member.Role),
+		})
+	}
+	return groupMessage
+}
+
+func convertGroupRoleFromStore(role store.GroupRole) v1pb.Group_Role {
+	switch role {
+	case store.GroupRoleEditor:
+		return v1pb.Group_EDITOR
+	case store.GroupRoleViewer:
+		return v1pb.Group_VIEWER
+	default:
+		return v1pb.Group_ROLE_UNSPECIFIED
+	}
+}
+
+func convertGroupRoleToStore(role v1pb.Group_Role) (store.GroupRole, error) {
+	switch role {
+	case v1pb.Group_EDITOR:
+		return store.GroupRoleEditor, nil
+	case v1pb.Group_VIEWER:
+		return store.GroupRoleViewer, nil
+	default:
+		return "", errors.Errorf("unsupported role %s", role)
+	}
+}
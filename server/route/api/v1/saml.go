@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	"github.com/yourselfhosted/slash/plugin/idp/saml"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/license"
+)
+
+// registerSAMLRoutes registers the endpoints of the SAML service provider, which are served
+// outside of the gRPC server as the IdP posts the responses as HTML forms.
+func (s *APIV1Service) registerSAMLRoutes(e *echo.Echo) {
+	e.GET("/api/v1/sso/saml/:idpId/metadata", s.handleSAMLMetadata)
+	e.POST("/api/v1/sso/saml/:idpId/acs", s.handleSAMLACS)
+}
+
+// handleSAMLMetadata serves the metadata of the service provider to register at the IdP.
+func (s *APIV1Service) handleSAMLMetadata(c echo.Context) error {
+	ctx := c.Request().Context()
+	samlIdentityProvider, err := s.newSAMLIdentityProvider(ctx, c.Param("idpId"), fmt.Sprintf("%s://%s", c.Scheme(), c.Request().Host))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	metadata, err := xml.MarshalIndent(samlIdentityProvider.Metadata(), "", "  ")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to marshal metadata").SetInternal(err)
+	}
+	return c.Blob(http.StatusOK, "application/samlmetadata+xml", metadata)
+}
+
+// handleSAMLACS verifies the SAML response posted by the IdP, which must be in response to a pending
+// SSO sign in started by AuthorizeSSO. The user is redirected back with a one-time code to complete
+// the sign in with SignInWithSSO. The pending sign in is consumed by the first response, so that a SAML
+// response cannot be replayed, and is stored again with the code once the response is verified.
+func (s *APIV1Service) handleSAMLACS(c echo.Context) error {
+	ctx := c.Request().Context()
+	idpID, state := c.Param("idpId"), c.FormValue("RelayState")
+	value, ok := s.ssoAuthRequests.LoadAndDelete(state)
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid relay state")
+	}
+	authRequest := *value.(*ssoAuthRequest)
+	if authRequest.idpID != idpID || authRequest.samlRequestID == "" || authRequest.expireTime.Before(time.Now()) {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid relay state")
+	}
+
+	samlIdentityProvider, err := s.newSAMLIdentityProvider(ctx, idpID, fmt.Sprintf("%s://%s", c.Scheme(), c.Request().Host))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	userInfo, err := samlIdentityProvider.UserInfo(c.FormValue("SAMLResponse"), authRequest.samlRequestID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	code, err := util.RandomString(32)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate code").SetInternal(err)
+	}
+
+	// The request id is cleared so that the code is only consumed by SignInWithSSO.
+	authRequest.samlRequestID = ""
+	authRequest.code = code
+	authRequest.userInfo = userInfo
+	s.ssoAuthRequests.Store(state, &authRequest)
+
+	redirectURL, err := url.Parse(authRequest.redirectURI)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid redirect uri")
+	}
+	query := redirectURL.Query()
+	query.Set("code", code)
+	query.Set("state", state)
+	// Only redirect to the path of the redirect uri on this host, which is never read as another host.
+	callbackURL := &url.URL{
+		Path:     "/" + strings.TrimLeft(redirectURL.Path, "/\\"),
+		RawQuery: query.Encode(),
+	}
+	return c.Redirect(http.StatusSeeOther, callbackURL.String())
+}
+
+// newSAMLIdentityProvider returns the SAML identity provider of the id, whose service provider endpoints
+// are under the instance url, or the given base url if the instance url is not set.
+func (s *APIV1Service) newSAMLIdentityProvider(ctx context.Context, idpID, baseURL string) (*saml.IdentityProvider, error) {
+	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeSSO) {
+		return nil, errors.New("SSO is not available in the current plan")
+	}
+	identityProvider, err := s.getIdentityProvider(ctx, idpID)
+	if err != nil {
+		return nil, err
+	}
+	if identityProvider.Type != storepb.IdentityProvider_SAML {
+		return nil, errors.Errorf("identity provider %q is not a SAML one", idpID)
+	}
+	generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace general setting")
+	}
+	if generalSetting.InstanceUrl != "" {
+		baseURL = generalSetting.InstanceUrl
+	}
+
+	endpoint := fmt.Sprintf("%s/api/v1/sso/saml/%s", strings.TrimSuffix(baseURL, "/"), url.PathEscape(idpID))
+	return saml.NewIdentityProvider(ctx, identityProvider.Config.GetSaml(), endpoint+"/metadata", endpoint+"/acs")
+}
@@ -0,0 +1,193 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+)
+
+// importedShortcut is a parsed row of the imported file, with the error if the row is invalid.
+type importedShortcut struct {
+	shortcut *v1pb.Shortcut
+	err      error
+}
+
+// parseImportedShortcuts parses the content of the imported file in the given format.
+// It returns an error only if the file as a whole can not be parsed.
+func parseImportedShortcuts(format v1pb.ImportShortcutsRequest_Format, content []byte) ([]*importedShortcut, error) {
+	switch format {
+	case v1pb.ImportShortcutsRequest_CSV:
+		return parseCSVShortcuts(content)
+	case v1pb.ImportShortcutsRequest_JSON:
+		return parseJSONShortcuts(content)
+	case v1pb.ImportShortcutsRequest_BOOKMARK_HTML:
+		return parseBookmarkHTMLShortcuts(content)
+	default:
+		return nil, errors.Errorf("unsupported format %s", format)
+	}
+}
+
+func parseCSVShortcuts(content []byte) ([]*importedShortcut, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the CSV header")
+	}
+	columns := map[string]int{}
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+		if column == "url" {
+			column = "link"
+		}
+		columns[column] = i
+	}
+	for _, column := range []string{"name", "link"} {
+		if _, ok := columns[column]; !ok {
+			return nil, errors.Errorf("missing the %s column in the CSV header", column)
+		}
+	}
+
+	list := []*importedShortcut{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				list = append(list, &importedShortcut{err: err})
+				continue
+			}
+			return nil, errors.Wrap(err, "failed to read the CSV record")
+		}
+		get := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		shortcut := &v1pb.Shortcut{
+			Name:        get("name"),
+			Link:        get("link"),
+			Title:       get("title"),
+			Description: get("description"),
+			Tags:        splitImportedTags(get("tags")),
+		}
+		if visibility := get("visibility"); visibility != "" {
+			value, ok := v1pb.Visibility_value[strings.ToUpper(visibility)]
+			if !ok {
+				list = append(list, &importedShortcut{err: errors.Errorf("invalid visibility %q", visibility)})
+				continue
+			}
+			shortcut.Visibility = v1pb.Visibility(value)
+		}
+		list = append(list, &importedShortcut{shortcut: shortcut})
+	}
+	return list, nil
+}
+
+func parseJSONShortcuts(content []byte) ([]*importedShortcut, error) {
+	rawShortcuts := []json.RawMessage{}
+	if err := json.Unmarshal(content, &rawShortcuts); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the JSON array")
+	}
+	list := []*importedShortcut{}
+	for _, rawShortcut := range rawShortcuts {
+		shortcut := &v1pb.Shortcut{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(rawShortcut, shortcut); err != nil {
+			list = append(list, &importedShortcut{err: errors.Wrap(err, "invalid shortcut")})
+			continue
+		}
+		list = append(list, &importedShortcut{shortcut: shortcut})
+	}
+	return list, nil
+}
+
+// parseBookmarkHTMLShortcuts parses the Netscape bookmark file, in which every bookmark is like
+// `<DT><A HREF="https://example.com" SHORTCUTURL="keyword" TAGS="a,b">Title</A>` with an optional `<DD>Description`.
+// The keyword of the bookmark is used as the shortcut name, otherwise the name is derived from the title.
+func parseBookmarkHTMLShortcuts(content []byte) ([]*importedShortcut, error) {
+	tokenizer := html.NewTokenizer(bytes.NewReader(content))
+	list := []*importedShortcut{}
+	var current *v1pb.Shortcut
+	var text strings.Builder
+	inAnchor, inDescription := false, false
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			if err := tokenizer.Err(); err != io.EOF {
+				return nil, errors.Wrap(err, "failed to parse the bookmark HTML")
+			}
+			return list, nil
+		case html.StartTagToken, html.EndTagToken:
+			token := tokenizer.Token()
+			if inDescription && current != nil {
+				current.Description = strings.TrimSpace(text.String())
+				inDescription = false
+			}
+			switch {
+			case token.Data == "a" && tokenType == html.StartTagToken:
+				current = &v1pb.Shortcut{}
+				for _, attr := range token.Attr {
+					switch attr.Key {
+					case "href":
+						current.Link = strings.TrimSpace(attr.Val)
+					case "shortcuturl":
+						current.Name = strings.TrimSpace(attr.Val)
+					case "tags":
+						current.Tags = splitImportedTags(attr.Val)
+					}
+				}
+				list = append(list, &importedShortcut{shortcut: current})
+				inAnchor = true
+				text.Reset()
+			case token.Data == "a" && tokenType == html.EndTagToken && inAnchor:
+				current.Title = strings.TrimSpace(text.String())
+				if current.Name == "" {
+					current.Name = slugify(current.Title)
+				}
+				inAnchor = false
+			case token.Data == "dd" && tokenType == html.StartTagToken && current != nil:
+				inDescription = true
+				text.Reset()
+			default:
+				// Any other tag ends the bookmark, so that the description of a folder is not attached to it.
+				if !inAnchor {
+					current = nil
+				}
+			}
+		case html.TextToken:
+			if inAnchor || inDescription {
+				text.Write(tokenizer.Text())
+			}
+		}
+	}
+}
+
+func splitImportedTags(s string) []string {
+	tags := []string{}
+	return append(tags, strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ';' || unicode.IsSpace(r)
+	})...)
+}
+
+var nonSlugCharRegexp = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// slugify derives a shortcut name from the title, e.g. "Slash - Docs" to "slash-docs".
+func slugify(title string) string {
+	return strings.Trim(nonSlugCharRegexp.ReplaceAllString(strings.ToLower(title), "-"), "-")
+}
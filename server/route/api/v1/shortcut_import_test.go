@@ -0,0 +1,125 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
+)
+
+func TestParseCSVShortcuts(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []*v1pb.Shortcut
+		// errRows are the indexes of the rows failed to parse.
+		errRows []int
+		wantErr bool
+	}{
+		{
+			name:    "header detection",
+			content: "\ufeffName, URL ,Tags,Visibility\ndocs,https://docs.example.com,a;b c,public\n",
+			expected: []*v1pb.Shortcut{
+				{Name: "docs", Link: "https://docs.example.com", Tags: []string{"a", "b", "c"}, Visibility: v1pb.Visibility_PUBLIC},
+			},
+		},
+		{
+			name:    "quoting",
+			content: "name,link,title,description\nq,\"https://example.com/?a=1,2\",\"Say \"\"hi\"\"\",\"multi\nline\"\n",
+			expected: []*v1pb.Shortcut{
+				{Name: "q", Link: "https://example.com/?a=1,2", Title: `Say "hi"`, Description: "multi\nline", Tags: []string{}},
+			},
+		},
+		{
+			name:    "malformed rows",
+			content: "name,link,visibility\nbad,https://a\"b.example.com,\nprivate,https://example.com,secret\nshort\ngood,https://example.com,\n",
+			expected: []*v1pb.Shortcut{
+				nil,
+				nil,
+				{Name: "short", Tags: []string{}},
+				{Name: "good", Link: "https://example.com", Tags: []string{}},
+			},
+			errRows: []int{0, 1},
+		},
+		{
+			name:    "missing column",
+			content: "name,title\ndocs,Docs\n",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			content: "",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list, err := parseCSVShortcuts([]byte(test.content))
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			requireImportedShortcuts(t, test.expected, test.errRows, list)
+		})
+	}
+}
+
+func TestParseJSONShortcuts(t *testing.T) {
+	list, err := parseJSONShortcuts([]byte(`[
+		{"name": "docs", "link": "https://docs.example.com", "tags": ["a"], "unknown": 1},
+		{"name": 1},
+		{"name": "wiki", "link": "https://wiki.example.com", "visibility": "PUBLIC"}
+	]`))
+	require.NoError(t, err)
+	requireImportedShortcuts(t, []*v1pb.Shortcut{
+		{Name: "docs", Link: "https://docs.example.com", Tags: []string{"a"}},
+		nil,
+		{Name: "wiki", Link: "https://wiki.example.com", Visibility: v1pb.Visibility_PUBLIC},
+	}, []int{1}, list)
+
+	_, err = parseJSONShortcuts([]byte(`{"name": "docs"}`))
+	require.Error(t, err)
+}
+
+func TestParseBookmarkHTMLShortcuts(t *testing.T) {
+	content := `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<META HTTP-EQUIV="Content-Type" CONTENT="text/html; charset=UTF-8">
+<TITLE>Bookmarks</TITLE>
+<H1>Bookmarks</H1>
+<DL><p>
+    <DT><H3>Work</H3>
+    <DD>Folder description
+    <DL><p>
+        <DT><A HREF="https://docs.example.com" SHORTCUTURL="docs" TAGS="a,b">Docs</A>
+        <DD>The team docs
+        <DT><H3>Nested</H3>
+        <DL><p>
+            <DT><A HREF=" https://wiki.example.com ">Team Wiki &amp; FAQ</A>
+        </DL><p>
+    </DL><p>
+    <DT><A HREF="https://example.com">Example</A>
+</DL><p>
+`
+	list, err := parseBookmarkHTMLShortcuts([]byte(content))
+	require.NoError(t, err)
+	requireImportedShortcuts(t, []*v1pb.Shortcut{
+		{Name: "docs", Link: "https://docs.example.com", Title: "Docs", Tags: []string{"a", "b"}, Description: "The team docs"},
+		{Name: "team-wiki-faq", Link: "https://wiki.example.com", Title: "Team Wiki & FAQ"},
+		{Name: "example", Link: "https://example.com", Title: "Example"},
+	}, nil, list)
+}
+
+func requireImportedShortcuts(t *testing.T, expected []*v1pb.Shortcut, errRows []int, list []*importedShortcut) {
+	t.Helper()
+	require.Len(t, list, len(expected))
+	for i, imported := range list {
+		if imported.err != nil {
+			require.Contains(t, errRows, i, "unexpected error of row %d: %v", i, imported.err)
+			continue
+		}
+		require.NotContains(t, errRows, i, "row %d", i)
+		require.Equal(t, expected[i].String(), imported.shortcut.String(), "row %d", i)
+	}
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"strings"
 	"time"
 
@@ -12,32 +13,117 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/yourselfhosted/slash/internal/linktemplate"
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/service/license"
 	"github.com/yourselfhosted/slash/store"
 )
 
-func (s *APIV1Service) ListShortcuts(ctx context.Context, _ *v1pb.ListShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
-	shortcutList, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+const (
+	// maxShortcutPageSize is the maximum page size of ListShortcuts.
+	maxShortcutPageSize = 1000
+)
+
+func (s *APIV1Service) ListShortcuts(ctx context.Context, request *v1pb.ListShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
+	shortcutFind := &store.FindShortcut{}
+	if err := parseShortcutFilter(request.Filter, shortcutFind); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	if err := parseShortcutOrderBy(request.OrderBy, shortcutFind); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order by: %v", err)
+	}
+	if request.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must not be negative")
+	}
+	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list shortcuts, err: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	canViewPrivate, err := s.Store.HasPermission(ctx, user, store.PermissionShortcutsViewPrivate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if !canViewPrivate {
+		// Users only see the private shortcuts created by themselves or owned by their groups.
+		groupIDs, err := s.Store.ListUserGroupIDs(ctx, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list user groups: %v", err)
+		}
+		shortcutFind.PrivateCreatorID = &user.ID
+		shortcutFind.PrivateGroupIDList = groupIDs
+	}
+	if shortcutFind.RowStatus == nil {
+		rowStatus := storepb.RowStatus_NORMAL
+		shortcutFind.RowStatus = &rowStatus
+	} else if *shortcutFind.RowStatus == storepb.RowStatus_ARCHIVED {
+		// Users only see their own shortcuts in the trash, unless they can edit all shortcuts.
+		canEditAll, err := s.Store.HasPermission(ctx, user, store.PermissionShortcutsEditAll)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+		}
+		if !canEditAll {
+			if shortcutFind.CreatorID != nil && *shortcutFind.CreatorID != user.ID {
+				return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+			}
+			shortcutFind.CreatorID = &user.ID
+		}
 	}
 
-	shortcutMessageList := []*v1pb.Shortcut{}
-	for _, shortcut := range shortcutList {
-		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	var limit, offset int
+	queryHash := hashPageQuery(request.Filter, request.OrderBy)
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		if pageToken.QueryHash != queryHash {
+			return nil, status.Errorf(codes.InvalidArgument, "page token doesn't match the filter and order by")
+		}
+		// The later pages are always limited, as the limit comes from the client.
+		limit, offset = maxShortcutPageSize, max(int(pageToken.Offset), 0)
+		if pageToken.Limit > 0 {
+			limit = min(int(pageToken.Limit), maxShortcutPageSize)
+		}
+	}
+	if request.PageSize > 0 {
+		limit = min(int(request.PageSize), maxShortcutPageSize)
+	}
+	if limit > 0 {
+		// Fetch one more shortcut to tell if there is a next page.
+		limitPlusOne := limit + 1
+		shortcutFind.Limit, shortcutFind.Offset = &limitPlusOne, &offset
+	}
+
+	shortcutList, err := s.Store.ListShortcuts(ctx, shortcutFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts, err: %v", err)
+	}
+	nextPageToken := ""
+	if limit > 0 && len(shortcutList) > limit {
+		shortcutList = shortcutList[:limit]
+		nextPageToken, err = marshalPageToken(&v1pb.PageToken{
+			Limit:     int32(limit),
+			Offset:    int32(offset + limit),
+			QueryHash: queryHash,
+		})
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to marshal next page token, err: %v", err)
 		}
-		shortcutMessageList = append(shortcutMessageList, composedShortcut)
+	}
+
+	shortcutMessageList, err := s.convertShortcutsFromStorepb(ctx, shortcutList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcuts, err: %v", err)
 	}
 
 	response := &v1pb.ListShortcutsResponse{
-		Shortcuts: shortcutMessageList,
+		Shortcuts:     shortcutMessageList,
+		NextPageToken: nextPageToken,
 	}
 	return response, nil
 }
@@ -57,7 +143,11 @@ func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcu
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user == nil && shortcut.Visibility != storepb.Visibility_PUBLIC {
+	visible, err := s.isVisibleToUser(ctx, user, store.PermissionShortcutsViewPrivate, shortcut.Visibility, shortcut.CreatorId, shortcut.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
@@ -69,21 +159,30 @@ func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcu
 }
 
 func (s *APIV1Service) GetShortcutByName(ctx context.Context, request *v1pb.GetShortcutByNameRequest) (*v1pb.Shortcut, error) {
-	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-		Name: &request.Name,
-	})
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	userID := int32(0)
+	if user != nil {
+		userID = user.ID
+	}
+	rowStatus := storepb.RowStatus_NORMAL
+	shortcut, err := s.Store.ResolveShortcut(ctx, &store.FindShortcut{
+		Name:      &request.Name,
+		RowStatus: &rowStatus,
+	}, userID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
 	}
 	if shortcut == nil {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
-
-	user, err := getCurrentUser(ctx, s.Store)
+	visible, err := s.isVisibleToUser(ctx, user, store.PermissionShortcutsViewPrivate, shortcut.Visibility, shortcut.CreatorId, shortcut.GroupId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
 	}
-	if user == nil && shortcut.Visibility != storepb.Visibility_PUBLIC {
+	if !visible {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
@@ -98,9 +197,42 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 	if request.Shortcut.Name == "" || request.Shortcut.Link == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "name and link are required")
 	}
+	if strings.HasPrefix(request.Shortcut.Name, store.PersonalNamespacePrefix) {
+		return nil, status.Errorf(codes.InvalidArgument, "name must not start with %q", store.PersonalNamespacePrefix)
+	}
+	if request.Shortcut.LinkTemplate {
+		if _, err := linktemplate.Parse(request.Shortcut.Link); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid link template: %v", err)
+		}
+	}
+
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	namespaceID := store.WorkspaceNamespaceID
+	if request.Shortcut.Personal {
+		namespaceID = user.ID
+	}
+	existingShortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		Name:        &request.Shortcut.Name,
+		NamespaceID: &namespaceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+	}
+	if existingShortcut != nil {
+		if existingShortcut.RowStatus == storepb.RowStatus_ARCHIVED {
+			return nil, status.Errorf(codes.AlreadyExists, "shortcut %q is in the trash, restore or purge it first", request.Shortcut.Name)
+		}
+		return nil, status.Errorf(codes.AlreadyExists, "shortcut %q already exists", request.Shortcut.Name)
+	}
 
 	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeUnlimitedShortcuts) {
-		shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+		rowStatus := storepb.RowStatus_NORMAL
+		shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+			RowStatus: &rowStatus,
+		})
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get shortcut list, err: %v", err)
 		}
@@ -110,30 +242,28 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 		}
 	}
 
-	user, err := getCurrentUser(ctx, s.Store)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
-	}
 	shortcutCreate := &storepb.Shortcut{
-		CreatorId:   user.ID,
-		Name:        request.Shortcut.Name,
-		Link:        request.Shortcut.Link,
-		Title:       request.Shortcut.Title,
-		Tags:        request.Shortcut.Tags,
-		Description: request.Shortcut.Description,
-		Visibility:  convertVisibilityToStorepb(request.Shortcut.Visibility),
-		OgMetadata:  &storepb.OpenGraphMetadata{},
+		CreatorId:    user.ID,
+		Name:         request.Shortcut.Name,
+		Link:         request.Shortcut.Link,
+		Title:        request.Shortcut.Title,
+		Tags:         request.Shortcut.Tags,
+		Description:  request.Shortcut.Description,
+		Visibility:   convertVisibilityToStorepb(request.Shortcut.Visibility),
+		OgMetadata:   &storepb.OpenGraphMetadata{},
+		LinkTemplate: request.Shortcut.LinkTemplate,
+		NamespaceId:  namespaceID,
+		GroupId:      request.Shortcut.GroupId,
+	}
+	if err := s.validateOwnerGroup(ctx, user, shortcutCreate.GroupId); err != nil {
+		return nil, err
 	}
 	if shortcutCreate.Visibility == storepb.Visibility_VISIBILITY_UNSPECIFIED {
-		workspaceSetting, err := s.GetWorkspaceSetting(ctx, nil)
+		visibility, err := s.getDefaultVisibility(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %v", err)
 		}
-		visibility := v1pb.Visibility_WORKSPACE
-		if workspaceSetting.DefaultVisibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED {
-			visibility = workspaceSetting.DefaultVisibility
-		}
-		shortcutCreate.Visibility = convertVisibilityToStorepb(visibility)
+		shortcutCreate.Visibility = visibility
 	}
 	if request.Shortcut.OgMetadata != nil {
 		shortcutCreate.OgMetadata = &storepb.OpenGraphMetadata{
@@ -157,6 +287,19 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 	return composedShortcut, nil
 }
 
+// getDefaultVisibility returns the visibility of the shortcuts created without one.
+func (s *APIV1Service) getDefaultVisibility(ctx context.Context) (storepb.Visibility, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, nil)
+	if err != nil {
+		return storepb.Visibility_VISIBILITY_UNSPECIFIED, err
+	}
+	visibility := v1pb.Visibility_WORKSPACE
+	if workspaceSetting.DefaultVisibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		visibility = workspaceSetting.DefaultVisibility
+	}
+	return convertVisibilityToStorepb(visibility), nil
+}
+
 func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateShortcutRequest) (*v1pb.Shortcut, error) {
 	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "updateMask is required")
@@ -175,9 +318,16 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 	if shortcut == nil {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
-	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+	editable, err := s.canEdit(ctx, user, store.PermissionShortcutsEditAll, store.PermissionShortcutsViewPrivate, shortcut.Visibility, shortcut.CreatorId, shortcut.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut permission: %v", err)
+	}
+	if !editable {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
+	if shortcut.RowStatus == storepb.RowStatus_ARCHIVED {
+		return nil, status.Errorf(codes.FailedPrecondition, "shortcut is in the trash, restore it first")
+	}
 
 	update := &store.UpdateShortcut{
 		ID: shortcut.Id,
@@ -185,8 +335,17 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 	for _, path := range request.UpdateMask.Paths {
 		switch path {
 		case "name":
+			if request.Shortcut.Name == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "name is required")
+			}
+			if strings.HasPrefix(request.Shortcut.Name, store.PersonalNamespacePrefix) {
+				return nil, status.Errorf(codes.InvalidArgument, "name must not start with %q", store.PersonalNamespacePrefix)
+			}
 			update.Name = &request.Shortcut.Name
 		case "link":
+			if request.Shortcut.Link == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "link is required")
+			}
 			update.Link = &request.Shortcut.Link
 		case "title":
 			update.Title = &request.Shortcut.Title
@@ -206,14 +365,64 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 					Image:       request.Shortcut.OgMetadata.Image,
 				}
 			}
+		case "link_template":
+			update.LinkTemplate = &request.Shortcut.LinkTemplate
+		case "personal":
+			// The personal shortcut is in the namespace of its creator.
+			namespaceID := store.WorkspaceNamespaceID
+			if request.Shortcut.Personal {
+				namespaceID = shortcut.CreatorId
+			}
+			update.NamespaceID = &namespaceID
+		case "group_id":
+			if err := s.validateOwnerGroup(ctx, user, request.Shortcut.GroupId); err != nil {
+				return nil, err
+			}
+			update.GroupID = &request.Shortcut.GroupId
+		}
+	}
+	if update.Link != nil || update.LinkTemplate != nil {
+		link, linkTemplate := shortcut.Link, shortcut.LinkTemplate
+		if update.Link != nil {
+			link = *update.Link
+		}
+		if update.LinkTemplate != nil {
+			linkTemplate = *update.LinkTemplate
+		}
+		if linkTemplate {
+			if _, err := linktemplate.Parse(link); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid link template: %v", err)
+			}
+		}
+	}
+	if update.Name != nil || update.NamespaceID != nil {
+		name, namespaceID := shortcut.Name, shortcut.NamespaceId
+		if update.Name != nil {
+			name = *update.Name
+		}
+		if update.NamespaceID != nil {
+			namespaceID = *update.NamespaceID
+		}
+		existingShortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name:        &name,
+			NamespaceID: &namespaceID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+		}
+		if existingShortcut != nil && existingShortcut.Id != shortcut.Id {
+			return nil, status.Errorf(codes.AlreadyExists, "shortcut %q already exists", name)
 		}
 	}
-	shortcut, err = s.Store.UpdateShortcut(ctx, update)
+	updatedShortcut, err := s.Store.UpdateShortcut(ctx, update)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update shortcut, err: %v", err)
 	}
+	if err := s.createShortcutRevision(ctx, user.ID, shortcut, updatedShortcut); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create shortcut revision, err: %v", err)
+	}
 
-	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, updatedShortcut)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
 	}
@@ -234,20 +443,43 @@ func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteS
 	if shortcut == nil {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
-	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+	editable, err := s.canEdit(ctx, user, store.PermissionShortcutsEditAll, store.PermissionShortcutsViewPrivate, shortcut.Visibility, shortcut.CreatorId, shortcut.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut permission: %v", err)
+	}
+	if !editable {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
-	err = s.Store.DeleteShortcut(ctx, &store.DeleteShortcut{
-		ID: shortcut.Id,
-	})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete shortcut, err: %v", err)
+	if request.Purge {
+		err = s.Store.DeleteShortcut(ctx, &store.DeleteShortcut{
+			ID: shortcut.Id,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete shortcut, err: %v", err)
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	if shortcut.RowStatus != storepb.RowStatus_ARCHIVED {
+		// The archived time of the shortcut in the trash is the updated time.
+		rowStatus, updatedTs := storepb.RowStatus_ARCHIVED, time.Now().Unix()
+		if _, err := s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:        shortcut.Id,
+			RowStatus: &rowStatus,
+			UpdatedTs: &updatedTs,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to archive shortcut, err: %v", err)
+		}
 	}
 	return &emptypb.Empty{}, nil
 }
 
-func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.GetShortcutAnalyticsRequest) (*v1pb.GetShortcutAnalyticsResponse, error) {
+func (s *APIV1Service) UndeleteShortcut(ctx context.Context, request *v1pb.UndeleteShortcutRequest) (*v1pb.Shortcut, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
 	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
 		ID: &request.Id,
 	})
@@ -257,59 +489,480 @@ func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.G
 	if shortcut == nil {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
-
-	activityFind := &store.FindActivity{
-		Type:              store.ActivityShortcutView,
-		PayloadShortcutID: &shortcut.Id,
+	editable, err := s.canEdit(ctx, user, store.PermissionShortcutsEditAll, store.PermissionShortcutsViewPrivate, shortcut.Visibility, shortcut.CreatorId, shortcut.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut permission: %v", err)
 	}
-	// For non-advanced analytics users, we limit the activity to the last 14 days.
-	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeAdvancedAnalytics) {
-		createdTsAfter := time.Now().AddDate(0, 0, -14).Unix()
-		activityFind.CreatedTsAfter = &createdTsAfter
+	if !editable {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	if shortcut.RowStatus != storepb.RowStatus_ARCHIVED {
+		return nil, status.Errorf(codes.FailedPrecondition, "shortcut is not in the trash")
 	}
-	activities, err := s.Store.ListActivities(ctx, activityFind)
+
+	rowStatus, updatedTs := storepb.RowStatus_NORMAL, time.Now().Unix()
+	shortcut, err = s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		RowStatus: &rowStatus,
+		UpdatedTs: &updatedTs,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get activities, err: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to restore shortcut, err: %v", err)
 	}
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	return composedShortcut, nil
+}
 
-	referenceMap := make(map[string]int32)
-	deviceMap := make(map[string]int32)
-	browserMap := make(map[string]int32)
-	for _, activity := range activities {
-		payload := &storepb.ActivityShorcutViewPayload{}
-		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err != nil {
-			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to unmarshal payload, err: %v", err))
+func (s *APIV1Service) ImportShortcuts(ctx context.Context, request *v1pb.ImportShortcutsRequest) (*v1pb.ImportShortcutsResponse, error) {
+	importedShortcuts, err := parseImportedShortcuts(request.Format, request.Content)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse imported shortcuts: %v", err)
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	defaultVisibility, err := s.getDefaultVisibility(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, err: %v", err)
+	}
+	// The shortcuts are imported into the workspace namespace.
+	namespaceID := store.WorkspaceNamespaceID
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		NamespaceID: &namespaceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut list, err: %v", err)
+	}
+	// The shortcuts by name, including the ones imported before the current row.
+	shortcutMap := make(map[string]*storepb.Shortcut)
+	// The shortcuts in the trash do not count towards the limit.
+	shortcutCount := 0
+	for _, shortcut := range shortcuts {
+		shortcutMap[shortcut.Name] = shortcut
+		if shortcut.RowStatus == storepb.RowStatus_NORMAL {
+			shortcutCount++
 		}
+	}
+	shortcutsLimit := -1
+	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeUnlimitedShortcuts) {
+		shortcutsLimit = int(s.LicenseService.GetSubscription().ShortcutsLimit)
+	}
 
-		if _, ok := referenceMap[payload.Referer]; !ok {
-			referenceMap[payload.Referer] = 0
+	response := &v1pb.ImportShortcutsResponse{}
+	for i, imported := range importedShortcuts {
+		result := &v1pb.ImportShortcutsResponse_Result{
+			Row: int32(i + 1),
+		}
+		response.Results = append(response.Results, result)
+		fail := func(err error) {
+			result.Status = v1pb.ImportShortcutsResponse_FAILED
+			result.Error = err.Error()
+			response.FailedCount++
+		}
+		if imported.err != nil {
+			fail(imported.err)
+			continue
+		}
+		shortcut := imported.shortcut
+		result.Name = shortcut.Name
+		if shortcut.Name == "" || shortcut.Link == "" {
+			fail(errors.New("name and link are required"))
+			continue
+		}
+		if strings.HasPrefix(shortcut.Name, store.PersonalNamespacePrefix) {
+			fail(errors.Errorf("name must not start with %q", store.PersonalNamespacePrefix))
+			continue
+		}
+		if shortcut.LinkTemplate {
+			if _, err := linktemplate.Parse(shortcut.Link); err != nil {
+				fail(errors.Wrap(err, "invalid link template"))
+				continue
+			}
 		}
-		referenceMap[payload.Referer]++
 
-		ua := useragent.New(payload.UserAgent)
-		deviceName := ua.OSInfo().Name
-		browserName, _ := ua.Browser()
+		if existing, ok := shortcutMap[shortcut.Name]; ok {
+			switch request.ConflictPolicy {
+			case v1pb.ImportShortcutsRequest_OVERWRITE:
+				editable, err := s.canEdit(ctx, user, store.PermissionShortcutsEditAll, store.PermissionShortcutsViewPrivate, existing.Visibility, existing.CreatorId, existing.GroupId)
+				if err != nil {
+					fail(errors.Wrap(err, "failed to check shortcut permission"))
+					continue
+				}
+				if !editable {
+					fail(errors.Errorf("permission denied to overwrite shortcut %q", existing.Name))
+					continue
+				}
+				if existing.RowStatus == storepb.RowStatus_ARCHIVED {
+					fail(errors.Errorf("shortcut %q is in the trash", existing.Name))
+					continue
+				}
+				update := &store.UpdateShortcut{
+					ID:           existing.Id,
+					Link:         &shortcut.Link,
+					Title:        &shortcut.Title,
+					Description:  &shortcut.Description,
+					LinkTemplate: &shortcut.LinkTemplate,
+				}
+				tag := strings.Join(shortcut.Tags, " ")
+				update.Tag = &tag
+				if shortcut.Visibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+					visibility := convertVisibilityToStorepb(shortcut.Visibility)
+					update.Visibility = &visibility
+				}
+				if !request.DryRun {
+					updatedShortcut, err := s.Store.UpdateShortcut(ctx, update)
+					if err != nil {
+						fail(errors.Wrap(err, "failed to update shortcut"))
+						continue
+					}
+					// The shortcut is updated anyway, so the row doesn't fail on the revision.
+					if err := s.createShortcutRevision(ctx, user.ID, existing, updatedShortcut); err != nil {
+						slog.Warn("failed to create shortcut revision", slog.String("name", updatedShortcut.Name), slog.String("error", err.Error()))
+					}
+					if result.Shortcut, err = s.convertShortcutFromStorepb(ctx, updatedShortcut); err != nil {
+						slog.Warn("failed to convert shortcut", slog.String("name", updatedShortcut.Name), slog.String("error", err.Error()))
+					}
+				}
+				result.Status = v1pb.ImportShortcutsResponse_UPDATED
+				response.UpdatedCount++
+				continue
+			case v1pb.ImportShortcutsRequest_RENAME:
+				name := shortcut.Name
+				for suffix := 1; shortcutMap[name] != nil; suffix++ {
+					name = fmt.Sprintf("%s-%d", shortcut.Name, suffix)
+				}
+				shortcut.Name, result.Name = name, name
+			default:
+				result.Status = v1pb.ImportShortcutsResponse_SKIPPED
+				response.SkippedCount++
+				continue
+			}
+		}
 
-		if _, ok := deviceMap[deviceName]; !ok {
-			deviceMap[deviceName] = 0
+		if shortcutsLimit >= 0 && shortcutCount >= shortcutsLimit {
+			fail(errors.Errorf("Maximum number of shortcuts %d reached", shortcutsLimit))
+			continue
+		}
+		shortcutCreate := &storepb.Shortcut{
+			CreatorId:    user.ID,
+			Name:         shortcut.Name,
+			Link:         shortcut.Link,
+			Title:        shortcut.Title,
+			Tags:         shortcut.Tags,
+			Description:  shortcut.Description,
+			Visibility:   convertVisibilityToStorepb(shortcut.Visibility),
+			OgMetadata:   &storepb.OpenGraphMetadata{},
+			LinkTemplate: shortcut.LinkTemplate,
 		}
-		deviceMap[deviceName]++
+		if shortcutCreate.Visibility == storepb.Visibility_VISIBILITY_UNSPECIFIED {
+			shortcutCreate.Visibility = defaultVisibility
+		}
+		if !request.DryRun {
+			createdShortcut, err := s.Store.CreateShortcut(ctx, shortcutCreate)
+			if err != nil {
+				fail(errors.Wrap(err, "failed to create shortcut"))
+				continue
+			}
+			// The shortcut is created anyway, so the row doesn't fail on the activity.
+			if err := s.createShortcutCreateActivity(ctx, createdShortcut); err != nil {
+				slog.Warn("failed to create shortcut activity", slog.String("name", createdShortcut.Name), slog.String("error", err.Error()))
+			}
+			if result.Shortcut, err = s.convertShortcutFromStorepb(ctx, createdShortcut); err != nil {
+				slog.Warn("failed to convert shortcut", slog.String("name", createdShortcut.Name), slog.String("error", err.Error()))
+			}
+			shortcutCreate = createdShortcut
+		}
+		shortcutMap[shortcutCreate.Name] = shortcutCreate
+		shortcutCount++
+		result.Status = v1pb.ImportShortcutsResponse_CREATED
+		response.CreatedCount++
+	}
+	return response, nil
+}
 
-		if _, ok := browserMap[browserName]; !ok {
-			browserMap[browserName] = 0
+func (s *APIV1Service) ListShortcutRevisions(ctx context.Context, request *v1pb.ListShortcutRevisionsRequest) (*v1pb.ListShortcutRevisionsResponse, error) {
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	visible, err := s.isVisibleToUser(ctx, user, store.PermissionShortcutsViewPrivate, shortcut.Visibility, shortcut.CreatorId, shortcut.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+
+	revisions, err := s.Store.ListShortcutRevisions(ctx, &store.FindShortcutRevision{
+		ShortcutID: &shortcut.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut revisions, err: %v", err)
+	}
+	response := &v1pb.ListShortcutRevisionsResponse{
+		Revisions: []*v1pb.ShortcutRevision{},
+	}
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, convertShortcutRevisionFromStorepb(revision))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RestoreShortcutRevision(ctx context.Context, request *v1pb.RestoreShortcutRevisionRequest) (*v1pb.Shortcut, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	editable, err := s.canEdit(ctx, user, store.PermissionShortcutsEditAll, store.PermissionShortcutsViewPrivate, shortcut.Visibility, shortcut.CreatorId, shortcut.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut permission: %v", err)
+	}
+	if !editable {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	if shortcut.RowStatus == storepb.RowStatus_ARCHIVED {
+		return nil, status.Errorf(codes.FailedPrecondition, "shortcut is in the trash, restore it first")
+	}
+	revision, err := s.Store.GetShortcutRevision(ctx, &store.FindShortcutRevision{
+		ID:         &request.RevisionId,
+		ShortcutID: &shortcut.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut revision, err: %v", err)
+	}
+	if revision == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut revision not found")
+	}
+
+	restored := revision.OldValue
+	if restored.GroupId != shortcut.GroupId {
+		if err := s.validateOwnerGroup(ctx, user, restored.GroupId); err != nil {
+			return nil, err
 		}
-		browserMap[browserName]++
+	}
+	if restored.Name != shortcut.Name || restored.NamespaceId != shortcut.NamespaceId {
+		existingShortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			Name:        &restored.Name,
+			NamespaceID: &restored.NamespaceId,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+		}
+		if existingShortcut != nil {
+			return nil, status.Errorf(codes.AlreadyExists, "shortcut %q already exists", restored.Name)
+		}
+	}
+	tag := strings.Join(restored.Tags, " ")
+	ogMetadata := restored.OgMetadata
+	if ogMetadata == nil {
+		ogMetadata = &storepb.OpenGraphMetadata{}
+	}
+	updatedShortcut, err := s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:                shortcut.Id,
+		Name:              &restored.Name,
+		Link:              &restored.Link,
+		Title:             &restored.Title,
+		Description:       &restored.Description,
+		Visibility:        &restored.Visibility,
+		Tag:               &tag,
+		OpenGraphMetadata: ogMetadata,
+		LinkTemplate:      &restored.LinkTemplate,
+		NamespaceID:       &restored.NamespaceId,
+		GroupID:           &restored.GroupId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update shortcut, err: %v", err)
+	}
+	if err := s.createShortcutRevision(ctx, user.ID, shortcut, updatedShortcut); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create shortcut revision, err: %v", err)
+	}
+
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, updatedShortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	return composedShortcut, nil
+}
+
+func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.GetShortcutAnalyticsRequest) (*v1pb.GetShortcutAnalyticsResponse, error) {
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	visible, err := s.isVisibleToUser(ctx, user, store.PermissionShortcutsViewPrivate, shortcut.Visibility, shortcut.CreatorId, shortcut.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+
+	analyticsFind, err := s.buildShortcutViewAnalyticsFind(request.StartTime, request.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	analyticsFind.ShortcutID = &shortcut.Id
+	timeline, err := s.getShortcutViewTimeline(ctx, analyticsFind, request.Granularity)
+	if err != nil {
+		return nil, err
+	}
+	references, devices, browsers, err := s.getShortcutViewDimensions(ctx, analyticsFind, int(request.TopN))
+	if err != nil {
+		return nil, err
 	}
 
 	response := &v1pb.GetShortcutAnalyticsResponse{
-		References: mapToAnalyticsSlice(referenceMap),
-		Devices:    mapToAnalyticsSlice(deviceMap),
-		Browsers:   mapToAnalyticsSlice(browserMap),
+		References: references,
+		Devices:    devices,
+		Browsers:   browsers,
+		Timeline:   timeline,
 	}
 	return response, nil
 }
 
-func mapToAnalyticsSlice(m map[string]int32) []*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem {
+const (
+	// defaultAnalyticsDays is the default time range of the analytics, and the maximum one without advanced analytics.
+	defaultAnalyticsDays = 14
+	// maxAnalyticsTimeBuckets is the maximum number of the buckets in the timeline.
+	maxAnalyticsTimeBuckets = 10000
+)
+
+// buildShortcutViewAnalyticsFind returns the find of the shortcut views in the requested time range.
+func (s *APIV1Service) buildShortcutViewAnalyticsFind(startTime, endTime *timestamppb.Timestamp) (*store.FindShortcutViewAnalytics, error) {
+	end := time.Now()
+	if endTime != nil {
+		end = endTime.AsTime()
+	}
+	start := end.AddDate(0, 0, -defaultAnalyticsDays)
+	if startTime != nil {
+		start = startTime.AsTime()
+	}
+	if !start.Before(end) {
+		return nil, status.Errorf(codes.InvalidArgument, "start time must be before end time")
+	}
+	// For non-advanced analytics users, we limit the activity to the last 14 days.
+	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeAdvancedAnalytics) {
+		if earliest := time.Now().AddDate(0, 0, -defaultAnalyticsDays); start.Before(earliest) {
+			start = earliest
+		}
+	}
+	startTs, endTs := start.Unix(), end.Unix()
+	return &store.FindShortcutViewAnalytics{
+		CreatedTsStart: &startTs,
+		CreatedTsEnd:   &endTs,
+	}, nil
+}
+
+// getShortcutViewTimeline returns the view counts of every bucket in the time range, including the empty ones.
+func (s *APIV1Service) getShortcutViewTimeline(ctx context.Context, find *store.FindShortcutViewAnalytics, granularity v1pb.AnalyticsGranularity) ([]*v1pb.GetShortcutAnalyticsResponse_TimeBucket, error) {
+	bucketSize, bucketOffset := getAnalyticsBucketSize(granularity)
+	startTs, endTs := *find.CreatedTsStart, *find.CreatedTsEnd
+	if endTs <= startTs {
+		return []*v1pb.GetShortcutAnalyticsResponse_TimeBucket{}, nil
+	}
+	firstBucketTs := startTs - (startTs-bucketOffset)%bucketSize
+	if (endTs-firstBucketTs)/bucketSize >= maxAnalyticsTimeBuckets {
+		return nil, status.Errorf(codes.InvalidArgument, "too many time buckets, use a larger granularity or a shorter time range")
+	}
+
+	buckets, err := s.Store.ListShortcutViewTimeBuckets(ctx, find, bucketSize, bucketOffset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcut view time buckets, err: %v", err)
+	}
+	bucketMap := make(map[int64]*store.ShortcutViewTimeBucket)
+	for _, bucket := range buckets {
+		bucketMap[bucket.BucketTs] = bucket
+	}
+	timeline := []*v1pb.GetShortcutAnalyticsResponse_TimeBucket{}
+	for bucketTs := firstBucketTs; bucketTs < endTs; bucketTs += bucketSize {
+		timeBucket := &v1pb.GetShortcutAnalyticsResponse_TimeBucket{
+			StartTime: timestamppb.New(time.Unix(bucketTs, 0)),
+		}
+		if bucket, ok := bucketMap[bucketTs]; ok {
+			timeBucket.ViewCount = bucket.ViewCount
+			timeBucket.UniqueVisitorCount = bucket.UniqueVisitorCount
+		}
+		timeline = append(timeline, timeBucket)
+	}
+	return timeline, nil
+}
+
+// getAnalyticsBucketSize returns the size and the offset of the UTC time buckets in seconds.
+func getAnalyticsBucketSize(granularity v1pb.AnalyticsGranularity) (int64, int64) {
+	switch granularity {
+	case v1pb.AnalyticsGranularity_HOUR:
+		return 60 * 60, 0
+	case v1pb.AnalyticsGranularity_WEEK:
+		// The Unix epoch is a Thursday, so the weeks starting on Monday are shifted by 4 days.
+		return 7 * 24 * 60 * 60, 4 * 24 * 60 * 60
+	default:
+		return 24 * 60 * 60, 0
+	}
+}
+
+// getShortcutViewDimensions returns the top references, devices and browsers of the shortcut views.
+// The devices and browsers are parsed from the distinct user agents.
+func (s *APIV1Service) getShortcutViewDimensions(ctx context.Context, find *store.FindShortcutViewAnalytics, topN int) ([]*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem, []*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem, []*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem, error) {
+	referers, err := s.Store.CountShortcutViewsByDimension(ctx, find, store.ShortcutViewDimensionReferer)
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to count shortcut views by referer, err: %v", err)
+	}
+	referenceMap := make(map[string]int32)
+	for _, referer := range referers {
+		referenceMap[referer.Value] += referer.Count
+	}
+
+	userAgents, err := s.Store.CountShortcutViewsByDimension(ctx, find, store.ShortcutViewDimensionUserAgent)
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to count shortcut views by user agent, err: %v", err)
+	}
+	deviceMap := make(map[string]int32)
+	browserMap := make(map[string]int32)
+	for _, userAgent := range userAgents {
+		ua := useragent.New(userAgent.Value)
+		deviceName := ua.OSInfo().Name
+		browserName, _ := ua.Browser()
+		deviceMap[deviceName] += userAgent.Count
+		browserMap[browserName] += userAgent.Count
+	}
+	return mapToAnalyticsSlice(referenceMap, topN), mapToAnalyticsSlice(deviceMap, topN), mapToAnalyticsSlice(browserMap, topN), nil
+}
+
+// mapToAnalyticsSlice returns the analytics items in descending order of the count, limited to topN if positive.
+func mapToAnalyticsSlice(m map[string]int32, topN int) []*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem {
 	analyticsSlice := make([]*v1pb.GetShortcutAnalyticsResponse_AnalyticsItem, 0)
 	for key, value := range m {
 		analyticsSlice = append(analyticsSlice, &v1pb.GetShortcutAnalyticsResponse_AnalyticsItem{
@@ -318,8 +971,14 @@ func mapToAnalyticsSlice(m map[string]int32) []*v1pb.GetShortcutAnalyticsRespons
 		})
 	}
 	slices.SortFunc(analyticsSlice, func(i, j *v1pb.GetShortcutAnalyticsResponse_AnalyticsItem) int {
-		return int(i.Count - j.Count)
+		if i.Count != j.Count {
+			return int(j.Count - i.Count)
+		}
+		return strings.Compare(i.Name, j.Name)
 	})
+	if topN > 0 && len(analyticsSlice) > topN {
+		analyticsSlice = analyticsSlice[:topN]
+	}
 	return analyticsSlice
 }
 
@@ -344,12 +1003,108 @@ func (s *APIV1Service) createShortcutCreateActivity(ctx context.Context, shortcu
 	return nil
 }
 
+// createShortcutRevision records the change of the shortcut, unless nothing is changed.
+func (s *APIV1Service) createShortcutRevision(ctx context.Context, creatorID int32, oldShortcut, newShortcut *storepb.Shortcut) error {
+	if len(getShortcutChangedFields(oldShortcut, newShortcut)) == 0 {
+		return nil
+	}
+	if _, err := s.Store.CreateShortcutRevision(ctx, &storepb.ShortcutRevision{
+		ShortcutId: newShortcut.Id,
+		CreatorId:  creatorID,
+		OldValue:   oldShortcut,
+		NewValue:   newShortcut,
+	}); err != nil {
+		return errors.Wrap(err, "Failed to create shortcut revision")
+	}
+	return nil
+}
+
+// getShortcutChangedFields returns the fields of the shortcut which are different between the values.
+func getShortcutChangedFields(oldShortcut, newShortcut *storepb.Shortcut) []string {
+	changedFields := []string{}
+	if oldShortcut.Name != newShortcut.Name {
+		changedFields = append(changedFields, "name")
+	}
+	if oldShortcut.Link != newShortcut.Link {
+		changedFields = append(changedFields, "link")
+	}
+	if oldShortcut.Title != newShortcut.Title {
+		changedFields = append(changedFields, "title")
+	}
+	if oldShortcut.Description != newShortcut.Description {
+		changedFields = append(changedFields, "description")
+	}
+	if !slices.Equal(oldShortcut.Tags, newShortcut.Tags) {
+		changedFields = append(changedFields, "tags")
+	}
+	if oldShortcut.Visibility != newShortcut.Visibility {
+		changedFields = append(changedFields, "visibility")
+	}
+	if !proto.Equal(oldShortcut.OgMetadata, newShortcut.OgMetadata) {
+		changedFields = append(changedFields, "og_metadata")
+	}
+	if oldShortcut.LinkTemplate != newShortcut.LinkTemplate {
+		changedFields = append(changedFields, "link_template")
+	}
+	if oldShortcut.NamespaceId != newShortcut.NamespaceId {
+		changedFields = append(changedFields, "personal")
+	}
+	if oldShortcut.GroupId != newShortcut.GroupId {
+		changedFields = append(changedFields, "group_id")
+	}
+	return changedFields
+}
+
+func convertShortcutRevisionFromStorepb(revision *storepb.ShortcutRevision) *v1pb.ShortcutRevision {
+	return &v1pb.ShortcutRevision{
+		Id:            revision.Id,
+		ShortcutId:    revision.ShortcutId,
+		CreatorId:     revision.CreatorId,
+		CreatedTime:   timestamppb.New(time.Unix(revision.CreatedTs, 0)),
+		OldValue:      convertShortcutValueFromStorepb(revision.OldValue),
+		NewValue:      convertShortcutValueFromStorepb(revision.NewValue),
+		ChangedFields: getShortcutChangedFields(revision.OldValue, revision.NewValue),
+	}
+}
+
 func (s *APIV1Service) convertShortcutFromStorepb(ctx context.Context, shortcut *storepb.Shortcut) (*v1pb.Shortcut, error) {
-	composedShortcut := &v1pb.Shortcut{
+	composedShortcuts, err := s.convertShortcutsFromStorepb(ctx, []*storepb.Shortcut{shortcut})
+	if err != nil {
+		return nil, err
+	}
+	return composedShortcuts[0], nil
+}
+
+// convertShortcutsFromStorepb converts the shortcuts with their view counts loaded in a single query.
+func (s *APIV1Service) convertShortcutsFromStorepb(ctx context.Context, shortcuts []*storepb.Shortcut) ([]*v1pb.Shortcut, error) {
+	shortcutIDList := []int32{}
+	for _, shortcut := range shortcuts {
+		shortcutIDList = append(shortcutIDList, shortcut.Id)
+	}
+	viewCounts, err := s.Store.CountShortcutViews(ctx, &store.FindShortcutViewStat{
+		ShortcutIDList: shortcutIDList,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to count shortcut views")
+	}
+
+	composedShortcuts := []*v1pb.Shortcut{}
+	for _, shortcut := range shortcuts {
+		composedShortcut := convertShortcutValueFromStorepb(shortcut)
+		composedShortcut.ViewCount = viewCounts[shortcut.Id]
+		composedShortcuts = append(composedShortcuts, composedShortcut)
+	}
+	return composedShortcuts, nil
+}
+
+// convertShortcutValueFromStorepb converts the values of the shortcut, without the view count.
+func convertShortcutValueFromStorepb(shortcut *storepb.Shortcut) *v1pb.Shortcut {
+	return &v1pb.Shortcut{
 		Id:          shortcut.Id,
 		CreatorId:   shortcut.CreatorId,
 		CreatedTime: timestamppb.New(time.Unix(shortcut.CreatedTs, 0)),
 		UpdatedTime: timestamppb.New(time.Unix(shortcut.UpdatedTs, 0)),
+		State:       convertStateFromRowStatus(shortcut.RowStatus),
 		Name:        shortcut.Name,
 		Link:        shortcut.Link,
 		Title:       shortcut.Title,
@@ -357,21 +1112,12 @@ func (s *APIV1Service) convertShortcutFromStorepb(ctx context.Context, shortcut
 		Description: shortcut.Description,
 		Visibility:  convertVisibilityFromStorepb(shortcut.Visibility),
 		OgMetadata: &v1pb.Shortcut_OpenGraphMetadata{
-			Title:       shortcut.OgMetadata.Title,
-			Description: shortcut.OgMetadata.Description,
-			Image:       shortcut.OgMetadata.Image,
+			Title:       shortcut.OgMetadata.GetTitle(),
+			Description: shortcut.OgMetadata.GetDescription(),
+			Image:       shortcut.OgMetadata.GetImage(),
 		},
+		LinkTemplate: shortcut.LinkTemplate,
+		Personal:     shortcut.NamespaceId != store.WorkspaceNamespaceID,
+		GroupId:      shortcut.GroupId,
 	}
-
-	activityList, err := s.Store.ListActivities(ctx, &store.FindActivity{
-		Type:              store.ActivityShortcutView,
-		Level:             store.ActivityInfo,
-		PayloadShortcutID: &composedShortcut.Id,
-	})
-	if err != nil {
-		return nil, errors.Wrap(err, "Failed to list activities")
-	}
-	composedShortcut.ViewCount = int32(len(activityList))
-
-	return composedShortcut, nil
 }
@@ -57,10 +57,21 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 		return nil, err
 	}
 
+	role := store.RoleUser
+	if request.User.Role != v1pb.Role_ROLE_UNSPECIFIED {
+		currentUser, err := getCurrentUser(ctx, s.Store)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		}
+		if role, err = s.validateGrantedRole(currentUser, request.User.Role); err != nil {
+			return nil, err
+		}
+	}
+
 	user, err := s.Store.CreateUser(ctx, &store.User{
 		Email:        request.User.Email,
 		Nickname:     request.User.Nickname,
-		Role:         store.RoleUser,
+		Role:         role,
 		PasswordHash: string(passwordHash),
 	})
 	if err != nil {
@@ -74,12 +85,24 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user.ID != request.User.Id {
-		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
-	}
 	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "UpdateMask is empty")
 	}
+	// Users can only update themselves, except for the role which is only updated by the user managers.
+	if slices.Contains(request.UpdateMask.Paths, "role") {
+		canManageUsers, err := s.Store.HasPermission(ctx, user, store.PermissionUsersManage)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+		}
+		if !canManageUsers || len(request.UpdateMask.Paths) != 1 {
+			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+		}
+		if user.ID == request.User.Id {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot update your own role")
+		}
+	} else if user.ID != request.User.Id {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
 
 	userUpdate := &store.UpdateUser{
 		ID: request.User.Id,
@@ -89,6 +112,31 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 			userUpdate.Email = &request.User.Email
 		} else if path == "nickname" {
 			userUpdate.Nickname = &request.User.Nickname
+		} else if path == "role" {
+			targetUser, err := s.Store.GetUser(ctx, &store.FindUser{
+				ID: &request.User.Id,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to find user: %v", err)
+			}
+			if targetUser == nil {
+				return nil, status.Errorf(codes.NotFound, "user not found")
+			}
+			// Only the users managing the workspace can demote the admins.
+			if targetUser.Role == store.RoleAdmin {
+				canManageWorkspace, err := s.Store.HasPermission(ctx, user, store.PermissionWorkspaceManage)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+				}
+				if !canManageWorkspace {
+					return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+				}
+			}
+			role, err := s.validateGrantedRole(user, request.User.Role)
+			if err != nil {
+				return nil, err
+			}
+			userUpdate.Role = &role
 		}
 	}
 	user, err = s.Store.UpdateUser(ctx, userUpdate)
@@ -98,6 +146,18 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 	return convertUserFromStore(user), nil
 }
 
+// validateGrantedRole returns the store role granted by the user, who must be an admin to grant the admin role.
+func (*APIV1Service) validateGrantedRole(user *store.User, role v1pb.Role) (store.Role, error) {
+	storeRole, err := convertUserRoleToStore(role)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid role: %v", err)
+	}
+	if storeRole == store.RoleAdmin && user.Role != store.RoleAdmin {
+		return "", status.Errorf(codes.PermissionDenied, "only admins can grant the admin role")
+	}
+	return storeRole, nil
+}
+
 func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserRequest) (*emptypb.Empty, error) {
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
@@ -106,13 +166,160 @@ func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserR
 	if user.ID == request.Id {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot delete yourself")
 	}
+	canManageWorkspace, err := s.Store.HasPermission(ctx, user, store.PermissionWorkspaceManage)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if !canManageWorkspace {
+		// Only the users managing the workspace can delete the admins.
+		targetUser, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &request.Id,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to find user: %v", err)
+		}
+		if targetUser != nil && targetUser.Role == store.RoleAdmin {
+			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+		}
+	}
 
-	if err := s.Store.DeleteUser(ctx, &store.DeleteUser{ID: request.Id}); err != nil {
+	// The transfer or the check runs in the transaction of the deletion, so that no shortcut or collection
+	// created meanwhile is deleted along with the user.
+	if err := s.Store.RunInTx(ctx, func(txStore *store.Store) error {
+		if request.TransferToUserId != 0 || request.TransferToGroupId != 0 {
+			if _, err := transferOwnership(ctx, txStore, user, request.Id, request.TransferToUserId, request.TransferToGroupId); err != nil {
+				return err
+			}
+		} else {
+			// Deleting the user would delete the shortcuts and collections, which may be used by the whole workspace.
+			shortcuts, err := txStore.ListShortcuts(ctx, &store.FindShortcut{
+				CreatorID: &request.Id,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to list shortcuts: %v", err)
+			}
+			collections, err := txStore.ListCollections(ctx, &store.FindCollection{
+				CreatorID: &request.Id,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to list collections: %v", err)
+			}
+			if len(shortcuts) != 0 || len(collections) != 0 {
+				return status.Errorf(codes.FailedPrecondition, "user owns %d shortcuts and %d collections, transfer them to another user or group first", len(shortcuts), len(collections))
+			}
+		}
+		if err := txStore.DeleteUser(ctx, &store.DeleteUser{ID: request.Id}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete user: %v", err)
+		}
+		return nil
+	}); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
+func (s *APIV1Service) TransferOwnership(ctx context.Context, request *v1pb.TransferOwnershipRequest) (*v1pb.TransferOwnershipResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	var result *store.TransferOwnershipResult
+	if err := s.Store.RunInTx(ctx, func(txStore *store.Store) error {
+		result, err = transferOwnership(ctx, txStore, user, request.Id, request.TransferToUserId, request.TransferToGroupId)
+		return err
+	}); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to transfer ownership: %v", err)
+	}
+	return &v1pb.TransferOwnershipResponse{
+		TransferredShortcutCount:   int32(result.ShortcutCount),
+		TransferredCollectionCount: int32(result.CollectionCount),
+	}, nil
+}
+
+// transferOwnership transfers the shortcuts and collections of the user to either the target user or group.
+// The caller becomes the creator of the ones transferred to the group. The store must be in a transaction,
+// so that no personal shortcut conflicting with the target is created before the transfer.
+func transferOwnership(ctx context.Context, txStore *store.Store, caller *store.User, userID, toUserID, toGroupID int32) (*store.TransferOwnershipResult, error) {
+	if (toUserID == 0) == (toGroupID == 0) {
+		return nil, status.Errorf(codes.InvalidArgument, "exactly one of the target user and group is required")
+	}
+	user, err := txStore.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	transfer := &store.TransferOwnership{
+		FromUserID: user.ID,
+	}
+	if toUserID != 0 {
+		if toUserID == user.ID {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot transfer to the same user")
+		}
+		toUser, err := txStore.GetUser(ctx, &store.FindUser{
+			ID: &toUserID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to find target user: %v", err)
+		}
+		if toUser == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "target user %d not found", toUserID)
+		}
+		if toUser.RowStatus != storepb.RowStatus_NORMAL {
+			return nil, status.Errorf(codes.FailedPrecondition, "target user %d is archived", toUserID)
+		}
+		transfer.ToUserID, transfer.ToNamespaceID = toUser.ID, toUser.ID
+	} else {
+		group, err := txStore.GetGroup(ctx, &store.FindGroup{
+			ID: &toGroupID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get target group: %v", err)
+		}
+		if group == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "target group %d not found", toGroupID)
+		}
+		transfer.ToUserID, transfer.ToGroupID, transfer.ToNamespaceID = caller.ID, group.ID, store.WorkspaceNamespaceID
+	}
+
+	// The names of the personal shortcuts must be available in the namespace they are moved into.
+	personalShortcuts, err := txStore.ListShortcuts(ctx, &store.FindShortcut{
+		NamespaceID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list personal shortcuts: %v", err)
+	}
+	for _, shortcut := range personalShortcuts {
+		existingShortcut, err := txStore.GetShortcut(ctx, &store.FindShortcut{
+			Name:        &shortcut.Name,
+			NamespaceID: &transfer.ToNamespaceID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+		}
+		if existingShortcut != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "personal shortcut %q conflicts with an existing shortcut of the target", shortcut.Name)
+		}
+	}
+
+	result, err := txStore.TransferOwnership(ctx, transfer)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to transfer ownership: %v", err)
+	}
+	return result, nil
+}
+
 func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.ListUserAccessTokensRequest) (*v1pb.ListUserAccessTokensResponse, error) {
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
@@ -290,7 +497,67 @@ func convertUserRoleFromStore(role store.Role) v1pb.Role {
 		return v1pb.Role_ADMIN
 	case store.RoleUser:
 		return v1pb.Role_USER
+	case store.RoleEditor:
+		return v1pb.Role_EDITOR
+	case store.RoleViewer:
+		return v1pb.Role_VIEWER
+	case store.RoleAnalyst:
+		return v1pb.Role_ANALYST
 	default:
 		return v1pb.Role_ROLE_UNSPECIFIED
 	}
 }
+
+func convertUserRoleToStore(role v1pb.Role) (store.Role, error) {
+	switch role {
+	case v1pb.Role_ADMIN:
+		return store.RoleAdmin, nil
+	case v1pb.Role_USER:
+		return store.RoleUser, nil
+	case v1pb.Role_EDITOR:
+		return store.RoleEditor, nil
+	case v1pb.Role_VIEWER:
+		return store.RoleViewer, nil
+	case v1pb.Role_ANALYST:
+		return store.RoleAnalyst, nil
+	default:
+		return "", errors.Errorf("unsupported role %s", role)
+	}
+}
+
+// convertRolePermissionsToStore validates the role permissions, which can neither be granted to the admin role
+// nor list unknown or admin only permissions.
+func convertRolePermissionsToStore(rolePermissions []*v1pb.RolePermission) (*storepb.WorkspaceSetting_RolePermissionSetting, error) {
+	rolePermissionSetting := &storepb.WorkspaceSetting_RolePermissionSetting{}
+	roles := map[store.Role]bool{}
+	for _, rolePermission := range rolePermissions {
+		role, err := convertUserRoleToStore(rolePermission.Role)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(store.ConfigurableRoles, role) {
+			return nil, errors.Errorf("permissions of role %s cannot be configured", role)
+		}
+		if roles[role] {
+			return nil, errors.Errorf("duplicate role %s", role)
+		}
+		roles[role] = true
+		permissions := []string{}
+		for _, permission := range rolePermission.Permissions {
+			if !slices.Contains(store.AllPermissions, store.Permission(permission)) {
+				return nil, errors.Errorf("unknown permission %q", permission)
+			}
+			if slices.Contains(store.AdminOnlyPermissions, store.Permission(permission)) {
+				return nil, errors.Errorf("permission %q can only be granted to the admin role", permission)
+			}
+			if !slices.Contains(permissions, permission) {
+				permissions = append(permissions, permission)
+			}
+		}
+		rolePermissionSetting.RolePermissions = append(rolePermissionSetting.RolePermissions, &storepb.WorkspaceSetting_RolePermissionSetting_RolePermission{
+			Role:        string(role),
+			Permissions: permissions,
+		})
+	}
+	return rolePermissionSetting, nil
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -14,6 +15,7 @@ import (
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	"github.com/yourselfhosted/slash/server/profile"
 	"github.com/yourselfhosted/slash/server/service/license"
+	"github.com/yourselfhosted/slash/server/service/recorder"
 	"github.com/yourselfhosted/slash/store"
 )
 
@@ -25,31 +27,38 @@ type APIV1Service struct {
 	v1pb.UnimplementedUserSettingServiceServer
 	v1pb.UnimplementedShortcutServiceServer
 	v1pb.UnimplementedCollectionServiceServer
+	v1pb.UnimplementedGroupServiceServer
 
 	Secret         string
 	Profile        *profile.Profile
 	Store          *store.Store
 	LicenseService *license.LicenseService
+	// ActivityRecorder records the shortcut views in batches.
+	ActivityRecorder *recorder.Recorder
 
 	grpcServer     *grpc.Server
 	grpcServerPort int
+	// ssoAuthRequests are the pending SSO sign ins by state.
+	ssoAuthRequests sync.Map
 }
 
-func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, licenseService *license.LicenseService, grpcServerPort int) *APIV1Service {
+func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, licenseService *license.LicenseService, activityRecorder *recorder.Recorder, grpcServerPort int) *APIV1Service {
 	authProvider := NewGRPCAuthInterceptor(store, secret)
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			NewLoggerInterceptor().LoggerInterceptor,
 			authProvider.AuthenticationInterceptor,
+			NewAuditInterceptor(store).AuditInterceptor,
 		),
 	)
 	apiV1Service := &APIV1Service{
-		Secret:         secret,
-		Profile:        profile,
-		Store:          store,
-		LicenseService: licenseService,
-		grpcServer:     grpcServer,
-		grpcServerPort: grpcServerPort,
+		Secret:           secret,
+		Profile:          profile,
+		Store:            store,
+		LicenseService:   licenseService,
+		ActivityRecorder: activityRecorder,
+		grpcServer:       grpcServer,
+		grpcServerPort:   grpcServerPort,
 	}
 
 	v1pb.RegisterSubscriptionServiceServer(grpcServer, apiV1Service)
@@ -59,6 +68,7 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 	v1pb.RegisterUserSettingServiceServer(grpcServer, apiV1Service)
 	v1pb.RegisterShortcutServiceServer(grpcServer, apiV1Service)
 	v1pb.RegisterCollectionServiceServer(grpcServer, apiV1Service)
+	v1pb.RegisterGroupServiceServer(grpcServer, apiV1Service)
 	reflection.Register(grpcServer)
 
 	return apiV1Service
@@ -102,7 +112,11 @@ func (s *APIV1Service) RegisterGateway(_ context.Context, e *echo.Echo) error {
 	if err := v1pb.RegisterCollectionServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterGroupServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
 	e.Any("/api/v1/*", echo.WrapHandler(gwMux))
+	s.registerSAMLRoutes(e)
 
 	// GRPC web proxy.
 	options := []grpcweb.Option{
@@ -3,16 +3,30 @@ package v1
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/yourselfhosted/slash/internal/util"
 	v1pb "github.com/yourselfhosted/slash/proto/gen/api/v1"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/license"
 	"github.com/yourselfhosted/slash/store"
 )
 
+const (
+	// defaultAuditLogPageSize is the default page size of ListAuditLogs.
+	defaultAuditLogPageSize = 100
+	// maxAuditLogPageSize is the maximum page size of ListAuditLogs.
+	maxAuditLogPageSize = 1000
+)
+
 func (s *APIV1Service) GetWorkspaceProfile(ctx context.Context, _ *v1pb.GetWorkspaceProfileRequest) (*v1pb.WorkspaceProfile, error) {
 	workspaceProfile := &v1pb.WorkspaceProfile{
 		Mode:    s.Profile.Mode,
@@ -49,6 +63,12 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, _ *v1pb.GetWorks
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list workspace settings: %v", err)
 	}
+	canManageWorkspace := false
+	if currentUser != nil {
+		if canManageWorkspace, err = s.Store.HasPermission(ctx, currentUser, store.PermissionWorkspaceManage); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+		}
+	}
 	workspaceSetting := &v1pb.WorkspaceSetting{}
 	for _, v := range workspaceSettings {
 		if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GENERAL {
@@ -58,24 +78,59 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, _ *v1pb.GetWorks
 			securitySetting := v.GetSecurity()
 			workspaceSetting.DisallowUserRegistration = securitySetting.GetDisallowUserRegistration()
 			workspaceSetting.DisallowPasswordAuth = securitySetting.GetDisallowPasswordAuth()
+			if canManageWorkspace {
+				workspaceSetting.ScimEnabled = securitySetting.GetScimTokenHash() != ""
+			}
 		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED {
 			shortcutRelatedSetting := v.GetShortcutRelated()
 			workspaceSetting.DefaultVisibility = convertVisibilityFromStorepb(shortcutRelatedSetting.GetDefaultVisibility())
+			workspaceSetting.EnablePathForwarding = shortcutRelatedSetting.GetEnablePathForwarding()
+			workspaceSetting.QueryMergeStrategy = v1pb.QueryMergeStrategy(shortcutRelatedSetting.GetQueryMergeStrategy())
+			workspaceSetting.TrashRetentionDays = shortcutRelatedSetting.GetTrashRetentionDays()
+			workspaceSetting.ActivityRetentionDays = shortcutRelatedSetting.GetActivityRetentionDays()
 		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER {
 			identityProviderSetting := v.GetIdentityProvider()
 			workspaceSetting.IdentityProviders = []*v1pb.IdentityProvider{}
 			for _, identityProvider := range identityProviderSetting.GetIdentityProviders() {
 				identityProviderV1pb := convertIdentityProviderFromStore(identityProvider)
-				if currentUser == nil || currentUser.Role != store.RoleAdmin {
+				if !canManageWorkspace {
 					oauth2Config := identityProviderV1pb.Config.GetOauth2()
 					if oauth2Config != nil {
 						oauth2Config.ClientSecret = ""
 					}
+					oidcConfig := identityProviderV1pb.Config.GetOidc()
+					if oidcConfig != nil {
+						oidcConfig.ClientSecret = ""
+					}
+					ldapConfig := identityProviderV1pb.Config.GetLdap()
+					if ldapConfig != nil {
+						ldapConfig.BindPassword = ""
+					}
+					// The provisioning rules reveal the groups of the organization.
+					identityProviderV1pb.RoleMappingRules = nil
+					identityProviderV1pb.AllowedEmailDomains = nil
 				}
 				workspaceSetting.IdentityProviders = append(workspaceSetting.IdentityProviders, identityProviderV1pb)
 			}
 		}
 	}
+	if currentUser != nil {
+		// The effective permissions of the roles, i.e. the configured or the default ones.
+		for _, role := range store.ConfigurableRoles {
+			permissions, err := s.Store.GetRolePermissions(ctx, role)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get role permissions: %v", err)
+			}
+			rolePermission := &v1pb.RolePermission{
+				Role:        convertUserRoleFromStore(role),
+				Permissions: []string{},
+			}
+			for _, permission := range permissions {
+				rolePermission.Permissions = append(rolePermission.Permissions, string(permission))
+			}
+			workspaceSetting.RolePermissions = append(workspaceSetting.RolePermissions, rolePermission)
+		}
+	}
 	return workspaceSetting, nil
 }
 
@@ -99,34 +154,49 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
-		} else if path == "default_visibility" {
-			shortcutRelatedSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
-				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
-			})
+		} else if path == "default_visibility" || path == "enable_path_forwarding" || path == "query_merge_strategy" || path == "trash_retention_days" || path == "activity_retention_days" {
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
 			}
-			if shortcutRelatedSetting == nil {
-				shortcutRelatedSetting = &storepb.WorkspaceSetting{
-					Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
-					Value: &storepb.WorkspaceSetting_ShortcutRelated{
-						ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{},
-					},
+			switch path {
+			case "default_visibility":
+				shortcutRelatedSetting.DefaultVisibility = convertVisibilityToStorepb(request.Setting.DefaultVisibility)
+			case "enable_path_forwarding":
+				shortcutRelatedSetting.EnablePathForwarding = request.Setting.EnablePathForwarding
+			case "query_merge_strategy":
+				shortcutRelatedSetting.QueryMergeStrategy = storepb.QueryMergeStrategy(request.Setting.QueryMergeStrategy)
+			case "trash_retention_days":
+				if request.Setting.TrashRetentionDays < 0 {
+					return nil, status.Errorf(codes.InvalidArgument, "trash retention days must not be negative")
+				}
+				shortcutRelatedSetting.TrashRetentionDays = request.Setting.TrashRetentionDays
+			case "activity_retention_days":
+				if request.Setting.ActivityRetentionDays < 0 {
+					return nil, status.Errorf(codes.InvalidArgument, "activity retention days must not be negative")
 				}
+				shortcutRelatedSetting.ActivityRetentionDays = request.Setting.ActivityRetentionDays
 			}
-			shortcutRelatedSetting.GetShortcutRelated().DefaultVisibility = convertVisibilityToStorepb(request.Setting.DefaultVisibility)
 			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
 				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
 				Value: &storepb.WorkspaceSetting_ShortcutRelated{
-					ShortcutRelated: shortcutRelatedSetting.GetShortcutRelated(),
+					ShortcutRelated: shortcutRelatedSetting,
 				},
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
 		} else if path == "identity_providers" {
+			currentUser, err := getCurrentUser(ctx, s.Store)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+			}
 			identityProviderSetting := &storepb.WorkspaceSetting_IdentityProviderSetting{}
 			for _, identityProvider := range request.Setting.IdentityProviders {
-				identityProviderSetting.IdentityProviders = append(identityProviderSetting.IdentityProviders, convertIdentityProviderToStore(identityProvider))
+				identityProviderStore := convertIdentityProviderToStore(identityProvider)
+				if identityProviderStore.RoleMappingRules, err = s.convertRoleMappingRulesToStore(currentUser, identityProvider.RoleMappingRules); err != nil {
+					return nil, err
+				}
+				identityProviderSetting.IdentityProviders = append(identityProviderSetting.IdentityProviders, identityProviderStore)
 			}
 			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
 				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER,
@@ -164,6 +234,36 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
+		} else if path == "scim_enabled" {
+			if request.Setting.ScimEnabled {
+				return nil, status.Errorf(codes.InvalidArgument, "SCIM is enabled by rotating its token")
+			}
+			securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			securitySetting.ScimTokenHash = ""
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+				Value: &storepb.WorkspaceSetting_Security{
+					Security: securitySetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "role_permissions" {
+			rolePermissionSetting, err := convertRolePermissionsToStore(request.Setting.RolePermissions)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid role permissions: %v", err)
+			}
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ROLE_PERMISSION,
+				Value: &storepb.WorkspaceSetting_RolePermission{
+					RolePermission: rolePermissionSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid path: %s", path)
 		}
@@ -178,6 +278,283 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 
 var ownerCache *v1pb.User
 
+const (
+	defaultWorkspaceAnalyticsTopN = 10
+	defaultInactiveShortcutDays   = 30
+)
+
+func (s *APIV1Service) GetWorkspaceAnalytics(ctx context.Context, request *v1pb.GetWorkspaceAnalyticsRequest) (*v1pb.GetWorkspaceAnalyticsResponse, error) {
+	topN, inactiveDays := int(request.TopN), int(request.InactiveDays)
+	if topN < 0 || inactiveDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "top n and inactive days must not be negative")
+	}
+	if topN == 0 {
+		topN = defaultWorkspaceAnalyticsTopN
+	}
+	if inactiveDays == 0 {
+		inactiveDays = defaultInactiveShortcutDays
+	}
+
+	analyticsFind, err := s.buildShortcutViewAnalyticsFind(request.StartTime, request.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	dailyViews, err := s.getShortcutViewTimeline(ctx, analyticsFind, v1pb.AnalyticsGranularity_DAY)
+	if err != nil {
+		return nil, err
+	}
+	referers, err := s.Store.CountShortcutViewsByDimension(ctx, analyticsFind, store.ShortcutViewDimensionReferer)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count shortcut views by referer, err: %v", err)
+	}
+	referenceMap := make(map[string]int32)
+	for _, referer := range referers {
+		referenceMap[referer.Value] += referer.Count
+	}
+
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts, err: %v", err)
+	}
+	shortcutMap := make(map[int32]*storepb.Shortcut)
+	for _, shortcut := range shortcuts {
+		shortcutMap[shortcut.Id] = shortcut
+	}
+
+	viewCounts, err := s.countViewsByShortcut(ctx, analyticsFind)
+	if err != nil {
+		return nil, err
+	}
+	topShortcuts := []*v1pb.GetWorkspaceAnalyticsResponse_ShortcutViewCount{}
+	creatorViewCounts := make(map[int32]int32)
+	for _, viewCount := range viewCounts {
+		// The views of the deleted shortcuts are left in the activities.
+		shortcut, ok := shortcutMap[viewCount.ShortcutId]
+		if !ok {
+			continue
+		}
+		viewCount.ShortcutName = shortcut.Name
+		if len(topShortcuts) < topN {
+			topShortcuts = append(topShortcuts, viewCount)
+		}
+		creatorViewCounts[shortcut.CreatorId] += viewCount.ViewCount
+	}
+	topCreators := []*v1pb.GetWorkspaceAnalyticsResponse_CreatorViewCount{}
+	for creatorID, viewCount := range creatorViewCounts {
+		topCreators = append(topCreators, &v1pb.GetWorkspaceAnalyticsResponse_CreatorViewCount{
+			CreatorId: creatorID,
+			ViewCount: viewCount,
+		})
+	}
+	slices.SortFunc(topCreators, func(i, j *v1pb.GetWorkspaceAnalyticsResponse_CreatorViewCount) int {
+		if i.ViewCount != j.ViewCount {
+			return int(j.ViewCount - i.ViewCount)
+		}
+		return int(i.CreatorId - j.CreatorId)
+	})
+	if len(topCreators) > topN {
+		topCreators = topCreators[:topN]
+	}
+
+	// The shortcuts created within the inactive days are too new to be inactive.
+	inactiveSince := time.Now().AddDate(0, 0, -inactiveDays).Unix()
+	recentViewCounts, err := s.countViewsByShortcut(ctx, &store.FindShortcutViewAnalytics{
+		CreatedTsStart: &inactiveSince,
+	})
+	if err != nil {
+		return nil, err
+	}
+	recentlyViewed := make(map[int32]bool)
+	for _, viewCount := range recentViewCounts {
+		recentlyViewed[viewCount.ShortcutId] = true
+	}
+	inactiveShortcuts := []*v1pb.GetWorkspaceAnalyticsResponse_ShortcutViewCount{}
+	for _, shortcut := range shortcuts {
+		if shortcut.RowStatus == storepb.RowStatus_NORMAL && shortcut.CreatedTs < inactiveSince && !recentlyViewed[shortcut.Id] {
+			inactiveShortcuts = append(inactiveShortcuts, &v1pb.GetWorkspaceAnalyticsResponse_ShortcutViewCount{
+				ShortcutId:   shortcut.Id,
+				ShortcutName: shortcut.Name,
+			})
+		}
+	}
+	// The shortcuts are listed newest first, reverse them to put the oldest first.
+	slices.Reverse(inactiveShortcuts)
+
+	return &v1pb.GetWorkspaceAnalyticsResponse{
+		TopShortcuts:      topShortcuts,
+		TopCreators:       topCreators,
+		InactiveShortcuts: inactiveShortcuts,
+		DailyViews:        dailyViews,
+		TopReferers:       mapToAnalyticsSlice(referenceMap, topN),
+	}, nil
+}
+
+// countViewsByShortcut returns the view counts of the shortcuts in descending order.
+func (s *APIV1Service) countViewsByShortcut(ctx context.Context, find *store.FindShortcutViewAnalytics) ([]*v1pb.GetWorkspaceAnalyticsResponse_ShortcutViewCount, error) {
+	counts, err := s.Store.CountShortcutViewsByDimension(ctx, find, store.ShortcutViewDimensionShortcutID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count shortcut views by shortcut, err: %v", err)
+	}
+	viewCounts := []*v1pb.GetWorkspaceAnalyticsResponse_ShortcutViewCount{}
+	for _, count := range counts {
+		shortcutID, err := strconv.ParseInt(count.Value, 10, 32)
+		if err != nil {
+			continue
+		}
+		viewCounts = append(viewCounts, &v1pb.GetWorkspaceAnalyticsResponse_ShortcutViewCount{
+			ShortcutId: int32(shortcutID),
+			ViewCount:  count.Count,
+		})
+	}
+	return viewCounts, nil
+}
+
+func (s *APIV1Service) GetActivityRecorderStats(_ context.Context, _ *v1pb.GetActivityRecorderStatsRequest) (*v1pb.ActivityRecorderStats, error) {
+	stats := s.ActivityRecorder.Stats()
+	return &v1pb.ActivityRecorderStats{
+		Queued:      stats.Queued,
+		Dropped:     stats.Dropped,
+		Flushed:     stats.Flushed,
+		Failed:      stats.Failed,
+		QueueLength: int32(stats.QueueLength),
+	}, nil
+}
+
+// RotateSCIMToken generates the SCIM bearer token, which acts as an admin to provision the users and groups.
+// Hence only the admins can rotate it.
+func (s *APIV1Service) RotateSCIMToken(ctx context.Context, _ *v1pb.RotateSCIMTokenRequest) (*v1pb.RotateSCIMTokenResponse, error) {
+	if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeSSO) {
+		return nil, status.Errorf(codes.PermissionDenied, "SCIM is not available in the current plan")
+	}
+
+	token, err := util.RandomString(40)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+	securitySetting, err := s.Store.GetWorkspaceSecuritySetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+	securitySetting.ScimTokenHash = store.HashSCIMToken(token)
+	if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY,
+		Value: &storepb.WorkspaceSetting_Security{
+			Security: securitySetting,
+		},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+	}
+	return &v1pb.RotateSCIMTokenResponse{
+		Token: token,
+	}, nil
+}
+
+func (s *APIV1Service) ExportWorkspace(ctx context.Context, request *v1pb.ExportWorkspaceRequest) (*v1pb.ExportWorkspaceResponse, error) {
+	archive, err := s.Store.ExportWorkspace(ctx, &store.ExportWorkspace{
+		SlashVersion:      s.Profile.Version,
+		IncludeActivities: request.IncludeActivities,
+		ExcludeSecrets:    request.ExcludeSecrets,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export workspace, err: %v", err)
+	}
+	content, err := store.MarshalWorkspaceArchive(archive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal workspace archive, err: %v", err)
+	}
+	return &v1pb.ExportWorkspaceResponse{
+		Content:  content,
+		Filename: fmt.Sprintf("slash-%s.json", time.Unix(archive.CreatedTs, 0).UTC().Format("20060102-150405")),
+	}, nil
+}
+
+func (s *APIV1Service) ImportWorkspace(ctx context.Context, request *v1pb.ImportWorkspaceRequest) (*v1pb.ImportWorkspaceResponse, error) {
+	archive, err := store.UnmarshalWorkspaceArchive(request.Content)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid workspace archive: %v", err)
+	}
+	result, err := s.Store.ImportWorkspace(ctx, archive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to import workspace, err: %v", err)
+	}
+	return &v1pb.ImportWorkspaceResponse{
+		CreatedUsers:       int32(result.CreatedUsers),
+		CreatedShortcuts:   int32(result.CreatedShortcuts),
+		CreatedCollections: int32(result.CreatedCollections),
+		CreatedActivities:  int32(result.CreatedActivities),
+		SkippedUsers:       int32(result.SkippedUsers),
+		SkippedShortcuts:   int32(result.SkippedShortcuts),
+		SkippedCollections: int32(result.SkippedCollections),
+		CreatedGroups:      int32(result.CreatedGroups),
+		SkippedGroups:      int32(result.SkippedGroups),
+	}, nil
+}
+
+func (s *APIV1Service) ListAuditLogs(ctx context.Context, request *v1pb.ListAuditLogsRequest) (*v1pb.ListAuditLogsResponse, error) {
+	activityFind := &store.FindActivity{
+		TypeList:  store.AuditActivityTypes,
+		OrderDesc: true,
+	}
+	if err := parseAuditLogFilter(request.Filter, activityFind); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	if request.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page size must not be negative")
+	}
+
+	limit, offset := defaultAuditLogPageSize, 0
+	queryHash := hashPageQuery(request.Filter)
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		if pageToken.QueryHash != queryHash {
+			return nil, status.Errorf(codes.InvalidArgument, "page token doesn't match the filter")
+		}
+		offset = max(int(pageToken.Offset), 0)
+		if pageToken.Limit > 0 {
+			limit = min(int(pageToken.Limit), maxAuditLogPageSize)
+		}
+	}
+	if request.PageSize > 0 {
+		limit = min(int(request.PageSize), maxAuditLogPageSize)
+	}
+	// Fetch one more audit log to tell if there is a next page.
+	limitPlusOne := limit + 1
+	activityFind.Limit, activityFind.Offset = &limitPlusOne, &offset
+
+	activities, err := s.Store.ListActivities(ctx, activityFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit logs, err: %v", err)
+	}
+	nextPageToken := ""
+	if len(activities) > limit {
+		activities = activities[:limit]
+		nextPageToken, err = marshalPageToken(&v1pb.PageToken{
+			Limit:     int32(limit),
+			Offset:    int32(offset + limit),
+			QueryHash: queryHash,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal next page token, err: %v", err)
+		}
+	}
+
+	response := &v1pb.ListAuditLogsResponse{
+		AuditLogs:     []*v1pb.AuditLog{},
+		NextPageToken: nextPageToken,
+	}
+	for _, activity := range activities {
+		auditLog, err := convertAuditLogFromActivity(activity)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert audit log, err: %v", err)
+		}
+		response.AuditLogs = append(response.AuditLogs, auditLog)
+	}
+	return response, nil
+}
+
 func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error) {
 	if ownerCache != nil {
 		return ownerCache, nil
@@ -198,16 +575,45 @@ func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error)
 	return ownerCache, nil
 }
 
+func convertAuditLogFromActivity(activity *store.Activity) (*v1pb.AuditLog, error) {
+	payload := &storepb.ActivityAuditPayload{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal([]byte(activity.Payload), payload); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal audit payload")
+	}
+	return &v1pb.AuditLog{
+		Id:          activity.ID,
+		ActorId:     activity.CreatorID,
+		CreatedTime: timestamppb.New(time.Unix(activity.CreatedTs, 0)),
+		Type:        activity.Type.String(),
+		Method:      payload.Method,
+		Resource:    payload.Resource,
+		Request:     payload.Request,
+		Ip:          payload.Ip,
+		UserAgent:   payload.UserAgent,
+		Code:        payload.Code,
+	}, nil
+}
+
 func convertIdentityProviderFromStore(identityProvider *storepb.IdentityProvider) *v1pb.IdentityProvider {
 	if identityProvider == nil {
 		return nil
 	}
-	return &v1pb.IdentityProvider{
-		Id:     identityProvider.Id,
-		Title:  identityProvider.Title,
-		Type:   v1pb.IdentityProvider_Type(identityProvider.Type),
-		Config: convertIdentityProviderConfigFromStore(identityProvider.Config),
+	identityProviderV1pb := &v1pb.IdentityProvider{
+		Id:                  identityProvider.Id,
+		Title:               identityProvider.Title,
+		Type:                v1pb.IdentityProvider_Type(identityProvider.Type),
+		Config:              convertIdentityProviderConfigFromStore(identityProvider.Config),
+		AllowedEmailDomains: identityProvider.AllowedEmailDomains,
 	}
+	for _, rule := range identityProvider.RoleMappingRules {
+		identityProviderV1pb.RoleMappingRules = append(identityProviderV1pb.RoleMappingRules, &v1pb.IdentityProvider_RoleMappingRule{
+			Groups:       rule.Groups,
+			EmailDomains: rule.EmailDomains,
+			Role:         convertUserRoleFromStore(store.Role(rule.Role)),
+			Deny:         rule.Deny,
+		})
+	}
+	return identityProviderV1pb
 }
 
 func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.IdentityProviderConfig) *v1pb.IdentityProviderConfig {
@@ -225,11 +631,68 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 					FieldMapping: &v1pb.IdentityProviderConfig_FieldMapping{
 						Identifier:  oauth2Config.FieldMapping.Identifier,
 						DisplayName: oauth2Config.FieldMapping.DisplayName,
+						Groups:      oauth2Config.FieldMapping.Groups,
 					},
 				},
 			},
 		}
 	}
+	samlConfig := identityProviderConfig.GetSaml()
+	if samlConfig != nil {
+		return &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_Saml{
+				Saml: &v1pb.IdentityProviderConfig_SAMLConfig{
+					IdpMetadataXml: samlConfig.IdpMetadataXml,
+					IdpMetadataUrl: samlConfig.IdpMetadataUrl,
+					EntityId:       samlConfig.EntityId,
+					FieldMapping: &v1pb.IdentityProviderConfig_FieldMapping{
+						Identifier:  samlConfig.GetFieldMapping().GetIdentifier(),
+						DisplayName: samlConfig.GetFieldMapping().GetDisplayName(),
+						Groups:      samlConfig.GetFieldMapping().GetGroups(),
+					},
+				},
+			},
+		}
+	}
+	oidcConfig := identityProviderConfig.GetOidc()
+	if oidcConfig != nil {
+		return &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_Oidc{
+				Oidc: &v1pb.IdentityProviderConfig_OIDCConfig{
+					ClientId:     oidcConfig.ClientId,
+					ClientSecret: oidcConfig.ClientSecret,
+					IssuerUrl:    oidcConfig.IssuerUrl,
+					Scopes:       oidcConfig.Scopes,
+					FieldMapping: &v1pb.IdentityProviderConfig_FieldMapping{
+						Identifier:  oidcConfig.GetFieldMapping().GetIdentifier(),
+						DisplayName: oidcConfig.GetFieldMapping().GetDisplayName(),
+						Groups:      oidcConfig.GetFieldMapping().GetGroups(),
+					},
+					AllowMissingEmailVerified: oidcConfig.AllowMissingEmailVerified,
+				},
+			},
+		}
+	}
+	ldapConfig := identityProviderConfig.GetLdap()
+	if ldapConfig != nil {
+		return &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_Ldap{
+				Ldap: &v1pb.IdentityProviderConfig_LDAPConfig{
+					Url:          ldapConfig.Url,
+					BindDn:       ldapConfig.BindDn,
+					BindPassword: ldapConfig.BindPassword,
+					SearchBase:   ldapConfig.SearchBase,
+					UserFilter:   ldapConfig.UserFilter,
+					FieldMapping: &v1pb.IdentityProviderConfig_FieldMapping{
+						Identifier:  ldapConfig.GetFieldMapping().GetIdentifier(),
+						DisplayName: ldapConfig.GetFieldMapping().GetDisplayName(),
+						Groups:      ldapConfig.GetFieldMapping().GetGroups(),
+					},
+					StartTls: ldapConfig.StartTls,
+				},
+			},
+		}
+	}
 	return nil
 }
 
@@ -238,13 +701,36 @@ func convertIdentityProviderToStore(identityProvider *v1pb.IdentityProvider) *st
 		return nil
 	}
 	return &storepb.IdentityProvider{
-		Id:     identityProvider.Id,
-		Title:  identityProvider.Title,
-		Type:   storepb.IdentityProvider_Type(identityProvider.Type),
-		Config: convertIdentityProviderConfigToStore(identityProvider.Config),
+		Id:                  identityProvider.Id,
+		Title:               identityProvider.Title,
+		Type:                storepb.IdentityProvider_Type(identityProvider.Type),
+		Config:              convertIdentityProviderConfigToStore(identityProvider.Config),
+		AllowedEmailDomains: identityProvider.AllowedEmailDomains,
 	}
 }
 
+// convertRoleMappingRulesToStore validates the role mapping rules configured by the user, who must be an admin
+// to map users to the admin role.
+func (s *APIV1Service) convertRoleMappingRulesToStore(user *store.User, rules []*v1pb.IdentityProvider_RoleMappingRule) ([]*storepb.IdentityProvider_RoleMappingRule, error) {
+	storeRules := []*storepb.IdentityProvider_RoleMappingRule{}
+	for _, rule := range rules {
+		storeRule := &storepb.IdentityProvider_RoleMappingRule{
+			Groups:       rule.Groups,
+			EmailDomains: rule.EmailDomains,
+			Deny:         rule.Deny,
+		}
+		if !rule.Deny {
+			role, err := s.validateGrantedRole(user, rule.Role)
+			if err != nil {
+				return nil, err
+			}
+			storeRule.Role = string(role)
+		}
+		storeRules = append(storeRules, storeRule)
+	}
+	return storeRules, nil
+}
+
 func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityProviderConfig) *storepb.IdentityProviderConfig {
 	oauth2Config := identityProviderConfig.GetOauth2()
 	if oauth2Config != nil {
@@ -260,7 +746,64 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 					FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
 						Identifier:  oauth2Config.FieldMapping.Identifier,
 						DisplayName: oauth2Config.FieldMapping.DisplayName,
+						Groups:      oauth2Config.FieldMapping.Groups,
+					},
+				},
+			},
+		}
+	}
+	samlConfig := identityProviderConfig.GetSaml()
+	if samlConfig != nil {
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_Saml{
+				Saml: &storepb.IdentityProviderConfig_SAMLConfig{
+					IdpMetadataXml: samlConfig.IdpMetadataXml,
+					IdpMetadataUrl: samlConfig.IdpMetadataUrl,
+					EntityId:       samlConfig.EntityId,
+					FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
+						Identifier:  samlConfig.GetFieldMapping().GetIdentifier(),
+						DisplayName: samlConfig.GetFieldMapping().GetDisplayName(),
+						Groups:      samlConfig.GetFieldMapping().GetGroups(),
+					},
+				},
+			},
+		}
+	}
+	oidcConfig := identityProviderConfig.GetOidc()
+	if oidcConfig != nil {
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_Oidc{
+				Oidc: &storepb.IdentityProviderConfig_OIDCConfig{
+					ClientId:     oidcConfig.ClientId,
+					ClientSecret: oidcConfig.ClientSecret,
+					IssuerUrl:    oidcConfig.IssuerUrl,
+					Scopes:       oidcConfig.Scopes,
+					FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
+						Identifier:  oidcConfig.GetFieldMapping().GetIdentifier(),
+						DisplayName: oidcConfig.GetFieldMapping().GetDisplayName(),
+						Groups:      oidcConfig.GetFieldMapping().GetGroups(),
+					},
+					AllowMissingEmailVerified: oidcConfig.AllowMissingEmailVerified,
+				},
+			},
+		}
+	}
+	ldapConfig := identityProviderConfig.GetLdap()
+	if ldapConfig != nil {
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_Ldap{
+				Ldap: &storepb.IdentityProviderConfig_LDAPConfig{
+					Url:          ldapConfig.Url,
+					BindDn:       ldapConfig.BindDn,
+					BindPassword: ldapConfig.BindPassword,
+					SearchBase:   ldapConfig.SearchBase,
+					UserFilter:   ldapConfig.UserFilter,
+					FieldMapping: &storepb.IdentityProviderConfig_FieldMapping{
+						Identifier:  ldapConfig.GetFieldMapping().GetIdentifier(),
+						DisplayName: ldapConfig.GetFieldMapping().GetDisplayName(),
+						Groups:      ldapConfig.GetFieldMapping().GetGroups(),
 					},
+					StartTls: ldapConfig.StartTls,
 				},
 			},
 		}
@@ -1,12 +1,16 @@
 package frontend
 
 import (
+	"bytes"
 	"context"
 	"embed"
 	"fmt"
+	"html/template"
 	"io/fs"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/labstack/echo/v4"
@@ -14,10 +18,13 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/yourselfhosted/slash/internal/linktemplate"
 	"github.com/yourselfhosted/slash/internal/util"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/server/common"
 	"github.com/yourselfhosted/slash/server/profile"
+	apiv1 "github.com/yourselfhosted/slash/server/route/api/v1"
+	"github.com/yourselfhosted/slash/server/service/recorder"
 	"github.com/yourselfhosted/slash/store"
 )
 
@@ -31,12 +38,17 @@ const (
 type FrontendService struct {
 	Profile *profile.Profile
 	Store   *store.Store
+
+	authInterceptor  *apiv1.GRPCAuthInterceptor
+	activityRecorder *recorder.Recorder
 }
 
-func NewFrontendService(profile *profile.Profile, store *store.Store) *FrontendService {
+func NewFrontendService(profile *profile.Profile, store *store.Store, secret string, activityRecorder *recorder.Recorder) *FrontendService {
 	return &FrontendService{
-		Profile: profile,
-		Store:   store,
+		Profile:          profile,
+		Store:            store,
+		authInterceptor:  apiv1.NewGRPCAuthInterceptor(store, secret),
+		activityRecorder: activityRecorder,
 	}
 }
 
@@ -47,7 +59,7 @@ func (s *FrontendService) Serve(_ context.Context, e *echo.Echo) {
 		HTML5:      true,
 		Filesystem: getFileSystem("dist"),
 		Skipper: func(c echo.Context) bool {
-			return util.HasPrefixes(c.Path(), "/api", "/slash.api.v1", "/s/:shortcutName", "/c/:collectionName")
+			return util.HasPrefixes(c.Path(), "/api", "/slash.api.v1", "/scim", "/s/:shortcutName", "/c/:collectionName")
 		},
 	}))
 
@@ -56,7 +68,7 @@ func (s *FrontendService) Serve(_ context.Context, e *echo.Echo) {
 	// Reference: https://echo.labstack.com/docs/middleware/gzip
 	assetsGroup.Use(middleware.GzipWithConfig(middleware.GzipConfig{
 		Skipper: func(c echo.Context) bool {
-			return util.HasPrefixes(c.Path(), "/api", "/slash.api.v1", "/s/:shortcutName", "/c/:collectionName")
+			return util.HasPrefixes(c.Path(), "/api", "/slash.api.v1", "/scim", "/s/:shortcutName", "/c/:collectionName")
 		},
 		Level: 5,
 	}))
@@ -70,7 +82,7 @@ func (s *FrontendService) Serve(_ context.Context, e *echo.Echo) {
 		HTML5:      true,
 		Filesystem: getFileSystem("dist/assets"),
 		Skipper: func(c echo.Context) bool {
-			return util.HasPrefixes(c.Path(), "/api", "/slash.api.v1", "/s/:shortcutName", "/c/:collectionName")
+			return util.HasPrefixes(c.Path(), "/api", "/slash.api.v1", "/scim", "/s/:shortcutName", "/c/:collectionName")
 		},
 	}))
 
@@ -80,26 +92,84 @@ func (s *FrontendService) Serve(_ context.Context, e *echo.Echo) {
 func (s *FrontendService) registerRoutes(e *echo.Echo) {
 	rawIndexHTML := getRawIndexHTML()
 
-	e.GET("/s/:shortcutName", func(c echo.Context) error {
+	shortcutHandler := func(c echo.Context) error {
 		ctx := c.Request().Context()
-		shortcutName := c.Param("shortcutName")
-		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-			Name: &shortcutName,
-		})
+		shortcutRelatedSetting, err := s.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+		if err != nil {
+			return c.HTML(http.StatusOK, rawIndexHTML)
+		}
+		// The user is nil if not signed in, or failed to be found, then only the workspace namespace is resolved.
+		user, _ := s.getCurrentUser(ctx, c)
+		shortcutPath := strings.TrimPrefix(c.Request().URL.Path, "/s/")
+		shortcut, suffix, err := s.findShortcutByPath(ctx, shortcutPath, shortcutRelatedSetting.EnablePathForwarding, user)
 		// If any error occurs or the shortcut is not found, return the raw `index.html`.
 		if err != nil || shortcut == nil {
 			return c.HTML(http.StatusOK, rawIndexHTML)
 		}
+		// The private shortcuts are only resolved for the users who can view them, and never previewed.
+		if shortcut.Visibility == storepb.Visibility_PRIVATE {
+			if user == nil {
+				// Let the web app ask to sign in.
+				return c.HTML(http.StatusOK, rawIndexHTML)
+			}
+			if !s.canViewPrivate(ctx, user, store.PermissionShortcutsViewPrivate, shortcut.CreatorId, shortcut.GroupId) {
+				return c.HTML(http.StatusNotFound, renderErrorPage("Link not found",
+					fmt.Sprintf("The shortcut %q doesn't exist or is private.", shortcut.Name)))
+			}
+		}
+		if shortcut.RowStatus == storepb.RowStatus_ARCHIVED {
+			return c.HTML(http.StatusGone, renderErrorPage("Link archived",
+				fmt.Sprintf("The shortcut %q was archived. Ask its creator or an admin to restore it from the trash.", shortcut.Name)))
+		}
+
+		// Link preview bots get the metadata page instead of being redirected.
+		if isLinkPreviewRequest(c.Request()) {
+			indexHTML := strings.ReplaceAll(rawIndexHTML, headerMetadataPlaceholder, generateShortcutMetadata(shortcut).String())
+			return c.HTML(http.StatusOK, indexHTML)
+		}
+		// Let the web app handle the shortcuts that require sign in.
+		if shortcut.Visibility != storepb.Visibility_PUBLIC && user == nil {
+			return c.HTML(http.StatusOK, rawIndexHTML)
+		}
+
+		link, query := shortcut.Link, c.Request().URL.Query()
+		if shortcut.LinkTemplate {
+			linkTemplate, err := linktemplate.Parse(shortcut.Link)
+			if err != nil {
+				return c.HTML(http.StatusOK, rawIndexHTML)
+			}
+			// The forwarded path segments are the arguments of the template.
+			link, query, err = expandShortcutLink(linkTemplate, suffix, query)
+			if err != nil {
+				var missingArgumentErr *linktemplate.MissingArgumentError
+				if errors.As(err, &missingArgumentErr) {
+					return c.HTML(http.StatusBadRequest, renderErrorPage("Missing argument",
+						fmt.Sprintf("The shortcut %q requires the argument %q, e.g. /s/%s/<%s>.", shortcut.Name, missingArgumentErr.Placeholder, shortcut.Name, missingArgumentErr.Placeholder)))
+				}
+				return c.HTML(http.StatusOK, rawIndexHTML)
+			}
+			suffix = ""
+		}
 
 		// Create shortcut view activity.
-		if err := s.createShortcutViewActivity(ctx, c.Request(), shortcut); err != nil {
+		if err := s.createShortcutViewActivity(c.Request(), shortcut); err != nil {
 			slog.Warn("failed to create shortcut view activity", slog.String("error", err.Error()))
 		}
+		// The web app renders the links that are not a valid URL as plain text.
+		if !util.ValidateURI(link) {
+			return c.HTML(http.StatusOK, rawIndexHTML)
+		}
 
-		// Inject shortcut metadata into `index.html`.
-		indexHTML := strings.ReplaceAll(rawIndexHTML, headerMetadataPlaceholder, generateShortcutMetadata(shortcut).String())
-		return c.HTML(http.StatusOK, indexHTML)
-	})
+		redirectURL, err := getShortcutRedirectURL(link, suffix, query, shortcutRelatedSetting.QueryMergeStrategy)
+		if err != nil {
+			return c.HTML(http.StatusBadRequest, renderErrorPage("Invalid path",
+				fmt.Sprintf("The path forwarded to the shortcut %q is invalid: %v.", shortcut.Name, err)))
+		}
+		c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+		return c.Redirect(http.StatusFound, redirectURL)
+	}
+	e.GET("/s/:shortcutName", shortcutHandler)
+	e.GET("/s/:shortcutName/*", shortcutHandler)
 
 	e.GET("/c/:collectionName", func(c echo.Context) error {
 		ctx := c.Request().Context()
@@ -111,6 +181,13 @@ func (s *FrontendService) registerRoutes(e *echo.Echo) {
 		if err != nil || collection == nil {
 			return c.HTML(http.StatusOK, rawIndexHTML)
 		}
+		// The metadata of the private collections is only injected for the users who can view them.
+		if collection.Visibility == storepb.Visibility_PRIVATE {
+			user, err := s.getCurrentUser(ctx, c)
+			if err != nil || user == nil || !s.canViewPrivate(ctx, user, store.PermissionCollectionsViewPrivate, collection.CreatorId, collection.GroupId) {
+				return c.HTML(http.StatusOK, rawIndexHTML)
+			}
+		}
 
 		// Inject collection metadata into `index.html`.
 		indexHTML := strings.ReplaceAll(rawIndexHTML, headerMetadataPlaceholder, generateCollectionMetadata(collection).String())
@@ -118,7 +195,7 @@ func (s *FrontendService) registerRoutes(e *echo.Echo) {
 	})
 }
 
-func (s *FrontendService) createShortcutViewActivity(ctx context.Context, request *http.Request, shortcut *storepb.Shortcut) error {
+func (s *FrontendService) createShortcutViewActivity(request *http.Request, shortcut *storepb.Shortcut) error {
 	ip := getReadUserIP(request)
 	referer := request.Header.Get("Referer")
 	userAgent := request.Header.Get("User-Agent")
@@ -143,11 +220,166 @@ func (s *FrontendService) createShortcutViewActivity(ctx context.Context, reques
 		Level:     store.ActivityInfo,
 		Payload:   string(payloadStr),
 	}
-	_, err = s.Store.CreateActivity(ctx, activity)
+	// The activity is written in batches off the request path.
+	// It's dropped if too many are queued, which the recorder counts and logs.
+	s.activityRecorder.Record(activity)
+	return nil
+}
+
+// getCurrentUser returns the signed in user from the access token cookie, or nil if there is none.
+func (s *FrontendService) getCurrentUser(ctx context.Context, c echo.Context) (*store.User, error) {
+	cookie, err := c.Cookie(apiv1.AccessTokenCookieName)
+	if err != nil || cookie.Value == "" {
+		return nil, nil
+	}
+	userID, err := s.authInterceptor.Authenticate(ctx, cookie.Value)
 	if err != nil {
-		return errors.Wrap(err, "Failed to create activity")
+		return nil, nil
 	}
-	return nil
+	return s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+}
+
+// canViewPrivate returns true if the user is the creator of the private shortcut or collection, a member of
+// its group, or has the view-private permission of its kind.
+func (s *FrontendService) canViewPrivate(ctx context.Context, user *store.User, viewPrivatePermission store.Permission, creatorID, groupID int32) bool {
+	if user.ID == creatorID {
+		return true
+	}
+	if canViewPrivate, err := s.Store.HasPermission(ctx, user, viewPrivatePermission); err == nil && canViewPrivate {
+		return true
+	}
+	if groupID == 0 {
+		return false
+	}
+	member, err := s.Store.GetGroupMember(ctx, groupID, user.ID)
+	return err == nil && member != nil
+}
+
+// findShortcutByPath finds the shortcut with the given path as its name. It falls back to the longest leading
+// segments of the path that name a shortcut and returns the rest as suffix, if path forwarding is enabled
+// or the shortcut link is a template which takes the rest as its arguments.
+// The names are resolved in the personal namespace of the user first, who is nil if not signed in.
+func (s *FrontendService) findShortcutByPath(ctx context.Context, shortcutPath string, enablePathForwarding bool, user *store.User) (*storepb.Shortcut, string, error) {
+	userID := int32(0)
+	if user != nil {
+		userID = user.ID
+	}
+	segments := strings.Split(shortcutPath, "/")
+	for i := len(segments); i > 0; i-- {
+		name := strings.Join(segments[:i], "/")
+		if name == "" {
+			continue
+		}
+		shortcut, err := s.Store.ResolveShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		}, userID)
+		if err != nil {
+			return nil, "", err
+		}
+		if shortcut == nil {
+			continue
+		}
+		if i == len(segments) || enablePathForwarding || shortcut.LinkTemplate {
+			return shortcut, strings.Join(segments[i:], "/"), nil
+		}
+	}
+	return nil, "", nil
+}
+
+// expandShortcutLink expands the link template with the path segments of the suffix as positional arguments.
+// The query params consumed by named placeholders are removed from the returned query.
+func expandShortcutLink(linkTemplate *linktemplate.Template, suffix string, query url.Values) (string, url.Values, error) {
+	args := []string{}
+	for _, arg := range strings.Split(suffix, "/") {
+		if arg != "" {
+			args = append(args, arg)
+		}
+	}
+	link, consumedParams, err := linkTemplate.Expand(args, query)
+	if err != nil {
+		return "", nil, err
+	}
+	restQuery := url.Values{}
+	for key, list := range query {
+		if !slices.Contains(consumedParams, key) {
+			restQuery[key] = list
+		}
+	}
+	return link, restQuery, nil
+}
+
+// getShortcutRedirectURL returns the target of the shortcut with the forwarded path suffix
+// and the request query params merged with the given strategy. The suffix must not contain
+// dot segments, which would escape the path of the link.
+func getShortcutRedirectURL(link string, suffix string, query url.Values, queryMergeStrategy storepb.QueryMergeStrategy) (string, error) {
+	redirectURL, err := url.Parse(link)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse shortcut link")
+	}
+	if suffix != "" {
+		for _, segment := range strings.Split(suffix, "/") {
+			if segment == "." || segment == ".." {
+				return "", errors.Errorf("invalid path segment %q", segment)
+			}
+		}
+		redirectURL = redirectURL.JoinPath(suffix)
+	}
+	if len(query) > 0 {
+		switch queryMergeStrategy {
+		case storepb.QueryMergeStrategy_IGNORE:
+		case storepb.QueryMergeStrategy_OVERRIDE:
+			values := redirectURL.Query()
+			for key, list := range query {
+				values[key] = list
+			}
+			redirectURL.RawQuery = values.Encode()
+		default:
+			if redirectURL.RawQuery != "" {
+				redirectURL.RawQuery += "&"
+			}
+			redirectURL.RawQuery += query.Encode()
+		}
+	}
+	return redirectURL.String(), nil
+}
+
+// linkPreviewUserAgents are the user agent tokens of the well-known link preview bots.
+var linkPreviewUserAgents = []string{
+	"facebookexternalhit",
+	"facebot",
+	"twitterbot",
+	"slackbot",
+	"slack-imgproxy",
+	"discordbot",
+	"linkedinbot",
+	"telegrambot",
+	"whatsapp",
+	"skypeuripreview",
+	"microsoftpreview",
+	"applebot",
+	"googlebot",
+	"bingbot",
+	"redditbot",
+	"pinterest",
+	"embedly",
+	"iframely",
+	"vkshare",
+	"mattermost",
+	"bitlybot",
+}
+
+// isLinkPreviewRequest returns true if the request comes from a link preview bot which expects the metadata page
+// instead of a redirect. Clients can also ask for the metadata page explicitly with `Accept: text/html` only.
+func isLinkPreviewRequest(request *http.Request) bool {
+	userAgent := strings.ToLower(request.Header.Get("User-Agent"))
+	for _, token := range linkPreviewUserAgents {
+		if strings.Contains(userAgent, token) {
+			return true
+		}
+	}
+	return strings.TrimSpace(request.Header.Get("Accept")) == "text/html"
 }
 
 func getReadUserIP(r *http.Request) string {
@@ -194,6 +426,28 @@ func generateCollectionMetadata(collection *storepb.Collection) *Metadata {
 	return metadata
 }
 
+var errorPageTemplate = template.Must(template.New("error").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8" />
+<meta name="viewport" content="width=device-width, initial-scale=1.0" />
+<title>{{.Title}} - Slash</title>
+</head>
+<body style="font-family: sans-serif; max-width: 40rem; margin: 4rem auto; padding: 0 1rem;">
+<h1>{{.Title}}</h1>
+<p>{{.Message}}</p>
+</body>
+</html>`))
+
+// renderErrorPage renders a minimal standalone page for the errors of the shortcut redirects.
+func renderErrorPage(title, message string) string {
+	var buf bytes.Buffer
+	if err := errorPageTemplate.Execute(&buf, map[string]string{"Title": title, "Message": message}); err != nil {
+		return title
+	}
+	return buf.String()
+}
+
 func getRawIndexHTML() string {
 	bytes, _ := embeddedFiles.ReadFile("dist/index.html")
 	return string(bytes)
@@ -0,0 +1,121 @@
+package frontend
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/internal/linktemplate"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+func TestGetShortcutRedirectURL(t *testing.T) {
+	tests := []struct {
+		link               string
+		suffix             string
+		query              url.Values
+		queryMergeStrategy storepb.QueryMergeStrategy
+		expected           string
+	}{
+		{
+			link:     "https://example.com/docs",
+			query:    url.Values{},
+			expected: "https://example.com/docs",
+		},
+		{
+			link:     "https://example.com/docs",
+			query:    url.Values{"q": []string{"slash"}},
+			expected: "https://example.com/docs?q=slash",
+		},
+		{
+			link:     "https://example.com/search?lang=en",
+			query:    url.Values{"q": []string{"a b"}},
+			expected: "https://example.com/search?lang=en&q=a+b",
+		},
+		{
+			link:     "https://example.com/docs/",
+			suffix:   "api/v2",
+			query:    url.Values{"x": []string{"1"}},
+			expected: "https://example.com/docs/api/v2?x=1",
+		},
+		{
+			link:     "https://example.com",
+			suffix:   "api/v2/",
+			expected: "https://example.com/api/v2/",
+		},
+		{
+			link:               "https://example.com/search?lang=en&q=go",
+			query:              url.Values{"q": []string{"slash"}},
+			queryMergeStrategy: storepb.QueryMergeStrategy_OVERRIDE,
+			expected:           "https://example.com/search?lang=en&q=slash",
+		},
+		{
+			link:               "https://example.com/search?lang=en",
+			query:              url.Values{"q": []string{"slash"}},
+			queryMergeStrategy: storepb.QueryMergeStrategy_IGNORE,
+			expected:           "https://example.com/search?lang=en",
+		},
+	}
+	for _, test := range tests {
+		redirectURL, err := getShortcutRedirectURL(test.link, test.suffix, test.query, test.queryMergeStrategy)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, redirectURL)
+	}
+
+	// The dot segments would escape the path of the link.
+	for _, suffix := range []string{"../../admin", "api/../../admin", "./api", "api/.."} {
+		_, err := getShortcutRedirectURL("https://example.com/docs", suffix, url.Values{}, storepb.QueryMergeStrategy_APPEND)
+		require.Error(t, err, suffix)
+	}
+}
+
+func TestIsLinkPreviewRequest(t *testing.T) {
+	tests := []struct {
+		userAgent string
+		accept    string
+		expected  bool
+	}{
+		{
+			userAgent: "curl/8.4.0",
+			accept:    "*/*",
+			expected:  false,
+		},
+		{
+			userAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			accept:    "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+			expected:  false,
+		},
+		{
+			userAgent: "Slackbot-LinkExpanding 1.0 (+https://api.slack.com/robots)",
+			accept:    "*/*",
+			expected:  true,
+		},
+		{
+			userAgent: "facebookexternalhit/1.1",
+			expected:  true,
+		},
+		{
+			userAgent: "curl/8.4.0",
+			accept:    "text/html",
+			expected:  true,
+		},
+	}
+	for _, test := range tests {
+		request, err := http.NewRequest(http.MethodGet, "/s/test", nil)
+		require.NoError(t, err)
+		request.Header.Set("User-Agent", test.userAgent)
+		request.Header.Set("Accept", test.accept)
+		require.Equal(t, test.expected, isLinkPreviewRequest(request))
+	}
+}
+
+func TestExpandShortcutLink(t *testing.T) {
+	linkTemplate, err := linktemplate.Parse("https://github.com/org/{repo}/pull/{pr}")
+	require.NoError(t, err)
+	link, query, err := expandShortcutLink(linkTemplate, "slash/", url.Values{"pr": []string{"42"}, "tab": []string{"files"}})
+	require.NoError(t, err)
+	require.Equal(t, "https://github.com/org/slash/pull/42", link)
+	require.Equal(t, url.Values{"tab": []string{"files"}}, query)
+}
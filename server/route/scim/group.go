@@ -0,0 +1,434 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// groupResource is the SCIM group, whose members are referenced by the user ids.
+type groupResource struct {
+	Schemas     []string  `json:"schemas"`
+	ID          string    `json:"id,omitempty"`
+	DisplayName string    `json:"displayName"`
+	Members     []*member `json:"members,omitempty"`
+	Meta        *meta     `json:"meta,omitempty"`
+}
+
+type member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// memberFilterRegexp matches the path removing a single member, e.g. `members[value eq "1"]`.
+var memberFilterRegexp = regexp.MustCompile(`^(?i:members)\[(.+)\]$`)
+
+func (s *SCIMService) convertGroupFromStore(c echo.Context, group *store.Group, withMembers bool) (*groupResource, error) {
+	resource := &groupResource{
+		Schemas:     []string{schemaGroup},
+		ID:          fmt.Sprint(group.ID),
+		DisplayName: group.Name,
+		Meta:        newMeta(c, "Group", group.ID, group.CreatedTs, group.UpdatedTs),
+	}
+	if !withMembers {
+		return resource, nil
+	}
+
+	ctx := c.Request().Context()
+	groupMembers, err := s.Store.ListGroupMembers(ctx, &store.FindGroupMember{
+		GroupID: &group.ID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list group members")
+	}
+	resource.Members = []*member{}
+	for _, groupMember := range groupMembers {
+		user, err := s.Store.GetUser(ctx, &store.FindUser{
+			ID: &groupMember.UserID,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user")
+		}
+		if user == nil {
+			continue
+		}
+		resource.Members = append(resource.Members, &member{
+			Value:   fmt.Sprint(user.ID),
+			Display: user.Email,
+			Ref:     newMeta(c, "User", user.ID, user.CreatedTs, user.UpdatedTs).Location,
+		})
+	}
+	return resource, nil
+}
+
+func (s *SCIMService) listGroups(c echo.Context) error {
+	ctx := c.Request().Context()
+	f, err := parseFilter(c.QueryParam("filter"), "id", "displayname")
+	if err != nil {
+		return err
+	}
+	find := &store.FindGroup{}
+	if f != nil {
+		if f.attribute == "id" {
+			id, err := util.ConvertStringToInt32(f.value)
+			if err != nil {
+				id = -1
+			}
+			find.ID = &id
+		} else {
+			find.Name = &f.value
+		}
+	}
+	groups, err := s.Store.ListGroups(ctx, find)
+	if err != nil {
+		return errors.Wrap(err, "failed to list groups")
+	}
+
+	// The identity providers exclude the members when only looking up the groups.
+	withMembers := !strings.Contains(strings.ToLower(c.QueryParam("excludedAttributes")), "members")
+	response, page := paginate(c, groups)
+	for _, group := range page {
+		resource, err := s.convertGroupFromStore(c, group, withMembers)
+		if err != nil {
+			return err
+		}
+		response.Resources = append(response.Resources, resource)
+	}
+	return writeJSON(c, http.StatusOK, response)
+}
+
+func (s *SCIMService) getGroup(c echo.Context) error {
+	group, err := s.findGroup(c)
+	if err != nil {
+		return err
+	}
+	return s.writeGroup(c, http.StatusOK, group)
+}
+
+// createGroup creates the group, whose members are added as viewers.
+func (s *SCIMService) createGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	resource := &groupResource{}
+	if err := readJSON(c, resource); err != nil {
+		return err
+	}
+	if resource.DisplayName == "" {
+		return newError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	if err := s.checkGroupNameAvailable(c, resource.DisplayName, 0); err != nil {
+		return err
+	}
+	userIDs, err := s.parseMembers(c, resource.Members)
+	if err != nil {
+		return err
+	}
+
+	group, err := s.Store.CreateGroup(ctx, &store.Group{
+		Name: resource.DisplayName,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create group")
+	}
+	if err := s.addGroupMembers(c, group.ID, userIDs); err != nil {
+		return err
+	}
+	return s.writeGroup(c, http.StatusCreated, group)
+}
+
+// replaceGroup replaces the name and the members of the group, keeping the roles of the remaining members.
+func (s *SCIMService) replaceGroup(c echo.Context) error {
+	group, err := s.findGroup(c)
+	if err != nil {
+		return err
+	}
+	resource := &groupResource{}
+	if err := readJSON(c, resource); err != nil {
+		return err
+	}
+	if resource.DisplayName == "" {
+		return newError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	userIDs, err := s.parseMembers(c, resource.Members)
+	if err != nil {
+		return err
+	}
+
+	if group, err = s.renameGroup(c, group, resource.DisplayName); err != nil {
+		return err
+	}
+	if err := s.replaceGroupMembers(c, group.ID, userIDs); err != nil {
+		return err
+	}
+	return s.writeGroup(c, http.StatusOK, group)
+}
+
+// patchGroup applies the operations on the name and the members of the group, e.g. removes a member with
+// `{"op": "remove", "path": "members[value eq \"1\"]"}`.
+func (s *SCIMService) patchGroup(c echo.Context) error {
+	group, err := s.findGroup(c)
+	if err != nil {
+		return err
+	}
+	request := &patchRequest{}
+	if err := readJSON(c, request); err != nil {
+		return err
+	}
+
+	for _, operation := range request.Operations {
+		op := strings.ToLower(operation.Op)
+		switch op {
+		case "add", "replace":
+			attributes, err := operation.attributes()
+			if err != nil {
+				return err
+			}
+			for attribute, value := range attributes {
+				switch attribute {
+				case "displayname":
+					name, err := parseString(value)
+					if err != nil {
+						return err
+					}
+					if name == "" {
+						return newError(http.StatusBadRequest, "invalidValue", "displayName is required")
+					}
+					if group, err = s.renameGroup(c, group, name); err != nil {
+						return err
+					}
+				case "members":
+					userIDs, err := s.parseMemberValues(c, value)
+					if err != nil {
+						return err
+					}
+					if op == "add" {
+						err = s.addGroupMembers(c, group.ID, userIDs)
+					} else {
+						err = s.replaceGroupMembers(c, group.ID, userIDs)
+					}
+					if err != nil {
+						return err
+					}
+				}
+			}
+		case "remove":
+			userIDs, err := s.parseRemovedMembers(c, group.ID, operation)
+			if err != nil {
+				return err
+			}
+			for _, userID := range userIDs {
+				if err := s.Store.DeleteGroupMember(c.Request().Context(), &store.DeleteGroupMember{
+					GroupID: group.ID,
+					UserID:  userID,
+				}); err != nil {
+					return errors.Wrap(err, "failed to remove group member")
+				}
+			}
+		default:
+			return newError(http.StatusBadRequest, "invalidSyntax", fmt.Sprintf("unsupported operation %q", operation.Op))
+		}
+	}
+	return s.writeGroup(c, http.StatusOK, group)
+}
+
+func (s *SCIMService) deleteGroup(c echo.Context) error {
+	group, err := s.findGroup(c)
+	if err != nil {
+		return err
+	}
+	if err := s.Store.DeleteGroup(c.Request().Context(), &store.DeleteGroup{ID: group.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete group")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *SCIMService) findGroup(c echo.Context) (*store.Group, error) {
+	id, err := parseID(c, "group")
+	if err != nil {
+		return nil, err
+	}
+	group, err := s.Store.GetGroup(c.Request().Context(), &store.FindGroup{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get group")
+	}
+	if group == nil {
+		return nil, newError(http.StatusNotFound, "", fmt.Sprintf("group %d not found", id))
+	}
+	return group, nil
+}
+
+func (s *SCIMService) writeGroup(c echo.Context, status int, group *store.Group) error {
+	resource, err := s.convertGroupFromStore(c, group, true)
+	if err != nil {
+		return err
+	}
+	return writeJSON(c, status, resource)
+}
+
+func (s *SCIMService) checkGroupNameAvailable(c echo.Context, name string, groupID int32) error {
+	group, err := s.Store.GetGroup(c.Request().Context(), &store.FindGroup{
+		Name: &name,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get group")
+	}
+	if group != nil && group.ID != groupID {
+		return newError(http.StatusConflict, "uniqueness", fmt.Sprintf("group %q already exists", name))
+	}
+	return nil
+}
+
+func (s *SCIMService) renameGroup(c echo.Context, group *store.Group, name string) (*store.Group, error) {
+	if name == group.Name {
+		return group, nil
+	}
+	if err := s.checkGroupNameAvailable(c, name, group.ID); err != nil {
+		return nil, err
+	}
+	updatedTs := time.Now().Unix()
+	group, err := s.Store.UpdateGroup(c.Request().Context(), &store.UpdateGroup{
+		ID:        group.ID,
+		UpdatedTs: &updatedTs,
+		Name:      &name,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update group")
+	}
+	return group, nil
+}
+
+// parseMembers returns the user ids of the members, after checking the users exist.
+func (s *SCIMService) parseMembers(c echo.Context, members []*member) ([]int32, error) {
+	userIDs := []int32{}
+	for _, member := range members {
+		userID, err := util.ConvertStringToInt32(member.Value)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("invalid member %q", member.Value))
+		}
+		user, err := s.Store.GetUser(c.Request().Context(), &store.FindUser{
+			ID: &userID,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user")
+		}
+		if user == nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("user %d not found", userID))
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, nil
+}
+
+func (s *SCIMService) parseMemberValues(c echo.Context, raw json.RawMessage) ([]int32, error) {
+	members := []*member{}
+	if err := json.Unmarshal(raw, &members); err != nil {
+		return nil, newError(http.StatusBadRequest, "invalidValue", "members must be a list")
+	}
+	return s.parseMembers(c, members)
+}
+
+// parseRemovedMembers returns the user ids removed by the operation, which are either filtered by the path,
+// listed in the value, or all the members if neither.
+func (s *SCIMService) parseRemovedMembers(c echo.Context, groupID int32, operation *patchOperation) ([]int32, error) {
+	if matches := memberFilterRegexp.FindStringSubmatch(operation.Path); matches != nil {
+		f, err := parseFilter(matches[1], "value")
+		if err != nil {
+			return nil, err
+		}
+		userID, err := util.ConvertStringToInt32(f.value)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("invalid member %q", f.value))
+		}
+		return []int32{userID}, nil
+	}
+	if !strings.EqualFold(operation.Path, "members") {
+		return nil, newError(http.StatusBadRequest, "noTarget", fmt.Sprintf("unsupported path %q", operation.Path))
+	}
+	if len(operation.Value) != 0 {
+		members := []*member{}
+		if err := json.Unmarshal(operation.Value, &members); err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "members must be a list")
+		}
+		userIDs := []int32{}
+		for _, member := range members {
+			userID, err := util.ConvertStringToInt32(member.Value)
+			if err != nil {
+				return nil, newError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("invalid member %q", member.Value))
+			}
+			userIDs = append(userIDs, userID)
+		}
+		return userIDs, nil
+	}
+
+	groupMembers, err := s.Store.ListGroupMembers(c.Request().Context(), &store.FindGroupMember{
+		GroupID: &groupID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list group members")
+	}
+	userIDs := []int32{}
+	for _, groupMember := range groupMembers {
+		userIDs = append(userIDs, groupMember.UserID)
+	}
+	return userIDs, nil
+}
+
+// addGroupMembers adds the users as viewers of the group, keeping the roles of the existing members.
+func (s *SCIMService) addGroupMembers(c echo.Context, groupID int32, userIDs []int32) error {
+	ctx := c.Request().Context()
+	for _, userID := range userIDs {
+		groupMember, err := s.Store.GetGroupMember(ctx, groupID, userID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get group member")
+		}
+		if groupMember != nil {
+			continue
+		}
+		if _, err := s.Store.UpsertGroupMember(ctx, &store.GroupMember{
+			GroupID: groupID,
+			UserID:  userID,
+			Role:    store.GroupRoleViewer,
+		}); err != nil {
+			return errors.Wrap(err, "failed to add group member")
+		}
+	}
+	return nil
+}
+
+// replaceGroupMembers makes the users the members of the group, removing the others.
+func (s *SCIMService) replaceGroupMembers(c echo.Context, groupID int32, userIDs []int32) error {
+	ctx := c.Request().Context()
+	groupMembers, err := s.Store.ListGroupMembers(ctx, &store.FindGroupMember{
+		GroupID: &groupID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list group members")
+	}
+	kept := map[int32]bool{}
+	for _, userID := range userIDs {
+		kept[userID] = true
+	}
+	for _, groupMember := range groupMembers {
+		if kept[groupMember.UserID] {
+			continue
+		}
+		if err := s.Store.DeleteGroupMember(ctx, &store.DeleteGroupMember{
+			GroupID: groupID,
+			UserID:  groupMember.UserID,
+		}); err != nil {
+			return errors.Wrap(err, "failed to remove group member")
+		}
+	}
+	return s.addGroupMembers(c, groupID, userIDs)
+}
@@ -0,0 +1,305 @@
+// Package scim serves the SCIM 2.0 provisioning endpoints, where the identity providers such as Okta or
+// Azure AD create, update and deactivate the users and groups of the workspace.
+// See https://datatracker.ietf.org/doc/html/rfc7644.
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/server/service/license"
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	contentType = "application/scim+json"
+	// maxPageSize is the maximum number of resources returned by a list request.
+	maxPageSize = 100
+)
+
+type SCIMService struct {
+	Store          *store.Store
+	LicenseService *license.LicenseService
+}
+
+func NewSCIMService(store *store.Store, licenseService *license.LicenseService) *SCIMService {
+	return &SCIMService{
+		Store:          store,
+		LicenseService: licenseService,
+	}
+}
+
+// RegisterRoutes registers the SCIM endpoints under "/scim/v2", which are authenticated with the SCIM bearer token.
+func (s *SCIMService) RegisterRoutes(e *echo.Echo) {
+	g := e.Group("/scim/v2", s.authenticate)
+	g.GET("/ServiceProviderConfig", handle(s.getServiceProviderConfig))
+	g.GET("/Users", handle(s.listUsers))
+	g.POST("/Users", handle(s.createUser))
+	g.GET("/Users/:id", handle(s.getUser))
+	g.PUT("/Users/:id", handle(s.replaceUser))
+	g.PATCH("/Users/:id", handle(s.patchUser))
+	g.DELETE("/Users/:id", handle(s.deleteUser))
+	g.GET("/Groups", handle(s.listGroups))
+	g.POST("/Groups", handle(s.createGroup))
+	g.GET("/Groups/:id", handle(s.getGroup))
+	g.PUT("/Groups/:id", handle(s.replaceGroup))
+	g.PATCH("/Groups/:id", handle(s.patchGroup))
+	g.DELETE("/Groups/:id", handle(s.deleteGroup))
+}
+
+// authenticate checks the bearer token against the hash of the SCIM token in the security setting.
+func (s *SCIMService) authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !s.LicenseService.IsFeatureEnabled(license.FeatureTypeSSO) {
+			return writeError(c, newError(http.StatusForbidden, "", "SCIM is not available in the current plan"))
+		}
+		securitySetting, err := s.Store.GetWorkspaceSecuritySetting(c.Request().Context())
+		if err != nil {
+			return writeError(c, errors.Wrap(err, "failed to get workspace security setting"))
+		}
+		authorization := c.Request().Header.Get(echo.HeaderAuthorization)
+		scheme, token, _ := strings.Cut(authorization, " ")
+		if securitySetting.ScimTokenHash == "" || !strings.EqualFold(scheme, "Bearer") || token == "" ||
+			subtle.ConstantTimeCompare([]byte(store.HashSCIMToken(token)), []byte(securitySetting.ScimTokenHash)) != 1 {
+			return writeError(c, newError(http.StatusUnauthorized, "", "invalid bearer token"))
+		}
+		return next(c)
+	}
+}
+
+func (*SCIMService) getServiceProviderConfig(c echo.Context) error {
+	return writeJSON(c, http.StatusOK, map[string]any{
+		"schemas":        []string{schemaServiceProviderConfig},
+		"patch":          map[string]any{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": maxPageSize},
+		"changePassword": map[string]any{"supported": false},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": false},
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with the SCIM token of the workspace",
+			"primary":     true,
+		}},
+	})
+}
+
+// scimError is the error response of SCIM, see https://datatracker.ietf.org/doc/html/rfc7644#section-3.12.
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+func (e *scimError) Error() string {
+	return e.Detail
+}
+
+func newError(status int, scimType, detail string) *scimError {
+	return &scimError{
+		Schemas:  []string{schemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	}
+}
+
+// handle writes the errors of the handler as SCIM error responses, instead of leaving them to echo,
+// which would fall back to the frontend for the not found errors.
+func handle(handler echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if err := handler(c); err != nil {
+			return writeError(c, err)
+		}
+		return nil
+	}
+}
+
+func writeError(c echo.Context, err error) error {
+	var e *scimError
+	if !errors.As(err, &e) {
+		slog.Error("failed to handle SCIM request", slog.String("path", c.Path()), slog.String("error", err.Error()))
+		e = newError(http.StatusInternalServerError, "", "internal server error")
+	}
+	status, _ := strconv.Atoi(e.Status)
+	return writeJSON(c, status, e)
+}
+
+func writeJSON(c echo.Context, status int, v any) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal response")
+	}
+	return c.Blob(status, contentType, body)
+}
+
+// readJSON decodes the request body, whose content type is "application/scim+json" which echo doesn't bind.
+func readJSON(c echo.Context, v any) error {
+	if err := json.NewDecoder(c.Request().Body).Decode(v); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", fmt.Sprintf("invalid request body: %v", err))
+	}
+	return nil
+}
+
+// meta is the metadata of a resource.
+type meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created"`
+	LastModified string `json:"lastModified"`
+	Location     string `json:"location"`
+}
+
+func newMeta(c echo.Context, resourceType string, id int32, createdTs, updatedTs int64) *meta {
+	return &meta{
+		ResourceType: resourceType,
+		Created:      time.Unix(createdTs, 0).UTC().Format(time.RFC3339),
+		LastModified: time.Unix(updatedTs, 0).UTC().Format(time.RFC3339),
+		Location:     fmt.Sprintf("%s://%s/scim/v2/%ss/%d", c.Scheme(), c.Request().Host, resourceType, id),
+	}
+}
+
+type listResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// paginate returns the page of the resources by the 1-based "startIndex" and the "count" query params.
+func paginate[T any](c echo.Context, resources []T) (*listResponse, []T) {
+	startIndex, err := strconv.Atoi(c.QueryParam("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+	count, err := strconv.Atoi(c.QueryParam("count"))
+	if err != nil || count > maxPageSize {
+		count = maxPageSize
+	}
+	count = max(count, 0)
+
+	page := []T{}
+	if startIndex <= len(resources) {
+		page = resources[startIndex-1 : min(startIndex-1+count, len(resources))]
+	}
+	return &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    []any{},
+	}, page
+}
+
+// filter is an equality filter, e.g. `userName eq "alice@example.com"`, which is the one used by the
+// identity providers to look up the resources.
+type filter struct {
+	// attribute is the lower-cased attribute name, e.g. "username".
+	attribute string
+	value     string
+}
+
+var filterRegexp = regexp.MustCompile(`^\s*(\S+)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+// parseFilter parses the filter whose attribute must be one of the given lower-cased ones, or returns nil if empty.
+func parseFilter(raw string, attributes ...string) (*filter, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+	matches := filterRegexp.FindStringSubmatch(raw)
+	if matches == nil {
+		return nil, newError(http.StatusBadRequest, "invalidFilter", fmt.Sprintf("unsupported filter %q, only the eq operator is supported", raw))
+	}
+	f := &filter{
+		attribute: strings.ToLower(matches[1]),
+	}
+	if err := json.Unmarshal([]byte(matches[2]), &f.value); err != nil {
+		return nil, newError(http.StatusBadRequest, "invalidFilter", fmt.Sprintf("invalid filter value %s", matches[2]))
+	}
+	for _, attribute := range attributes {
+		if f.attribute == attribute {
+			return f, nil
+		}
+	}
+	return nil, newError(http.StatusBadRequest, "invalidFilter", fmt.Sprintf("unsupported filter attribute %q", matches[1]))
+}
+
+// patchRequest is the request of a PATCH, see https://datatracker.ietf.org/doc/html/rfc7644#section-3.5.2.
+type patchRequest struct {
+	Operations []*patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	// Op is one of "add", "remove" and "replace", which some identity providers capitalize.
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// attributes returns the values of the operation by the lower-cased attribute names, which are either the
+// path of the operation, or the keys of its value object if there is no path.
+func (o *patchOperation) attributes() (map[string]json.RawMessage, error) {
+	if o.Path != "" {
+		return map[string]json.RawMessage{strings.ToLower(o.Path): o.Value}, nil
+	}
+	values := map[string]json.RawMessage{}
+	if err := json.Unmarshal(o.Value, &values); err != nil {
+		return nil, newError(http.StatusBadRequest, "invalidValue", "the value must be an object if there is no path")
+	}
+	attributes := map[string]json.RawMessage{}
+	for key, value := range values {
+		attributes[strings.ToLower(key)] = value
+	}
+	return attributes, nil
+}
+
+// parseBool parses a boolean value, which some identity providers send as a string, e.g. "False".
+func parseBool(raw json.RawMessage) (bool, error) {
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return false, newError(http.StatusBadRequest, "invalidValue", "invalid boolean value")
+	}
+	switch v := v.(type) {
+	case bool:
+		return v, nil
+	case string:
+		if b, err := strconv.ParseBool(strings.ToLower(v)); err == nil {
+			return b, nil
+		}
+	}
+	return false, newError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("invalid boolean value %s", raw))
+}
+
+func parseString(raw json.RawMessage) (string, error) {
+	var v string
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return "", newError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("invalid string value %s", raw))
+	}
+	return v, nil
+}
+
+func parseID(c echo.Context, resourceType string) (int32, error) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return 0, newError(http.StatusNotFound, "", fmt.Sprintf("%s %q not found", resourceType, c.Param("id")))
+	}
+	return int32(id), nil
+}
@@ -0,0 +1,62 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	f, err := parseFilter(`userName eq "alice@example.com"`, "username")
+	require.NoError(t, err)
+	assert.Equal(t, &filter{attribute: "username", value: "alice@example.com"}, f)
+
+	f, err = parseFilter(`displayName EQ "Team \"A\""`, "displayname")
+	require.NoError(t, err)
+	assert.Equal(t, &filter{attribute: "displayname", value: `Team "A"`}, f)
+
+	f, err = parseFilter("", "username")
+	require.NoError(t, err)
+	assert.Nil(t, f)
+
+	_, err = parseFilter(`userName co "alice"`, "username")
+	assert.ErrorContains(t, err, "only the eq operator is supported")
+	_, err = parseFilter(`title eq "engineer"`, "username")
+	assert.ErrorContains(t, err, `unsupported filter attribute "title"`)
+}
+
+func TestParseBool(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want bool
+	}{
+		{raw: `true`, want: true},
+		{raw: `false`, want: false},
+		{raw: `"False"`, want: false},
+		{raw: `"TRUE"`, want: true},
+	}
+	for _, test := range tests {
+		got, err := parseBool(json.RawMessage(test.raw))
+		require.NoError(t, err, test.raw)
+		assert.Equal(t, test.want, got, test.raw)
+	}
+
+	_, err := parseBool(json.RawMessage(`"inactive"`))
+	assert.Error(t, err)
+}
+
+func TestPatchOperationAttributes(t *testing.T) {
+	attributes, err := (&patchOperation{Op: "Replace", Path: "active", Value: json.RawMessage(`false`)}).attributes()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]json.RawMessage{"active": json.RawMessage(`false`)}, attributes)
+
+	// Azure AD sends the attributes in the value without a path.
+	attributes, err = (&patchOperation{Op: "Replace", Value: json.RawMessage(`{"active": false, "displayName": "Alice"}`)}).attributes()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]json.RawMessage{"active": json.RawMessage(`false`), "displayname": json.RawMessage(`"Alice"`)}, attributes)
+
+	_, err = (&patchOperation{Op: "Replace", Value: json.RawMessage(`false`)}).attributes()
+	assert.Error(t, err)
+}
@@ -0,0 +1,396 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/yourselfhosted/slash/internal/util"
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/service/license"
+	"github.com/yourselfhosted/slash/store"
+)
+
+// userResource is the SCIM user, whose userName is the email of the user.
+type userResource struct {
+	Schemas     []string  `json:"schemas"`
+	ID          string    `json:"id,omitempty"`
+	UserName    string    `json:"userName"`
+	Name        *userName `json:"name,omitempty"`
+	DisplayName string    `json:"displayName,omitempty"`
+	Emails      []*email  `json:"emails,omitempty"`
+	Active      *bool     `json:"active,omitempty"`
+	Meta        *meta     `json:"meta,omitempty"`
+}
+
+type userName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// email returns the email of the user, which is the userName, or the primary email if the userName is not one.
+func (u *userResource) email() (string, error) {
+	if util.ValidateEmail(u.UserName) {
+		return u.UserName, nil
+	}
+	for _, email := range u.Emails {
+		if email.Primary && util.ValidateEmail(email.Value) {
+			return email.Value, nil
+		}
+	}
+	return "", newError(http.StatusBadRequest, "invalidValue", "userName must be an email address")
+}
+
+// nickname returns the display name of the user, or the email if there is none.
+func (u *userResource) nickname(email string) string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name != nil {
+		if u.Name.Formatted != "" {
+			return u.Name.Formatted
+		}
+		if name := strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName); name != "" {
+			return name
+		}
+	}
+	return email
+}
+
+func convertUserFromStore(c echo.Context, user *store.User) *userResource {
+	active := user.RowStatus != storepb.RowStatus_ARCHIVED
+	return &userResource{
+		Schemas:     []string{schemaUser},
+		ID:          fmt.Sprint(user.ID),
+		UserName:    user.Email,
+		Name:        &userName{Formatted: user.Nickname},
+		DisplayName: user.Nickname,
+		Emails:      []*email{{Value: user.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta:        newMeta(c, "User", user.ID, user.CreatedTs, user.UpdatedTs),
+	}
+}
+
+func (s *SCIMService) listUsers(c echo.Context) error {
+	ctx := c.Request().Context()
+	f, err := parseFilter(c.QueryParam("filter"), "id", "username", "emails.value")
+	if err != nil {
+		return err
+	}
+	find := &store.FindUser{}
+	if f != nil {
+		if f.attribute == "id" {
+			id, err := util.ConvertStringToInt32(f.value)
+			if err != nil {
+				id = -1
+			}
+			find.ID = &id
+		} else {
+			find.Email = &f.value
+		}
+	}
+	users, err := s.Store.ListUsers(ctx, find)
+	if err != nil {
+		return errors.Wrap(err, "failed to list users")
+	}
+
+	response, page := paginate(c, users)
+	for _, user := range page {
+		response.Resources = append(response.Resources, convertUserFromStore(c, user))
+	}
+	return writeJSON(c, http.StatusOK, response)
+}
+
+func (s *SCIMService) getUser(c echo.Context) error {
+	user, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	return writeJSON(c, http.StatusOK, convertUserFromStore(c, user))
+}
+
+// createUser provisions the user with the USER role, who signs in with SSO as the password is random.
+func (s *SCIMService) createUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	resource := &userResource{}
+	if err := readJSON(c, resource); err != nil {
+		return err
+	}
+	email, err := resource.email()
+	if err != nil {
+		return err
+	}
+	if err := s.checkEmailAvailable(c, email, 0); err != nil {
+		return err
+	}
+	if err := s.checkSeatAvailability(c); err != nil {
+		return err
+	}
+
+	password, err := util.RandomString(20)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate random password")
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate password hash")
+	}
+	user, err := s.Store.CreateUser(ctx, &store.User{
+		Email:        email,
+		Nickname:     resource.nickname(email),
+		Role:         store.RoleUser,
+		PasswordHash: string(passwordHash),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create user")
+	}
+	if resource.Active != nil && !*resource.Active {
+		rowStatus := storepb.RowStatus_ARCHIVED
+		if user, err = s.Store.UpdateUser(ctx, &store.UpdateUser{
+			ID:        user.ID,
+			RowStatus: &rowStatus,
+		}); err != nil {
+			return errors.Wrap(err, "failed to archive user")
+		}
+	}
+	return writeJSON(c, http.StatusCreated, convertUserFromStore(c, user))
+}
+
+// replaceUser replaces the email, the nickname and the state of the user, which is active if omitted.
+func (s *SCIMService) replaceUser(c echo.Context) error {
+	user, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	resource := &userResource{}
+	if err := readJSON(c, resource); err != nil {
+		return err
+	}
+	email, err := resource.email()
+	if err != nil {
+		return err
+	}
+	nickname := resource.nickname(email)
+	rowStatus := storepb.RowStatus_NORMAL
+	if resource.Active != nil && !*resource.Active {
+		rowStatus = storepb.RowStatus_ARCHIVED
+	}
+
+	update := &store.UpdateUser{
+		ID: user.ID,
+	}
+	if email != user.Email {
+		if err := s.checkEmailAvailable(c, email, user.ID); err != nil {
+			return err
+		}
+		update.Email = &email
+	}
+	if nickname != user.Nickname {
+		update.Nickname = &nickname
+	}
+	if rowStatus != user.RowStatus {
+		update.RowStatus = &rowStatus
+	}
+	if user, err = s.updateUser(c, user, update); err != nil {
+		return err
+	}
+	return writeJSON(c, http.StatusOK, convertUserFromStore(c, user))
+}
+
+// patchUser applies the operations on the email, the nickname and the state of the user, e.g. archives the
+// user with `{"op": "replace", "path": "active", "value": false}`. The attributes not stored are ignored.
+func (s *SCIMService) patchUser(c echo.Context) error {
+	user, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	request := &patchRequest{}
+	if err := readJSON(c, request); err != nil {
+		return err
+	}
+
+	update := &store.UpdateUser{
+		ID: user.ID,
+	}
+	for _, operation := range request.Operations {
+		op := strings.ToLower(operation.Op)
+		if op != "add" && op != "replace" && op != "remove" {
+			return newError(http.StatusBadRequest, "invalidSyntax", fmt.Sprintf("unsupported operation %q", operation.Op))
+		}
+		if op == "remove" {
+			switch strings.ToLower(operation.Path) {
+			case "displayname", "name", "name.formatted":
+				update.Nickname = &user.Email
+			case "active", "username":
+				return newError(http.StatusBadRequest, "mutability", fmt.Sprintf("%s cannot be removed", operation.Path))
+			}
+			continue
+		}
+
+		attributes, err := operation.attributes()
+		if err != nil {
+			return err
+		}
+		for attribute, value := range attributes {
+			if err := applyUserAttribute(update, attribute, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	if update.Email != nil && *update.Email != user.Email {
+		if err := s.checkEmailAvailable(c, *update.Email, user.ID); err != nil {
+			return err
+		}
+	}
+	if user, err = s.updateUser(c, user, update); err != nil {
+		return err
+	}
+	return writeJSON(c, http.StatusOK, convertUserFromStore(c, user))
+}
+
+// applyUserAttribute sets the update by the lower-cased attribute.
+func applyUserAttribute(update *store.UpdateUser, attribute string, value json.RawMessage) error {
+	switch attribute {
+	case "active":
+		active, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		rowStatus := storepb.RowStatus_NORMAL
+		if !active {
+			rowStatus = storepb.RowStatus_ARCHIVED
+		}
+		update.RowStatus = &rowStatus
+	case "username":
+		email, err := parseString(value)
+		if err != nil {
+			return err
+		}
+		if !util.ValidateEmail(email) {
+			return newError(http.StatusBadRequest, "invalidValue", "userName must be an email address")
+		}
+		update.Email = &email
+	case "displayname", "name.formatted":
+		nickname, err := parseString(value)
+		if err != nil {
+			return err
+		}
+		if nickname != "" {
+			update.Nickname = &nickname
+		}
+	case "name":
+		name := &userName{}
+		if err := json.Unmarshal(value, name); err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", "invalid name value")
+		}
+		if name.Formatted != "" {
+			update.Nickname = &name.Formatted
+		}
+	}
+	return nil
+}
+
+// deleteUser deletes the user, who must not own shortcuts or collections, which would be deleted along.
+func (s *SCIMService) deleteUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	// The check runs in the transaction of the deletion, so that no shortcut or collection created meanwhile
+	// is deleted along with the user.
+	if err := s.Store.RunInTx(ctx, func(txStore *store.Store) error {
+		shortcuts, err := txStore.ListShortcuts(ctx, &store.FindShortcut{
+			CreatorID: &user.ID,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list shortcuts")
+		}
+		collections, err := txStore.ListCollections(ctx, &store.FindCollection{
+			CreatorID: &user.ID,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list collections")
+		}
+		if len(shortcuts) != 0 || len(collections) != 0 {
+			return newError(http.StatusConflict, "", fmt.Sprintf("user owns %d shortcuts and %d collections, deactivate the user or transfer them first", len(shortcuts), len(collections)))
+		}
+		if err := txStore.DeleteUser(ctx, &store.DeleteUser{ID: user.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete user")
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *SCIMService) findUser(c echo.Context) (*store.User, error) {
+	id, err := parseID(c, "user")
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.Store.GetUser(c.Request().Context(), &store.FindUser{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	if user == nil {
+		return nil, newError(http.StatusNotFound, "", fmt.Sprintf("user %d not found", id))
+	}
+	return user, nil
+}
+
+// updateUser updates the user if anything changes.
+func (s *SCIMService) updateUser(c echo.Context, user *store.User, update *store.UpdateUser) (*store.User, error) {
+	if update.Email == nil && update.Nickname == nil && update.RowStatus == nil {
+		return user, nil
+	}
+	user, err := s.Store.UpdateUser(c.Request().Context(), update)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update user")
+	}
+	return user, nil
+}
+
+// checkEmailAvailable returns a conflict error if the email is used by another user than the given one.
+func (s *SCIMService) checkEmailAvailable(c echo.Context, email string, userID int32) error {
+	user, err := s.Store.GetUser(c.Request().Context(), &store.FindUser{
+		Email: &email,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user != nil && user.ID != userID {
+		return newError(http.StatusConflict, "uniqueness", fmt.Sprintf("user %q already exists", email))
+	}
+	return nil
+}
+
+func (s *SCIMService) checkSeatAvailability(c echo.Context) error {
+	if s.LicenseService.IsFeatureEnabled(license.FeatureTypeUnlimitedAccounts) {
+		return nil
+	}
+	users, err := s.Store.ListUsers(c.Request().Context(), &store.FindUser{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list users")
+	}
+	if seats := s.LicenseService.GetSubscription().Seats; len(users) >= int(seats) {
+		return newError(http.StatusForbidden, "", fmt.Sprintf("maximum number of users %d reached", seats))
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Package activity provides a runner to compact the shortcut view activities after the retention days.
+package activity
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/yourselfhosted/slash/internal/cron"
+	"github.com/yourselfhosted/slash/store"
+)
+
+type Runner struct {
+	Store *store.Store
+
+	// mutex prevents the compactions from overlapping, if one takes longer than the schedule.
+	mutex sync.Mutex
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule runner at minute 30 past every hour.
+// Only the whole days are compacted, so it mostly has nothing to do except after midnight.
+const runnerSchedule = "30 * * * *"
+
+func (r *Runner) Run(ctx context.Context) {
+	c := cron.New()
+	c.MustAdd("compactActivities", runnerSchedule, func() {
+		r.RunOnce(ctx)
+	})
+	c.Start()
+	<-ctx.Done()
+	c.Stop()
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	if !r.mutex.TryLock() {
+		return
+	}
+	defer r.mutex.Unlock()
+
+	shortcutRelatedSetting, err := r.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get workspace shortcut related setting", slog.Any("error", err))
+		return
+	}
+	retentionDays := int(shortcutRelatedSetting.ActivityRetentionDays)
+	if retentionDays <= 0 {
+		return
+	}
+	createdBefore := time.Now().AddDate(0, 0, -retentionDays).Unix()
+	count, err := r.Store.CompactShortcutViewActivities(ctx, createdBefore)
+	if err != nil {
+		slog.Error("failed to compact shortcut view activities", slog.Any("error", err))
+	}
+	if count > 0 {
+		slog.Info("compacted shortcut view activities", slog.Int("count", count))
+	}
+}
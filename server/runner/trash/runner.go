@@ -0,0 +1,61 @@
+// Package trash provides a runner to purge the archived shortcuts after the retention days.
+package trash
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule runner every hour.
+const runnerInterval = time.Hour
+
+// defaultRetentionDays is the retention days of the trash if not set in the workspace setting.
+const defaultRetentionDays = 30
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	shortcutRelatedSetting, err := r.Store.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get workspace shortcut related setting", slog.Any("error", err))
+		return
+	}
+	retentionDays := int(shortcutRelatedSetting.TrashRetentionDays)
+	if retentionDays <= 0 {
+		retentionDays = defaultRetentionDays
+	}
+	archivedBefore := time.Now().AddDate(0, 0, -retentionDays).Unix()
+	count, err := r.Store.PurgeArchivedShortcuts(ctx, archivedBefore)
+	if err != nil {
+		slog.Error("failed to purge archived shortcuts", slog.Any("error", err))
+		return
+	}
+	if count > 0 {
+		slog.Info("purged archived shortcuts", slog.Int("count", count))
+	}
+}
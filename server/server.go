@@ -16,9 +16,13 @@ import (
 	"github.com/yourselfhosted/slash/server/profile"
 	apiv1 "github.com/yourselfhosted/slash/server/route/api/v1"
 	"github.com/yourselfhosted/slash/server/route/frontend"
+	"github.com/yourselfhosted/slash/server/route/scim"
+	"github.com/yourselfhosted/slash/server/runner/activity"
 	licensern "github.com/yourselfhosted/slash/server/runner/license"
+	"github.com/yourselfhosted/slash/server/runner/trash"
 	"github.com/yourselfhosted/slash/server/runner/version"
 	"github.com/yourselfhosted/slash/server/service/license"
+	"github.com/yourselfhosted/slash/server/service/recorder"
 	"github.com/yourselfhosted/slash/store"
 )
 
@@ -30,6 +34,8 @@ type Server struct {
 	Secret  string
 
 	licenseService *license.LicenseService
+	// activityRecorder records the shortcut views off the request path.
+	activityRecorder *recorder.Recorder
 
 	// API services.
 	apiV1Service *apiv1.APIV1Service
@@ -44,16 +50,13 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	licenseService := license.NewLicenseService(profile, store)
 
 	s := &Server{
-		e:              e,
-		Profile:        profile,
-		Store:          store,
-		licenseService: licenseService,
+		e:                e,
+		Profile:          profile,
+		Store:            store,
+		licenseService:   licenseService,
+		activityRecorder: recorder.NewRecorder(store),
 	}
 
-	// Serve frontend.
-	frontendService := frontend.NewFrontendService(profile, store)
-	frontendService.Serve(ctx, e)
-
 	// In dev mode, we'd like to set the const secret key to make signin session persistence.
 	secret := "slash"
 	if profile.Mode == "prod" {
@@ -65,22 +68,30 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	}
 	s.Secret = secret
 
+	// Serve frontend.
+	frontendService := frontend.NewFrontendService(profile, store, secret, s.activityRecorder)
+	frontendService.Serve(ctx, e)
+
 	// Register healthz endpoint.
 	e.GET("/healthz", func(c echo.Context) error {
 		return c.String(http.StatusOK, "Service ready.")
 	})
 
-	s.apiV1Service = apiv1.NewAPIV1Service(secret, profile, store, licenseService, s.Profile.Port+1)
+	s.apiV1Service = apiv1.NewAPIV1Service(secret, profile, store, licenseService, s.activityRecorder, s.Profile.Port+1)
 	// Register gRPC gateway as api v1.
 	if err := s.apiV1Service.RegisterGateway(ctx, e); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
 	}
 
+	// Register SCIM provisioning endpoints.
+	scim.NewSCIMService(store, licenseService).RegisterRoutes(e)
+
 	return s, nil
 }
 
 func (s *Server) Start(ctx context.Context) error {
 	s.StartBackgroundRunners(ctx)
+	go s.activityRecorder.Run(ctx)
 	// Start gRPC server.
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", s.Profile.Port+1))
 	if err != nil {
@@ -104,6 +115,13 @@ func (s *Server) Shutdown(ctx context.Context) {
 		fmt.Printf("failed to shutdown server, error: %v\n", err)
 	}
 
+	// Flush the queued activities before closing the database.
+	if err := s.activityRecorder.Close(ctx); err != nil {
+		fmt.Printf("failed to flush activities, error: %v\n", err)
+	}
+	stats := s.activityRecorder.Stats()
+	slog.Info("activity recorder stopped", slog.Int64("flushed", stats.Flushed), slog.Int64("dropped", stats.Dropped), slog.Int64("failed", stats.Failed))
+
 	// Close database connection.
 	if err := s.Store.Close(); err != nil {
 		fmt.Printf("failed to close database, error: %v\n", err)
@@ -121,9 +139,15 @@ func (s *Server) StartBackgroundRunners(ctx context.Context) {
 	licenseRunner.RunOnce(ctx)
 	versionRunner := version.NewRunner(s.Store, s.Profile)
 	versionRunner.RunOnce(ctx)
+	trashRunner := trash.NewRunner(s.Store)
+	trashRunner.RunOnce(ctx)
+	// The activity runner is not run on startup, as compacting a large backlog of activities may take a while.
+	activityRunner := activity.NewRunner(s.Store)
 
 	go licenseRunner.Run(ctx)
 	go versionRunner.Run(ctx)
+	go trashRunner.Run(ctx)
+	go activityRunner.Run(ctx)
 }
 
 func (s *Server) getSecretSession(ctx context.Context) (string, error) {
@@ -0,0 +1,168 @@
+// Package recorder provides a buffered pipeline to record the activities off the request path,
+// flushing them into the store in batches.
+package recorder
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+const (
+	// defaultQueueSize bounds the memory of the queued activities.
+	defaultQueueSize = 10000
+	// defaultBatchSize is the number of the queued activities that triggers a flush before the interval.
+	defaultBatchSize = 500
+	// defaultFlushInterval is the interval to flush the queued activities.
+	defaultFlushInterval = time.Second
+)
+
+// Stats are the counters of the recorder since it's created.
+type Stats struct {
+	// Queued is the number of the activities accepted into the queue.
+	Queued int64
+	// Dropped is the number of the activities dropped as the queue is full, or the recorder is closed.
+	Dropped int64
+	// Flushed is the number of the activities written into the store.
+	Flushed int64
+	// Failed is the number of the activities failed to be written into the store.
+	Failed int64
+	// QueueLength is the current number of the queued activities.
+	QueueLength int
+}
+
+// Recorder queues the activities in memory and writes them into the store in batches.
+// When the queue is full, the new activities are dropped instead of blocking the caller.
+type Recorder struct {
+	Store *store.Store
+
+	queue         chan *store.Activity
+	batchSize     int
+	flushInterval time.Duration
+
+	queued  atomic.Int64
+	dropped atomic.Int64
+	flushed atomic.Int64
+	failed  atomic.Int64
+
+	// mutex guards closed, so no activity is sent to the queue after it's closed.
+	mutex  sync.RWMutex
+	closed bool
+	done   chan struct{}
+}
+
+func NewRecorder(s *store.Store) *Recorder {
+	return &Recorder{
+		Store:         s,
+		queue:         make(chan *store.Activity, defaultQueueSize),
+		batchSize:     defaultBatchSize,
+		flushInterval: defaultFlushInterval,
+		done:          make(chan struct{}),
+	}
+}
+
+// Record queues the activity without blocking. It returns false if the activity is dropped.
+func (r *Recorder) Record(activity *store.Activity) bool {
+	if activity.CreatedTs == 0 {
+		// The activity is created at the time it happens, not when it's flushed.
+		activity.CreatedTs = time.Now().Unix()
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	if r.closed {
+		r.dropped.Add(1)
+		return false
+	}
+	select {
+	case r.queue <- activity:
+		r.queued.Add(1)
+		return true
+	default:
+		r.dropped.Add(1)
+		return false
+	}
+}
+
+// Run flushes the queued activities until the recorder is closed.
+func (r *Recorder) Run(ctx context.Context) {
+	defer close(r.done)
+	// Keep flushing after the context is canceled on shutdown, until the queue is drained by Close.
+	ctx = context.WithoutCancel(ctx)
+
+	ticker := time.NewTicker(r.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*store.Activity, 0, r.batchSize)
+	lastDropped := int64(0)
+	for {
+		select {
+		case activity, ok := <-r.queue:
+			if !ok {
+				// The queue is closed and drained.
+				r.flush(ctx, batch)
+				return
+			}
+			batch = append(batch, activity)
+			if len(batch) >= r.batchSize {
+				r.flush(ctx, batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			r.flush(ctx, batch)
+			batch = batch[:0]
+			if dropped := r.dropped.Load(); dropped > lastDropped {
+				slog.Warn("dropped activities as the recorder queue is full", slog.Int64("count", dropped-lastDropped), slog.Int("queueSize", cap(r.queue)))
+				lastDropped = dropped
+			}
+		}
+	}
+}
+
+// Close stops accepting the activities, and waits for the queued ones to be flushed
+// until the context is done.
+func (r *Recorder) Close(ctx context.Context) error {
+	r.mutex.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.queue)
+	}
+	r.mutex.Unlock()
+
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrapf(ctx.Err(), "failed to flush %d queued activities", len(r.queue))
+	}
+}
+
+// Stats returns the counters of the recorder.
+func (r *Recorder) Stats() *Stats {
+	return &Stats{
+		Queued:      r.queued.Load(),
+		Dropped:     r.dropped.Load(),
+		Flushed:     r.flushed.Load(),
+		Failed:      r.failed.Load(),
+		QueueLength: len(r.queue),
+	}
+}
+
+func (r *Recorder) flush(ctx context.Context, batch []*store.Activity) {
+	if len(batch) == 0 {
+		return
+	}
+	// The batch is created in one transaction, so it either fails or is flushed as a whole.
+	if err := r.Store.CreateActivities(ctx, batch); err != nil {
+		r.failed.Add(int64(len(batch)))
+		slog.Error("failed to flush activities", slog.Int("count", len(batch)), slog.Any("error", err))
+		return
+	}
+	r.flushed.Add(int64(len(batch)))
+}
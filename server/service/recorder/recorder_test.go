@@ -0,0 +1,63 @@
+package recorder
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/store"
+	teststore "github.com/yourselfhosted/slash/store/test"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	r := NewRecorder(ts)
+	r.flushInterval = time.Hour
+	r.batchSize = 2
+	go r.Run(ctx)
+
+	for i := 0; i < 3; i++ {
+		require.True(t, r.Record(&store.Activity{
+			Type:    store.ActivityShortcutView,
+			Level:   store.ActivityInfo,
+			Payload: fmt.Sprintf(`{"shortcutId":%d}`, i+1),
+		}))
+	}
+	// The full batch is flushed before the interval, and the rest is flushed on close.
+	require.Eventually(t, func() bool {
+		return r.Stats().Flushed == 2
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Close(ctx))
+	require.False(t, r.Record(&store.Activity{
+		Type:  store.ActivityShortcutView,
+		Level: store.ActivityInfo,
+	}))
+	require.Equal(t, &Stats{
+		Queued:  3,
+		Dropped: 1,
+		Flushed: 3,
+	}, r.Stats())
+
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type: store.ActivityShortcutView,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(activities))
+	ts.Close()
+}
+
+func TestRecorderDropsWhenQueueIsFull(t *testing.T) {
+	r := NewRecorder(nil)
+	r.queue = make(chan *store.Activity, 1)
+	require.True(t, r.Record(&store.Activity{}))
+	require.False(t, r.Record(&store.Activity{}))
+	require.Equal(t, &Stats{
+		Queued:      1,
+		Dropped:     1,
+		QueueLength: 1,
+	}, r.Stats())
+}
@@ -2,6 +2,13 @@ package store
 
 import (
 	"context"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 )
 
 type ActivityType string
@@ -11,14 +18,41 @@ const (
 	ActivityShortcutCreate ActivityType = "shortcut.create"
 	// ActivityShortcutView is the activity type of shortcut view.
 	ActivityShortcutView ActivityType = "shortcut.view"
+
+	// The audit activity types record the mutating API calls by the resources they change.
+	ActivityAuditAuth            ActivityType = "audit.auth"
+	ActivityAuditWorkspace       ActivityType = "audit.workspace"
+	ActivityAuditSubscription    ActivityType = "audit.subscription"
+	ActivityAuditUser            ActivityType = "audit.user"
+	ActivityAuditUserAccessToken ActivityType = "audit.user_access_token"
+	ActivityAuditUserSetting     ActivityType = "audit.user_setting"
+	ActivityAuditShortcut        ActivityType = "audit.shortcut"
+	ActivityAuditCollection      ActivityType = "audit.collection"
+	ActivityAuditGroup           ActivityType = "audit.group"
 )
 
+// AuditActivityTypes are all the audit activity types.
+var AuditActivityTypes = []ActivityType{
+	ActivityAuditAuth,
+	ActivityAuditWorkspace,
+	ActivityAuditSubscription,
+	ActivityAuditUser,
+	ActivityAuditUserAccessToken,
+	ActivityAuditUserSetting,
+	ActivityAuditShortcut,
+	ActivityAuditCollection,
+	ActivityAuditGroup,
+}
+
 func (t ActivityType) String() string {
 	switch t {
 	case ActivityShortcutCreate:
 		return "shortcut.create"
 	case ActivityShortcutView:
 		return "shortcut.view"
+	case ActivityAuditAuth, ActivityAuditWorkspace, ActivityAuditSubscription, ActivityAuditUser,
+		ActivityAuditUserAccessToken, ActivityAuditUserSetting, ActivityAuditShortcut, ActivityAuditCollection, ActivityAuditGroup:
+		return string(t)
 	}
 	return ""
 }
@@ -57,13 +91,141 @@ type Activity struct {
 
 type FindActivity struct {
 	Type              ActivityType
+	TypeList          []ActivityType
 	Level             ActivityLevel
+	CreatorID         *int32
 	PayloadShortcutID *int32
 	CreatedTsAfter    *int64
+	CreatedTsBefore   *int64
+
+	// The fields of the audit payload.
+	PayloadMethod   *string
+	PayloadResource *string
+	PayloadCode     *string
+
+	// OrderDesc lists the activities in descending order of the id, otherwise ascending.
+	OrderDesc bool
+
+	// Pagination
+	Limit  *int
+	Offset *int
 }
 
+// ShortcutViewDimension is a field of the shortcut view payload that the views can be grouped by.
+type ShortcutViewDimension string
+
+const (
+	ShortcutViewDimensionReferer   ShortcutViewDimension = "referer"
+	ShortcutViewDimensionUserAgent ShortcutViewDimension = "userAgent"
+	// ShortcutViewDimensionShortcutID groups the views by the shortcut id, in decimal.
+	ShortcutViewDimensionShortcutID ShortcutViewDimension = "shortcutId"
+)
+
+type FindShortcutViewAnalytics struct {
+	// ShortcutID is the shortcut to aggregate the views of, or all the shortcuts if nil.
+	ShortcutID *int32
+	// CreatedTsStart is inclusive and CreatedTsEnd is exclusive.
+	CreatedTsStart *int64
+	CreatedTsEnd   *int64
+}
+
+// ShortcutViewTimeBucket is the aggregated views in a time bucket.
+type ShortcutViewTimeBucket struct {
+	BucketTs           int64
+	ViewCount          int32
+	UniqueVisitorCount int32
+}
+
+// ShortcutViewDimensionCount is the view count of a value of a dimension.
+type ShortcutViewDimensionCount struct {
+	Value string
+	Count int32
+}
+
+// CreateActivity creates the activity, and increases the view stat of the shortcut in the same transaction
+// if it is a view.
 func (s *Store) CreateActivity(ctx context.Context, create *Activity) (*Activity, error) {
-	return s.driver.CreateActivity(ctx, create)
+	var activity *Activity
+	if err := s.driver.RunInTx(ctx, func(driver Driver) error {
+		var err error
+		if activity, err = driver.CreateActivity(ctx, create); err != nil {
+			return err
+		}
+		if activity.Type != ActivityShortcutView {
+			return nil
+		}
+		payload := &storepb.ActivityShorcutViewPayload{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal([]byte(activity.Payload), payload); err != nil {
+			return errors.Wrap(err, "failed to unmarshal shortcut view payload")
+		}
+		if err := driver.IncreaseShortcutViewStat(ctx, &ShortcutViewStat{
+			ShortcutID: payload.ShortcutId,
+			BucketTs:   GetShortcutViewStatBucketTs(activity.CreatedTs),
+			ViewCount:  1,
+		}); err != nil {
+			return errors.Wrap(err, "failed to increase shortcut view stat")
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
+
+// maxActivityBatchSize is the maximum number of the activities inserted in one statement,
+// which keeps the number of the bound parameters below the limits of the drivers.
+const maxActivityBatchSize = 500
+
+// CreateActivities creates the activities in batches of multi-row inserts, and increases the view stats of the
+// shortcuts, all in one transaction. The activities without the created timestamp are created at the current time.
+func (s *Store) CreateActivities(ctx context.Context, creates []*Activity) error {
+	now := time.Now().Unix()
+	for _, create := range creates {
+		if create.CreatedTs == 0 {
+			create.CreatedTs = now
+		}
+	}
+
+	// Increase the view stats once per shortcut and day instead of once per view.
+	stats := []*ShortcutViewStat{}
+	statMap := map[ShortcutViewStat]*ShortcutViewStat{}
+	for _, create := range creates {
+		if create.Type != ActivityShortcutView {
+			continue
+		}
+		payload := &storepb.ActivityShorcutViewPayload{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal([]byte(create.Payload), payload); err != nil {
+			return errors.Wrap(err, "failed to unmarshal shortcut view payload")
+		}
+		key := ShortcutViewStat{
+			ShortcutID: payload.ShortcutId,
+			BucketTs:   GetShortcutViewStatBucketTs(create.CreatedTs),
+		}
+		stat, ok := statMap[key]
+		if !ok {
+			stat = &ShortcutViewStat{
+				ShortcutID: key.ShortcutID,
+				BucketTs:   key.BucketTs,
+			}
+			statMap[key] = stat
+			stats = append(stats, stat)
+		}
+		stat.ViewCount++
+	}
+
+	return s.driver.RunInTx(ctx, func(driver Driver) error {
+		for batch := range slices.Chunk(creates, maxActivityBatchSize) {
+			if err := driver.CreateActivities(ctx, batch); err != nil {
+				return err
+			}
+		}
+		for _, stat := range stats {
+			if err := driver.IncreaseShortcutViewStat(ctx, stat); err != nil {
+				return errors.Wrap(err, "failed to increase shortcut view stat")
+			}
+		}
+		return nil
+	})
 }
 
 func (s *Store) ListActivities(ctx context.Context, find *FindActivity) ([]*Activity, error) {
@@ -83,3 +245,32 @@ func (s *Store) GetActivity(ctx context.Context, find *FindActivity) (*Activity,
 	activity := list[0]
 	return activity, nil
 }
+
+// ListShortcutViewTimeBuckets aggregates the shortcut views into the time buckets of the given size in seconds.
+// The buckets are aligned to the multiples of the size shifted by the offset, and the empty buckets are omitted.
+// The views of the compacted activities are added from the daily summaries.
+func (s *Store) ListShortcutViewTimeBuckets(ctx context.Context, find *FindShortcutViewAnalytics, bucketSize, bucketOffset int64) ([]*ShortcutViewTimeBucket, error) {
+	buckets, err := s.driver.ListShortcutViewTimeBuckets(ctx, find, bucketSize, bucketOffset)
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := s.listShortcutViewSummariesOfAnalytics(ctx, find)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list shortcut view summaries")
+	}
+	return mergeShortcutViewSummaryTimeBuckets(buckets, summaries, bucketSize, bucketOffset), nil
+}
+
+// CountShortcutViewsByDimension returns the view counts by the values of the dimension, in descending order of the count.
+// The views of the compacted activities are added from the daily summaries.
+func (s *Store) CountShortcutViewsByDimension(ctx context.Context, find *FindShortcutViewAnalytics, dimension ShortcutViewDimension) ([]*ShortcutViewDimensionCount, error) {
+	counts, err := s.driver.CountShortcutViewsByDimension(ctx, find, dimension)
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := s.listShortcutViewSummariesOfAnalytics(ctx, find)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list shortcut view summaries")
+	}
+	return mergeShortcutViewSummaryDimensionCounts(counts, summaries, dimension), nil
+}
@@ -0,0 +1,500 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// WorkspaceArchiveFormatVersion is the current version of the workspace archive format.
+const WorkspaceArchiveFormatVersion = 3
+
+type ExportWorkspace struct {
+	// SlashVersion is the version of Slash recorded in the archive.
+	SlashVersion string
+	// IncludeActivities includes the activities, which can be much larger than the rest of the workspace.
+	IncludeActivities bool
+	// ExcludeSecrets excludes the access tokens of the users and the session secret of the workspace.
+	ExcludeSecrets bool
+}
+
+// ImportWorkspaceResult is the summary of a workspace import.
+type ImportWorkspaceResult struct {
+	CreatedUsers       int
+	CreatedShortcuts   int
+	CreatedCollections int
+	CreatedActivities  int
+	CreatedGroups      int
+	// The existing records with the same email or name are kept as they are.
+	SkippedUsers       int
+	SkippedShortcuts   int
+	SkippedCollections int
+	SkippedGroups      int
+}
+
+// ExportWorkspace exports the whole workspace into an archive.
+func (s *Store) ExportWorkspace(ctx context.Context, export *ExportWorkspace) (*storepb.WorkspaceArchive, error) {
+	archive := &storepb.WorkspaceArchive{
+		FormatVersion: WorkspaceArchiveFormatVersion,
+		SlashVersion:  export.SlashVersion,
+		CreatedTs:     time.Now().Unix(),
+	}
+
+	users, err := s.ListUsers(ctx, &FindUser{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list users")
+	}
+	for _, user := range users {
+		archive.Users = append(archive.Users, &storepb.WorkspaceArchive_User{
+			Id:           user.ID,
+			CreatedTs:    user.CreatedTs,
+			UpdatedTs:    user.UpdatedTs,
+			RowStatus:    user.RowStatus,
+			Email:        user.Email,
+			Nickname:     user.Nickname,
+			PasswordHash: user.PasswordHash,
+			Role:         string(user.Role),
+		})
+	}
+
+	userSettings, err := s.ListUserSettings(ctx, &FindUserSetting{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user settings")
+	}
+	for _, userSetting := range userSettings {
+		if export.ExcludeSecrets && userSetting.Key == storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS {
+			continue
+		}
+		archive.UserSettings = append(archive.UserSettings, userSetting)
+	}
+
+	if archive.Shortcuts, err = s.ListShortcuts(ctx, &FindShortcut{
+		OrderBy:  ShortcutOrderByCreatedTs,
+		OrderAsc: true,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to list shortcuts")
+	}
+	if archive.Collections, err = s.ListCollections(ctx, &FindCollection{}); err != nil {
+		return nil, errors.Wrap(err, "failed to list collections")
+	}
+
+	groups, err := s.ListGroups(ctx, &FindGroup{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list groups")
+	}
+	for _, group := range groups {
+		members, err := s.ListGroupMembers(ctx, &FindGroupMember{
+			GroupID: &group.ID,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list members of group %s", group.Name)
+		}
+		archivedGroup := &storepb.WorkspaceArchive_Group{
+			Id:          group.ID,
+			CreatedTs:   group.CreatedTs,
+			UpdatedTs:   group.UpdatedTs,
+			Name:        group.Name,
+			Description: group.Description,
+		}
+		for _, member := range members {
+			archivedGroup.Members = append(archivedGroup.Members, &storepb.WorkspaceArchive_Group_Member{
+				UserId: member.UserID,
+				Role:   string(member.Role),
+			})
+		}
+		archive.Groups = append(archive.Groups, archivedGroup)
+	}
+
+	workspaceSettings, err := s.ListWorkspaceSettings(ctx, &FindWorkspaceSetting{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list workspace settings")
+	}
+	for _, workspaceSetting := range workspaceSettings {
+		if export.ExcludeSecrets && workspaceSetting.GetGeneral() != nil {
+			general := proto.Clone(workspaceSetting.GetGeneral()).(*storepb.WorkspaceSetting_GeneralSetting)
+			general.SecretSession = ""
+			workspaceSetting = &storepb.WorkspaceSetting{
+				Key:   workspaceSetting.Key,
+				Value: &storepb.WorkspaceSetting_General{General: general},
+			}
+		}
+		archive.WorkspaceSettings = append(archive.WorkspaceSettings, workspaceSetting)
+	}
+
+	if export.IncludeActivities {
+		// The audit logs are not archived, as they are not restored either.
+		activities, err := s.ListActivities(ctx, &FindActivity{
+			TypeList: []ActivityType{ActivityShortcutCreate, ActivityShortcutView},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list activities")
+		}
+		for _, activity := range activities {
+			archive.Activities = append(archive.Activities, &storepb.WorkspaceArchive_Activity{
+				Id:        activity.ID,
+				CreatorId: activity.CreatorID,
+				CreatedTs: activity.CreatedTs,
+				Type:      activity.Type.String(),
+				Level:     activity.Level.String(),
+				Payload:   activity.Payload,
+			})
+		}
+		// The compacted view activities only remain in the summaries.
+		summaries, err := s.ListShortcutViewSummaries(ctx, &FindShortcutViewSummary{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list shortcut view summaries")
+		}
+		for _, summary := range summaries {
+			archive.ShortcutViewSummaries = append(archive.ShortcutViewSummaries, &storepb.WorkspaceArchive_ShortcutViewSummary{
+				ShortcutId:         summary.ShortcutID,
+				BucketTs:           summary.BucketTs,
+				ViewCount:          summary.ViewCount,
+				UniqueVisitorCount: summary.UniqueVisitorCount,
+				RefererCounts:      summary.RefererCounts,
+				UserAgentCounts:    summary.UserAgentCounts,
+			})
+		}
+	}
+	return archive, nil
+}
+
+// ImportWorkspace restores the archive into the workspace in one transaction, so that nothing is restored on failure.
+// The records get new ids in the workspace, and the references between them are rewritten accordingly. Users are
+// matched by email, shortcuts, collections and groups by name, and the existing ones are kept. The workspace settings in the
+// archive replace the existing ones.
+func (s *Store) ImportWorkspace(ctx context.Context, archive *storepb.WorkspaceArchive) (*ImportWorkspaceResult, error) {
+	if archive.FormatVersion < 1 || archive.FormatVersion > WorkspaceArchiveFormatVersion {
+		return nil, errors.Errorf("unsupported archive format version %d", archive.FormatVersion)
+	}
+	var result *ImportWorkspaceResult
+	if err := s.RunInTx(ctx, func(txStore *Store) error {
+		var err error
+		result, err = txStore.importWorkspace(ctx, archive)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *Store) importWorkspace(ctx context.Context, archive *storepb.WorkspaceArchive) (*ImportWorkspaceResult, error) {
+	result := &ImportWorkspaceResult{}
+
+	// The ids of the records in the archive mapped to the ones in the workspace.
+	userIDMap := map[int32]int32{}
+	createdUserIDs := map[int32]bool{}
+	for _, archivedUser := range archive.Users {
+		user, err := s.GetUser(ctx, &FindUser{
+			Email: &archivedUser.Email,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %s", archivedUser.Email)
+		}
+		if user != nil {
+			userIDMap[archivedUser.Id] = user.ID
+			result.SkippedUsers++
+			continue
+		}
+		role := Role(archivedUser.Role)
+		if role != RoleAdmin && !slices.Contains(ConfigurableRoles, role) {
+			return nil, errors.Errorf("invalid role %q of user %s", archivedUser.Role, archivedUser.Email)
+		}
+		user, err = s.CreateUser(ctx, &User{
+			CreatedTs:    archivedUser.CreatedTs,
+			UpdatedTs:    archivedUser.UpdatedTs,
+			Email:        archivedUser.Email,
+			Nickname:     archivedUser.Nickname,
+			PasswordHash: archivedUser.PasswordHash,
+			Role:         role,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create user %s", archivedUser.Email)
+		}
+		if archivedUser.RowStatus == storepb.RowStatus_ARCHIVED {
+			rowStatus := storepb.RowStatus_ARCHIVED
+			if _, err := s.UpdateUser(ctx, &UpdateUser{
+				ID:        user.ID,
+				RowStatus: &rowStatus,
+			}); err != nil {
+				return nil, errors.Wrapf(err, "failed to archive user %s", archivedUser.Email)
+			}
+		}
+		userIDMap[archivedUser.Id] = user.ID
+		createdUserIDs[user.ID] = true
+		result.CreatedUsers++
+	}
+
+	for _, userSetting := range archive.UserSettings {
+		userID, ok := userIDMap[userSetting.UserId]
+		// The settings of the existing users are kept.
+		if !ok || !createdUserIDs[userID] {
+			continue
+		}
+		// The access tokens are bound to the user id, so they are only valid if the id is unchanged.
+		if userSetting.Key == storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS && userID != userSetting.UserId {
+			continue
+		}
+		upsert := &storepb.UserSetting{
+			UserId: userID,
+			Key:    userSetting.Key,
+			Value:  userSetting.Value,
+		}
+		if _, err := s.UpsertUserSetting(ctx, upsert); err != nil {
+			return nil, errors.Wrapf(err, "failed to upsert user setting %s", userSetting.Key)
+		}
+	}
+
+	groupIDMap := map[int32]int32{}
+	for _, archivedGroup := range archive.Groups {
+		group, err := s.GetGroup(ctx, &FindGroup{
+			Name: &archivedGroup.Name,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get group %s", archivedGroup.Name)
+		}
+		// The members of the existing groups are kept.
+		if group != nil {
+			groupIDMap[archivedGroup.Id] = group.ID
+			result.SkippedGroups++
+			continue
+		}
+		group, err = s.CreateGroup(ctx, &Group{
+			CreatedTs:   archivedGroup.CreatedTs,
+			UpdatedTs:   archivedGroup.UpdatedTs,
+			Name:        archivedGroup.Name,
+			Description: archivedGroup.Description,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create group %s", archivedGroup.Name)
+		}
+		for _, archivedMember := range archivedGroup.Members {
+			userID, ok := userIDMap[archivedMember.UserId]
+			if !ok {
+				continue
+			}
+			role := GroupRole(archivedMember.Role)
+			if role != GroupRoleEditor && role != GroupRoleViewer {
+				return nil, errors.Errorf("invalid role %q of member %d in group %s", archivedMember.Role, archivedMember.UserId, archivedGroup.Name)
+			}
+			if _, err := s.UpsertGroupMember(ctx, &GroupMember{
+				GroupID: group.ID,
+				UserID:  userID,
+				Role:    role,
+			}); err != nil {
+				return nil, errors.Wrapf(err, "failed to add member %d to group %s", archivedMember.UserId, archivedGroup.Name)
+			}
+		}
+		groupIDMap[archivedGroup.Id] = group.ID
+		result.CreatedGroups++
+	}
+
+	shortcutIDMap := map[int32]int32{}
+	createdShortcutIDs := map[int32]bool{}
+	for _, archivedShortcut := range archive.Shortcuts {
+		creatorID, ok := userIDMap[archivedShortcut.CreatorId]
+		if !ok {
+			continue
+		}
+		if strings.HasPrefix(archivedShortcut.Name, PersonalNamespacePrefix) {
+			return nil, errors.Errorf("invalid name %s of shortcut, which must not start with %q", archivedShortcut.Name, PersonalNamespacePrefix)
+		}
+		namespaceID := WorkspaceNamespaceID
+		if archivedShortcut.NamespaceId != WorkspaceNamespaceID {
+			// The personal shortcuts stay in the namespace of the same user.
+			if namespaceID, ok = userIDMap[archivedShortcut.NamespaceId]; !ok {
+				continue
+			}
+		}
+		shortcut, err := s.GetShortcut(ctx, &FindShortcut{
+			Name:        &archivedShortcut.Name,
+			NamespaceID: &namespaceID,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get shortcut %s", archivedShortcut.Name)
+		}
+		if shortcut != nil {
+			shortcutIDMap[archivedShortcut.Id] = shortcut.Id
+			result.SkippedShortcuts++
+			continue
+		}
+		create := &storepb.Shortcut{
+			CreatorId:    creatorID,
+			CreatedTs:    archivedShortcut.CreatedTs,
+			UpdatedTs:    archivedShortcut.UpdatedTs,
+			RowStatus:    archivedShortcut.RowStatus,
+			Name:         archivedShortcut.Name,
+			Link:         archivedShortcut.Link,
+			Title:        archivedShortcut.Title,
+			Tags:         archivedShortcut.Tags,
+			Description:  archivedShortcut.Description,
+			Visibility:   archivedShortcut.Visibility,
+			OgMetadata:   archivedShortcut.OgMetadata,
+			LinkTemplate: archivedShortcut.LinkTemplate,
+			NamespaceId:  namespaceID,
+			// The shortcuts of the groups missing in the archive are only owned by their creators.
+			GroupId: groupIDMap[archivedShortcut.GroupId],
+		}
+		if create.OgMetadata == nil {
+			create.OgMetadata = &storepb.OpenGraphMetadata{}
+		}
+		shortcut, err = s.CreateShortcut(ctx, create)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create shortcut %s", archivedShortcut.Name)
+		}
+		shortcutIDMap[archivedShortcut.Id] = shortcut.Id
+		createdShortcutIDs[shortcut.Id] = true
+		result.CreatedShortcuts++
+	}
+
+	for _, archivedCollection := range archive.Collections {
+		creatorID, ok := userIDMap[archivedCollection.CreatorId]
+		if !ok {
+			continue
+		}
+		collection, err := s.GetCollection(ctx, &FindCollection{
+			Name: &archivedCollection.Name,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get collection %s", archivedCollection.Name)
+		}
+		if collection != nil {
+			result.SkippedCollections++
+			continue
+		}
+		shortcutIDs := []int32{}
+		for _, shortcutID := range archivedCollection.ShortcutIds {
+			if id, ok := shortcutIDMap[shortcutID]; ok {
+				shortcutIDs = append(shortcutIDs, id)
+			}
+		}
+		if _, err := s.CreateCollection(ctx, &storepb.Collection{
+			CreatorId:   creatorID,
+			CreatedTs:   archivedCollection.CreatedTs,
+			UpdatedTs:   archivedCollection.UpdatedTs,
+			Name:        archivedCollection.Name,
+			Title:       archivedCollection.Title,
+			Description: archivedCollection.Description,
+			ShortcutIds: shortcutIDs,
+			Visibility:  archivedCollection.Visibility,
+			GroupId:     groupIDMap[archivedCollection.GroupId],
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to create collection %s", archivedCollection.Name)
+		}
+		result.CreatedCollections++
+	}
+
+	for _, workspaceSetting := range archive.WorkspaceSettings {
+		if general := workspaceSetting.GetGeneral(); general != nil && general.SecretSession == "" {
+			// Keep the session secret of the workspace if it is excluded from the archive.
+			existing, err := s.GetWorkspaceGeneralSetting(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get workspace general setting")
+			}
+			restored := proto.Clone(general).(*storepb.WorkspaceSetting_GeneralSetting)
+			restored.SecretSession = existing.GetSecretSession()
+			workspaceSetting = &storepb.WorkspaceSetting{
+				Key:   workspaceSetting.Key,
+				Value: &storepb.WorkspaceSetting_General{General: restored},
+			}
+		}
+		if _, err := s.UpsertWorkspaceSetting(ctx, workspaceSetting); err != nil {
+			return nil, errors.Wrapf(err, "failed to upsert workspace setting %s", workspaceSetting.Key)
+		}
+	}
+
+	for _, archivedActivity := range archive.Activities {
+		creatorID, ok := userIDMap[archivedActivity.CreatorId]
+		if !ok {
+			// The activities created by the bot are kept as they are.
+			creatorID = archivedActivity.CreatorId
+		}
+		payload, ok, err := rewriteActivityPayloadShortcutID(archivedActivity.Payload, shortcutIDMap)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to rewrite payload of activity %d", archivedActivity.Id)
+		}
+		// Only import the activities of the created shortcuts, so that importing an archive again does not count them twice.
+		if !ok || !createdShortcutIDs[payload.ShortcutId] {
+			continue
+		}
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal activity payload")
+		}
+		if _, err := s.CreateActivity(ctx, &Activity{
+			CreatorID: creatorID,
+			CreatedTs: archivedActivity.CreatedTs,
+			Type:      ActivityType(archivedActivity.Type),
+			Level:     ActivityLevel(archivedActivity.Level),
+			Payload:   string(payloadBytes),
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to create activity %d", archivedActivity.Id)
+		}
+		result.CreatedActivities++
+	}
+
+	for _, archivedSummary := range archive.ShortcutViewSummaries {
+		shortcutID, ok := shortcutIDMap[archivedSummary.ShortcutId]
+		// Only import the summaries of the created shortcuts, the same as the activities.
+		if !ok || !createdShortcutIDs[shortcutID] {
+			continue
+		}
+		if err := s.UpsertShortcutViewSummary(ctx, &ShortcutViewSummary{
+			ShortcutID:         shortcutID,
+			BucketTs:           archivedSummary.BucketTs,
+			ViewCount:          archivedSummary.ViewCount,
+			UniqueVisitorCount: archivedSummary.UniqueVisitorCount,
+			RefererCounts:      archivedSummary.RefererCounts,
+			UserAgentCounts:    archivedSummary.UserAgentCounts,
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to upsert view summary of shortcut %d", shortcutID)
+		}
+		// The view stats are rebuilt from the summaries along with the activities.
+		if err := s.IncreaseShortcutViewStat(ctx, &ShortcutViewStat{
+			ShortcutID: shortcutID,
+			BucketTs:   GetShortcutViewStatBucketTs(archivedSummary.BucketTs),
+			ViewCount:  archivedSummary.ViewCount,
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to increase view stat of shortcut %d", shortcutID)
+		}
+	}
+	return result, nil
+}
+
+// MarshalWorkspaceArchive encodes the archive in JSON.
+func MarshalWorkspaceArchive(archive *storepb.WorkspaceArchive) ([]byte, error) {
+	return protojson.MarshalOptions{Indent: "  "}.Marshal(archive)
+}
+
+// UnmarshalWorkspaceArchive decodes the archive encoded in JSON or in binary protobuf.
+func UnmarshalWorkspaceArchive(content []byte) (*storepb.WorkspaceArchive, error) {
+	archive := &storepb.WorkspaceArchive{}
+	if err := protojson.Unmarshal(content, archive); err != nil {
+		if binaryErr := proto.Unmarshal(content, archive); binaryErr != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal archive")
+		}
+	}
+	return archive, nil
+}
+
+// rewriteActivityPayloadShortcutID rewrites the shortcut id in the activity payload with the id map.
+// It returns false if the shortcut is not in the map.
+func rewriteActivityPayloadShortcutID(rawPayload string, shortcutIDMap map[int32]int32) (*storepb.ActivityShorcutViewPayload, bool, error) {
+	// The payloads of all the activity types share the shortcut id field, which the view payload is a superset of.
+	payload := &storepb.ActivityShorcutViewPayload{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal([]byte(rawPayload), payload); err != nil {
+		return nil, false, err
+	}
+	shortcutID, ok := shortcutIDMap[payload.ShortcutId]
+	if !ok {
+		return nil, false, nil
+	}
+	payload.ShortcutId = shortcutID
+	return payload, true, nil
+}
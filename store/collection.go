@@ -14,6 +14,7 @@ type UpdateCollection struct {
 	Description *string
 	ShortcutIDs []int32
 	Visibility  *storepb.Visibility
+	GroupID     *int32
 }
 
 type FindCollection struct {
@@ -21,6 +22,11 @@ type FindCollection struct {
 	CreatorID      *int32
 	Name           *string
 	VisibilityList []storepb.Visibility
+	// PrivateCreatorID excludes the private collections not created by the user.
+	PrivateCreatorID *int32
+	// PrivateGroupIDList includes the private collections owned by the groups along with PrivateCreatorID.
+	PrivateGroupIDList []int32
+	GroupID            *int32
 }
 
 type DeleteCollection struct {
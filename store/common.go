@@ -13,8 +13,11 @@ func ConvertRowStatusStringToStorepb(status string) storepb.RowStatus {
 }
 
 func ConvertVisibilityStringToStorepb(visibility string) storepb.Visibility {
-	if visibility == "PUBLIC" {
+	switch visibility {
+	case "PUBLIC":
 		return storepb.Visibility_PUBLIC
+	case "PRIVATE":
+		return storepb.Visibility_PRIVATE
 	}
 	// Otherwise, fallback to workspace visibility.
 	return storepb.Visibility_WORKSPACE
@@ -0,0 +1,183 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// copiedTable is a table copied by CopyDatabase.
+type copiedTable struct {
+	name    string
+	columns []string
+	// hasSerialID is true if the table has an auto-increment id column, whose sequence needs to be fixed up on postgres.
+	hasSerialID bool
+}
+
+// copiedTables are the tables to copy, ordered by the foreign key references between them.
+// The migration history is not copied, as the target has its own after applying the latest schema.
+var copiedTables = []copiedTable{
+	{name: "workspace_setting", columns: []string{"key", "value"}},
+	{name: "user", columns: []string{"id", "created_ts", "updated_ts", "row_status", "email", "nickname", "password_hash", "role"}, hasSerialID: true},
+	{name: "user_setting", columns: []string{"user_id", "key", "value"}},
+	{name: "user_group", columns: []string{"id", "created_ts", "updated_ts", "name", "description"}, hasSerialID: true},
+	{name: "user_group_member", columns: []string{"group_id", "user_id", "role"}},
+	{name: "shortcut", columns: []string{"id", "creator_id", "created_ts", "updated_ts", "row_status", "name", "link", "title", "description", "visibility", "tag", "og_metadata", "link_template", "namespace_id", "group_id"}, hasSerialID: true},
+	{name: "shortcut_view_stat", columns: []string{"shortcut_id", "bucket_ts", "view_count"}},
+	{name: "shortcut_view_summary", columns: []string{"shortcut_id", "bucket_ts", "view_count", "unique_visitor_count", "referer_counts", "user_agent_counts"}},
+	{name: "shortcut_revision", columns: []string{"id", "shortcut_id", "creator_id", "created_ts", "old_value", "new_value"}, hasSerialID: true},
+	{name: "collection", columns: []string{"id", "creator_id", "created_ts", "updated_ts", "name", "title", "description", "shortcut_ids", "visibility", "group_id"}, hasSerialID: true},
+	{name: "activity", columns: []string{"id", "creator_id", "created_ts", "type", "level", "payload"}, hasSerialID: true},
+}
+
+// CopyTableResult is the number of rows copied of a table.
+type CopyTableResult struct {
+	Table string
+	Rows  int
+}
+
+// CopyDatabase copies every table of the source into the target, preserving the ids and timestamps.
+// Both stores must be migrated to the latest schema, and the target must be empty.
+// The row counts of the tables are verified after copying.
+func CopyDatabase(ctx context.Context, source, target *Store) ([]*CopyTableResult, error) {
+	sourceDB, targetDB := source.driver.GetDB(), target.driver.GetDB()
+	for _, table := range copiedTables {
+		count, err := countTableRows(ctx, targetDB, table.name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to count rows of table %s in target", table.name)
+		}
+		if count != 0 {
+			return nil, errors.Errorf("target database is not empty, table %s has %d rows", table.name, count)
+		}
+	}
+
+	tx, err := targetDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	results := []*CopyTableResult{}
+	for _, table := range copiedTables {
+		rows, err := copyTable(ctx, sourceDB, tx, table, source.profile.Driver, target.profile.Driver)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to copy table %s", table.name)
+		}
+		results = append(results, &CopyTableResult{
+			Table: table.name,
+			Rows:  rows,
+		})
+	}
+	if target.profile.Driver == "postgres" {
+		for _, table := range copiedTables {
+			if !table.hasSerialID {
+				continue
+			}
+			// Make the next id after the copied ones, otherwise creating a new record conflicts with them.
+			stmt := fmt.Sprintf(`SELECT setval(pg_get_serial_sequence('%s', 'id'), COALESCE((SELECT MAX(id) FROM %s), 0) + 1, false)`, quoteIdentifier(table.name), quoteIdentifier(table.name))
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return nil, errors.Wrapf(err, "failed to fix up the id sequence of table %s", table.name)
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		sourceCount, err := countTableRows(ctx, sourceDB, result.Table)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to count rows of table %s in source", result.Table)
+		}
+		targetCount, err := countTableRows(ctx, targetDB, result.Table)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to count rows of table %s in target", result.Table)
+		}
+		if sourceCount != targetCount {
+			return nil, errors.Errorf("row count mismatch of table %s, source has %d rows and target has %d rows", result.Table, sourceCount, targetCount)
+		}
+	}
+	return results, nil
+}
+
+func copyTable(ctx context.Context, sourceDB *sql.DB, tx *sql.Tx, table copiedTable, sourceDriver, targetDriver string) (int, error) {
+	columns := []string{}
+	for _, column := range table.columns {
+		columns = append(columns, quoteIdentifier(column))
+	}
+	placeholders := []string{}
+	for i := range table.columns {
+		if targetDriver == "postgres" {
+			placeholders = append(placeholders, fmt.Sprintf("$%d", i+1))
+		} else {
+			placeholders = append(placeholders, "?")
+		}
+	}
+	insertStmt, err := tx.PrepareContext(ctx, fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", quoteIdentifier(table.name), strings.Join(columns, ", "), strings.Join(placeholders, ", ")))
+	if err != nil {
+		return 0, err
+	}
+	defer insertStmt.Close()
+
+	rows, err := sourceDB.QueryContext(ctx, fmt.Sprintf("SELECT %s FROM %s", strings.Join(columns, ", "), quoteIdentifier(table.name)))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		values := make([]any, len(table.columns))
+		dest := make([]any, len(table.columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return 0, err
+		}
+		for i, column := range table.columns {
+			if bytes, ok := values[i].([]byte); ok {
+				values[i] = string(bytes)
+			}
+			if column == "shortcut_ids" {
+				values[i] = convertShortcutIDs(fmt.Sprint(values[i]), sourceDriver, targetDriver)
+			}
+		}
+		if _, err := insertStmt.ExecContext(ctx, values...); err != nil {
+			return 0, err
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// convertShortcutIDs converts the shortcut ids of a collection between the drivers.
+// They are stored as "1,2,3" in sqlite and as an integer array "{1,2,3}" in postgres.
+func convertShortcutIDs(value, sourceDriver, targetDriver string) string {
+	if sourceDriver == targetDriver {
+		return value
+	}
+	if targetDriver == "postgres" {
+		return "{" + value + "}"
+	}
+	return strings.Trim(value, "{}")
+}
+
+func countTableRows(ctx context.Context, db *sql.DB, table string) (int, error) {
+	count := 0
+	if err := db.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM %s", quoteIdentifier(table))).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// quoteIdentifier quotes the identifier, as some of the names are reserved words, e.g. "user" in postgres.
+func quoteIdentifier(name string) string {
+	return `"` + name + `"`
+}
@@ -14,8 +14,14 @@ import (
 )
 
 func (d *DB) CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error) {
-	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility"}
-	args := []any{create.CreatorId, create.Name, create.Title, create.Description, pq.Array(create.ShortcutIds), create.Visibility.String()}
+	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility", "group_id"}
+	args := []any{create.CreatorId, create.Name, create.Title, create.Description, pq.Array(create.ShortcutIds), create.Visibility.String(), create.GroupId}
+	if create.CreatedTs != 0 {
+		set, args = append(set, "created_ts"), append(args, create.CreatedTs)
+	}
+	if create.UpdatedTs != 0 {
+		set, args = append(set, "updated_ts"), append(args, create.UpdatedTs)
+	}
 
 	stmt := `
 		INSERT INTO collection (` + strings.Join(set, ", ") + `)
@@ -50,6 +56,9 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 	if update.Visibility != nil {
 		set, args = append(set, "visibility = "+placeholder(len(args)+1)), append(args, update.Visibility.String())
 	}
+	if update.GroupID != nil {
+		set, args = append(set, "group_id = "+placeholder(len(args)+1)), append(args, *update.GroupID)
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -58,7 +67,7 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		UPDATE collection
 		SET ` + strings.Join(set, ", ") + `
 		WHERE id = ` + placeholder(len(args)+1) + `
-		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility
+		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, group_id
 	`
 	args = append(args, update.ID)
 	collection := &storepb.Collection{}
@@ -74,6 +83,7 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		&collection.Description,
 		pq.Array(&shortcutIDs),
 		&visibility,
+		&collection.GroupId,
 	); err != nil {
 		return nil, err
 	}
@@ -102,10 +112,17 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 	if v := find.VisibilityList; len(v) != 0 {
 		list := []string{}
 		for _, visibility := range v {
-			list, args = append(list, placeholder(len(args)+1)), append(args, visibility)
+			list, args = append(list, placeholder(len(args)+1)), append(args, visibility.String())
 		}
 		where = append(where, fmt.Sprintf("visibility IN (%s)", strings.Join(list, ",")))
 	}
+	if v := find.PrivateCreatorID; v != nil {
+		condition, conditionArgs := privateCondition(*v, find.PrivateGroupIDList, len(args))
+		where, args = append(where, condition), append(args, conditionArgs...)
+	}
+	if v := find.GroupID; v != nil {
+		where, args = append(where, "group_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -117,7 +134,8 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			title,
 			description,
 			shortcut_ids,
-			visibility
+			visibility,
+			group_id
 		FROM collection
 		WHERE `+strings.Join(where, " AND ")+`
 		ORDER BY created_ts DESC`,
@@ -143,6 +161,7 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			&collection.Description,
 			pq.Array(&shortcutIDs),
 			&visibility,
+			&collection.GroupId,
 		); err != nil {
 			return nil, err
 		}
@@ -24,3 +24,24 @@ func placeholders(n int) string {
 	}
 	return strings.Join(list, ", ")
 }
+
+// privateCondition returns the condition excluding the private records not created by the user,
+// nor owned by any of the groups. The placeholders are numbered after the given number of args.
+func privateCondition(creatorID int32, groupIDList []int32, argCount int) (string, []any) {
+	conditions, args := []string{"visibility != 'PRIVATE'", "creator_id = " + placeholder(argCount+1)}, []any{creatorID}
+	if len(groupIDList) != 0 {
+		list := []string{}
+		for _, groupID := range groupIDList {
+			list, args = append(list, placeholder(argCount+len(args)+1)), append(args, groupID)
+		}
+		conditions = append(conditions, fmt.Sprintf("group_id IN (%s)", strings.Join(list, ",")))
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern, which is used with `ESCAPE '\'`.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
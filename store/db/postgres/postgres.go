@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"log"
 
@@ -10,10 +11,11 @@ import (
 
 	"github.com/yourselfhosted/slash/server/profile"
 	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db/sqltx"
 )
 
 type DB struct {
-	db      *sql.DB
+	db      *sqltx.DB
 	profile *profile.Profile
 }
 
@@ -30,16 +32,24 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 	}
 
 	var driver store.Driver = &DB{
-		db:      db,
+		db:      sqltx.New(db),
 		profile: profile,
 	}
 	return driver, nil
 }
 
 func (d *DB) GetDB() *sql.DB {
-	return d.db
+	return d.db.DB()
 }
 
 func (d *DB) Close() error {
-	return d.db.Close()
+	return d.db.DB().Close()
+}
+
+// RunInTx runs the function with a driver whose statements run in one transaction, which is committed
+// if the function returns nil and rolled back otherwise.
+func (d *DB) RunInTx(ctx context.Context, fn func(driver store.Driver) error) error {
+	return d.db.RunInTx(ctx, func(txDB *sqltx.DB) error {
+		return fn(&DB{db: txDB, profile: d.profile})
+	})
 }
@@ -13,8 +13,8 @@ import (
 )
 
 func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
-	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag"}
-	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " ")}
+	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag", "link_template", "namespace_id", "group_id"}
+	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " "), create.LinkTemplate, create.NamespaceId, create.GroupId}
 	if create.OgMetadata != nil {
 		set = append(set, "og_metadata")
 		openGraphMetadataBytes, err := protojson.Marshal(create.OgMetadata)
@@ -23,25 +23,43 @@ func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*sto
 		}
 		args = append(args, string(openGraphMetadataBytes))
 	}
+	if create.CreatedTs != 0 {
+		set, args = append(set, "created_ts"), append(args, create.CreatedTs)
+	}
+	if create.UpdatedTs != 0 {
+		set, args = append(set, "updated_ts"), append(args, create.UpdatedTs)
+	}
+	if create.RowStatus != storepb.RowStatus_ROW_STATUS_UNSPECIFIED {
+		set, args = append(set, "row_status"), append(args, create.RowStatus.String())
+	}
 
 	stmt := fmt.Sprintf(`
 		INSERT INTO shortcut (%s)
 		VALUES (%s)
-		RETURNING id, created_ts, updated_ts
+		RETURNING id, created_ts, updated_ts, row_status
 	`, strings.Join(set, ","), placeholders(len(args)))
+	var rowStatus string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.Id,
 		&create.CreatedTs,
 		&create.UpdatedTs,
+		&rowStatus,
 	); err != nil {
 		return nil, err
 	}
+	create.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut := create
 	return shortcut, nil
 }
 
 func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (*storepb.Shortcut, error) {
 	set, args := []string{}, []any{}
+	if update.UpdatedTs != nil {
+		set, args = append(set, fmt.Sprintf("updated_ts = $%d", len(args)+1)), append(args, *update.UpdatedTs)
+	}
+	if update.RowStatus != nil {
+		set, args = append(set, fmt.Sprintf("row_status = $%d", len(args)+1)), append(args, update.RowStatus.String())
+	}
 	if update.Name != nil {
 		set, args = append(set, fmt.Sprintf("name = $%d", len(args)+1)), append(args, *update.Name)
 	}
@@ -67,6 +85,15 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		}
 		set, args = append(set, fmt.Sprintf("og_metadata = $%d", len(args)+1)), append(args, string(openGraphMetadataBytes))
 	}
+	if update.LinkTemplate != nil {
+		set, args = append(set, fmt.Sprintf("link_template = $%d", len(args)+1)), append(args, *update.LinkTemplate)
+	}
+	if update.NamespaceID != nil {
+		set, args = append(set, fmt.Sprintf("namespace_id = $%d", len(args)+1)), append(args, *update.NamespaceID)
+	}
+	if update.GroupID != nil {
+		set, args = append(set, fmt.Sprintf("group_id = $%d", len(args)+1)), append(args, *update.GroupID)
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -76,16 +103,17 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		UPDATE shortcut
 		SET %s
 		WHERE id = $%d
-		RETURNING id, creator_id, created_ts, updated_ts, name, link, title, description, visibility, tag, og_metadata
+		RETURNING id, creator_id, created_ts, updated_ts, row_status, name, link, title, description, visibility, tag, og_metadata, link_template, namespace_id, group_id
 	`, strings.Join(set, ","), len(args))
 
 	shortcut := &storepb.Shortcut{}
-	var visibility, tags, openGraphMetadataString string
+	var rowStatus, visibility, tags, openGraphMetadataString string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&shortcut.Id,
 		&shortcut.CreatorId,
 		&shortcut.CreatedTs,
 		&shortcut.UpdatedTs,
+		&rowStatus,
 		&shortcut.Name,
 		&shortcut.Link,
 		&shortcut.Title,
@@ -93,9 +121,13 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		&visibility,
 		&tags,
 		&openGraphMetadataString,
+		&shortcut.LinkTemplate,
+		&shortcut.NamespaceId,
+		&shortcut.GroupId,
 	); err != nil {
 		return nil, err
 	}
+	shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
 	shortcut.Tags = filterTags(strings.Split(tags, " "))
 	var ogMetadata storepb.OpenGraphMetadata
@@ -114,38 +146,78 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, fmt.Sprintf("creator_id = %s", placeholder(len(args)+1))), append(args, *v)
 	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, fmt.Sprintf("row_status = %s", placeholder(len(args)+1))), append(args, v.String())
+	}
 	if v := find.Name; v != nil {
 		where, args = append(where, fmt.Sprintf("name = %s", placeholder(len(args)+1))), append(args, *v)
 	}
+	if v := find.NamespaceID; v != nil {
+		where, args = append(where, fmt.Sprintf("namespace_id = %s", placeholder(len(args)+1))), append(args, *v)
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		list := []string{}
 		for _, visibility := range v {
 			list = append(list, placeholder(len(args)+1))
-			args = append(args, visibility)
+			args = append(args, visibility.String())
 		}
 		where = append(where, fmt.Sprintf("visibility IN (%s)", strings.Join(list, ",")))
 	}
+	if v := find.PrivateCreatorID; v != nil {
+		condition, conditionArgs := privateCondition(*v, find.PrivateGroupIDList, len(args))
+		where, args = append(where, condition), append(args, conditionArgs...)
+	}
+	if v := find.GroupID; v != nil {
+		where, args = append(where, fmt.Sprintf("group_id = %s", placeholder(len(args)+1))), append(args, *v)
+	}
 	if v := find.Tag; v != nil {
 		where, args = append(where, fmt.Sprintf("tag LIKE %s", placeholder(len(args)+1))), append(args, "%"+*v+"%")
 	}
+	if v := find.NamePrefix; v != nil {
+		where, args = append(where, fmt.Sprintf("name LIKE %s ESCAPE '\\'", placeholder(len(args)+1))), append(args, escapeLike(*v)+"%")
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, "created_ts > "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, "created_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.UpdatedTsAfter; v != nil {
+		where, args = append(where, "updated_ts > "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.UpdatedTsBefore; v != nil {
+		where, args = append(where, "updated_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
 
-	rows, err := d.db.QueryContext(ctx, fmt.Sprintf(`
+	query := fmt.Sprintf(`
 		SELECT
 			id,
 			creator_id,
 			created_ts,
 			updated_ts,
+			row_status,
 			name,
 			link,
 			title,
 			description,
 			visibility,
 			tag,
-			og_metadata
+			og_metadata,
+			link_template,
+			namespace_id,
+			group_id
 		FROM shortcut
 		WHERE %s
-		ORDER BY created_ts DESC
-	`, strings.Join(where, " AND ")), args...)
+		ORDER BY %s
+	`, strings.Join(where, " AND "), shortcutOrderBy(find))
+	if find.Limit != nil {
+		query, args = query+" LIMIT "+placeholder(len(args)+1), append(args, *find.Limit)
+		if find.Offset != nil {
+			query, args = query+" OFFSET "+placeholder(len(args)+1), append(args, *find.Offset)
+		}
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -154,12 +226,13 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	list := make([]*storepb.Shortcut, 0)
 	for rows.Next() {
 		shortcut := &storepb.Shortcut{}
-		var visibility, tags, openGraphMetadataString string
+		var rowStatus, visibility, tags, openGraphMetadataString string
 		if err := rows.Scan(
 			&shortcut.Id,
 			&shortcut.CreatorId,
 			&shortcut.CreatedTs,
 			&shortcut.UpdatedTs,
+			&rowStatus,
 			&shortcut.Name,
 			&shortcut.Link,
 			&shortcut.Title,
@@ -167,9 +240,13 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 			&visibility,
 			&tags,
 			&openGraphMetadataString,
+			&shortcut.LinkTemplate,
+			&shortcut.NamespaceId,
+			&shortcut.GroupId,
 		); err != nil {
 			return nil, err
 		}
+		shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 		shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
 		shortcut.Tags = filterTags(strings.Split(tags, " "))
 		var ogMetadata storepb.OpenGraphMetadata
@@ -191,6 +268,20 @@ func (d *DB) DeleteShortcut(ctx context.Context, delete *store.DeleteShortcut) e
 	return err
 }
 
+// shortcutOrderBy returns the ORDER BY clause of the shortcut list, with id as the tiebreaker to keep pages stable.
+func shortcutOrderBy(find *store.FindShortcut) string {
+	orderBy := store.ShortcutOrderByCreatedTs
+	switch find.OrderBy {
+	case store.ShortcutOrderByUpdatedTs, store.ShortcutOrderByName:
+		orderBy = find.OrderBy
+	}
+	direction := "DESC"
+	if find.OrderAsc {
+		direction = "ASC"
+	}
+	return fmt.Sprintf("%s %s, id %s", orderBy, direction, direction)
+}
+
 func filterTags(tags []string) []string {
 	result := []string{}
 	for _, tag := range tags {
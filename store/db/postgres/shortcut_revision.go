@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) CreateShortcutRevision(ctx context.Context, create *storepb.ShortcutRevision) (*storepb.ShortcutRevision, error) {
+	oldValue, err := protojson.Marshal(create.OldValue)
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := protojson.Marshal(create.NewValue)
+	if err != nil {
+		return nil, err
+	}
+	set := []string{"shortcut_id", "creator_id", "old_value", "new_value"}
+	args := []any{create.ShortcutId, create.CreatorId, string(oldValue), string(newValue)}
+	if create.CreatedTs != 0 {
+		set, args = append(set, "created_ts"), append(args, create.CreatedTs)
+	}
+
+	stmt := `
+		INSERT INTO shortcut_revision (` + strings.Join(set, ", ") + `)
+		VALUES (` + placeholders(len(args)) + `)
+		RETURNING id, created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.Id,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	revision := create
+	return revision, nil
+}
+
+func (d *DB) ListShortcutRevisions(ctx context.Context, find *store.FindShortcutRevision) ([]*storepb.ShortcutRevision, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.ShortcutID; v != nil {
+		where, args = append(where, "shortcut_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			shortcut_id,
+			creator_id,
+			created_ts,
+			old_value,
+			new_value
+		FROM shortcut_revision
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY id DESC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*storepb.ShortcutRevision{}
+	for rows.Next() {
+		revision := &storepb.ShortcutRevision{
+			OldValue: &storepb.Shortcut{},
+			NewValue: &storepb.Shortcut{},
+		}
+		var oldValue, newValue string
+		if err := rows.Scan(
+			&revision.Id,
+			&revision.ShortcutId,
+			&revision.CreatorId,
+			&revision.CreatedTs,
+			&oldValue,
+			&newValue,
+		); err != nil {
+			return nil, err
+		}
+		if err := protojson.Unmarshal([]byte(oldValue), revision.OldValue); err != nil {
+			return nil, err
+		}
+		if err := protojson.Unmarshal([]byte(newValue), revision.NewValue); err != nil {
+			return nil, err
+		}
+		list = append(list, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,219 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func (d *DB) CreateGroup(ctx context.Context, create *store.Group) (*store.Group, error) {
+	set := []string{"name", "description"}
+	args := []any{create.Name, create.Description}
+	if create.CreatedTs != 0 {
+		set, args = append(set, "created_ts"), append(args, create.CreatedTs)
+	}
+	if create.UpdatedTs != 0 {
+		set, args = append(set, "updated_ts"), append(args, create.UpdatedTs)
+	}
+
+	stmt := `
+		INSERT INTO user_group (
+			` + strings.Join(set, ", ") + `
+		)
+		VALUES (` + placeholders(len(args)) + `)
+		RETURNING id, created_ts, updated_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	group := create
+	return group, nil
+}
+
+func (d *DB) UpdateGroup(ctx context.Context, update *store.UpdateGroup) (*store.Group, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Name; v != nil {
+		set, args = append(set, "name = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, "description = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	if len(set) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+
+	stmt := `
+		UPDATE user_group
+		SET ` + strings.Join(set, ", ") + `
+		WHERE id = ` + placeholder(len(args)+1) + `
+		RETURNING id, created_ts, updated_ts, name, description
+	`
+	args = append(args, update.ID)
+	group := &store.Group{}
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&group.ID,
+		&group.CreatedTs,
+		&group.UpdatedTs,
+		&group.Name,
+		&group.Description,
+	); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (d *DB) ListGroups(ctx context.Context, find *store.FindGroup) ([]*store.Group, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Name; v != nil {
+		where, args = append(where, "name = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.IDList; v != nil {
+		if len(v) == 0 {
+			return []*store.Group{}, nil
+		}
+		list := []string{}
+		for _, id := range v {
+			list, args = append(list, placeholder(len(args)+1)), append(args, id)
+		}
+		where = append(where, fmt.Sprintf("id IN (%s)", strings.Join(list, ",")))
+	}
+
+	query := `
+		SELECT
+			id,
+			created_ts,
+			updated_ts,
+			name,
+			description
+		FROM user_group
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY name ASC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.Group, 0)
+	for rows.Next() {
+		group := &store.Group{}
+		if err := rows.Scan(
+			&group.ID,
+			&group.CreatedTs,
+			&group.UpdatedTs,
+			&group.Name,
+			&group.Description,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, group)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteGroup(ctx context.Context, delete *store.DeleteGroup) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_group WHERE id = $1`, delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE shortcut SET group_id = 0 WHERE group_id = $1`, delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE collection SET group_id = 0 WHERE group_id = $1`, delete.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d *DB) UpsertGroupMember(ctx context.Context, upsert *store.GroupMember) (*store.GroupMember, error) {
+	stmt := `
+		INSERT INTO user_group_member (
+			group_id, user_id, role
+		)
+		VALUES ($1, $2, $3)
+		ON CONFLICT(group_id, user_id) DO UPDATE
+		SET role = EXCLUDED.role
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.GroupID, upsert.UserID, upsert.Role); err != nil {
+		return nil, err
+	}
+
+	member := upsert
+	return member, nil
+}
+
+func (d *DB) ListGroupMembers(ctx context.Context, find *store.FindGroupMember) ([]*store.GroupMember, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.GroupID; v != nil {
+		where, args = append(where, "group_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := `
+		SELECT
+			group_id,
+			user_id,
+			role
+		FROM user_group_member
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY group_id ASC, user_id ASC
+	`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.GroupMember, 0)
+	for rows.Next() {
+		member := &store.GroupMember{}
+		if err := rows.Scan(
+			&member.GroupID,
+			&member.UserID,
+			&member.Role,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteGroupMember(ctx context.Context, delete *store.DeleteGroupMember) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM user_group_member WHERE group_id = $1 AND user_id = $2`, delete.GroupID, delete.UserID); err != nil {
+		return err
+	}
+
+	return nil
+}
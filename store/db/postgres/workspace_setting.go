@@ -47,6 +47,12 @@ func (d *DB) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.Workspa
 			return nil, err
 		}
 		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ROLE_PERMISSION {
+		valueBytes, err := protojson.Marshal(upsert.GetRolePermission())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
 	} else {
 		return nil, errors.New("invalid workspace setting key")
 	}
@@ -122,6 +128,14 @@ func (d *DB) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspac
 			workspaceSetting.Value = &storepb.WorkspaceSetting_IdentityProvider{
 				IdentityProvider: workspaceSettingIdentityProvider,
 			}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ROLE_PERMISSION {
+			workspaceSettingRolePermission := &storepb.WorkspaceSetting_RolePermissionSetting{}
+			if err := protojsonUnmarshaler.Unmarshal([]byte(valueString), workspaceSettingRolePermission); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_RolePermission{
+				RolePermission: workspaceSettingRolePermission,
+			}
 		} else if slices.Contains([]storepb.WorkspaceSettingKey{
 			storepb.WorkspaceSettingKey_WORKSPACE_SETTING_LICENSE_KEY,
 			storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECRET_SESSION,
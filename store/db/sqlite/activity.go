@@ -2,28 +2,30 @@ package sqlite
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	"github.com/pkg/errors"
+
 	"github.com/yourselfhosted/slash/store"
 )
 
 func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store.Activity, error) {
+	set := []string{"creator_id", "type", "level", "payload"}
+	args := []any{create.CreatorID, create.Type.String(), create.Level.String(), create.Payload}
+	placeholder := []string{"?", "?", "?", "?"}
+	if create.CreatedTs != 0 {
+		set, args, placeholder = append(set, "created_ts"), append(args, create.CreatedTs), append(placeholder, "?")
+	}
+
 	stmt := `
 		INSERT INTO activity (
-			creator_id,
-			type,
-			level,
-			payload
+			` + strings.Join(set, ", ") + `
 		)
-		VALUES (?, ?, ?, ?)
+		VALUES (` + strings.Join(placeholder, ", ") + `)
 		RETURNING id, created_ts
 	`
-	if err := d.db.QueryRowContext(ctx, stmt,
-		create.CreatorID,
-		create.Type.String(),
-		create.Level.String(),
-		create.Payload,
-	).Scan(
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 	); err != nil {
@@ -34,6 +36,30 @@ func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store
 	return activity, nil
 }
 
+func (d *DB) CreateActivities(ctx context.Context, creates []*store.Activity) error {
+	if len(creates) == 0 {
+		return nil
+	}
+	values, args := []string{}, []any{}
+	for _, create := range creates {
+		values = append(values, "(?, ?, ?, ?, ?)")
+		args = append(args, create.CreatorID, create.CreatedTs, create.Type.String(), create.Level.String(), create.Payload)
+	}
+	stmt := `
+		INSERT INTO activity (
+			creator_id,
+			created_ts,
+			type,
+			level,
+			payload
+		)
+		VALUES ` + strings.Join(values, ", ")
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*store.Activity, error) {
 	where, args := []string{"1 = 1"}, []any{}
 	if find.Type != "" {
@@ -45,10 +71,36 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 	if find.PayloadShortcutID != nil {
 		where, args = append(where, "json_extract(payload, '$.shortcutId') = ?"), append(args, *find.PayloadShortcutID)
 	}
+	if len(find.TypeList) > 0 {
+		list := []string{}
+		for _, t := range find.TypeList {
+			list, args = append(list, "?"), append(args, t.String())
+		}
+		where = append(where, fmt.Sprintf("type IN (%s)", strings.Join(list, ", ")))
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "creator_id = ?"), append(args, *find.CreatorID)
+	}
 	if find.CreatedTsAfter != nil {
 		where, args = append(where, "created_ts > ?"), append(args, *find.CreatedTsAfter)
 	}
+	if find.CreatedTsBefore != nil {
+		where, args = append(where, "created_ts < ?"), append(args, *find.CreatedTsBefore)
+	}
+	if find.PayloadMethod != nil {
+		where, args = append(where, "json_extract(payload, '$.method') = ?"), append(args, *find.PayloadMethod)
+	}
+	if find.PayloadResource != nil {
+		where, args = append(where, "json_extract(payload, '$.resource') = ?"), append(args, *find.PayloadResource)
+	}
+	if find.PayloadCode != nil {
+		where, args = append(where, "json_extract(payload, '$.code') = ?"), append(args, *find.PayloadCode)
+	}
 
+	orderBy := "id ASC"
+	if find.OrderDesc {
+		orderBy = "id DESC"
+	}
 	query := `
 		SELECT
 			id,
@@ -58,7 +110,14 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 			level,
 			payload
 		FROM activity
-		WHERE ` + strings.Join(where, " AND ")
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY ` + orderBy
+	if find.Limit != nil {
+		query, args = query+" LIMIT ?", append(args, *find.Limit)
+		if find.Offset != nil {
+			query, args = query+" OFFSET ?", append(args, *find.Offset)
+		}
+	}
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -88,3 +147,93 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 
 	return list, nil
 }
+
+func (d *DB) ListShortcutViewTimeBuckets(ctx context.Context, find *store.FindShortcutViewAnalytics, bucketSize, bucketOffset int64) ([]*store.ShortcutViewTimeBucket, error) {
+	where, whereArgs := buildShortcutViewAnalyticsWhere(find)
+	args := append([]any{bucketOffset, bucketSize}, whereArgs...)
+	query := `
+		SELECT
+			created_ts - (created_ts - ?) % ? AS bucket_ts,
+			COUNT(*),
+			COUNT(DISTINCT json_extract(payload, '$.ip'))
+		FROM activity
+		WHERE ` + strings.Join(where, " AND ") + `
+		GROUP BY bucket_ts
+		ORDER BY bucket_ts`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutViewTimeBucket{}
+	for rows.Next() {
+		bucket := &store.ShortcutViewTimeBucket{}
+		if err := rows.Scan(
+			&bucket.BucketTs,
+			&bucket.ViewCount,
+			&bucket.UniqueVisitorCount,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, bucket)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) CountShortcutViewsByDimension(ctx context.Context, find *store.FindShortcutViewAnalytics, dimension store.ShortcutViewDimension) ([]*store.ShortcutViewDimensionCount, error) {
+	switch dimension {
+	case store.ShortcutViewDimensionReferer, store.ShortcutViewDimensionUserAgent, store.ShortcutViewDimensionShortcutID:
+	default:
+		return nil, errors.Errorf("unsupported shortcut view dimension %q", dimension)
+	}
+	where, args := buildShortcutViewAnalyticsWhere(find)
+	query := `
+		SELECT
+			COALESCE(json_extract(payload, '$.` + string(dimension) + `'), '') AS value,
+			COUNT(*) AS count
+		FROM activity
+		WHERE ` + strings.Join(where, " AND ") + `
+		GROUP BY value
+		ORDER BY count DESC, value`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutViewDimensionCount{}
+	for rows.Next() {
+		count := &store.ShortcutViewDimensionCount{}
+		if err := rows.Scan(
+			&count.Value,
+			&count.Count,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func buildShortcutViewAnalyticsWhere(find *store.FindShortcutViewAnalytics) ([]string, []any) {
+	where, args := []string{"type = ?"}, []any{store.ActivityShortcutView.String()}
+	if find.ShortcutID != nil {
+		where, args = append(where, "json_extract(payload, '$.shortcutId') = ?"), append(args, *find.ShortcutID)
+	}
+	if find.CreatedTsStart != nil {
+		where, args = append(where, "created_ts >= ?"), append(args, *find.CreatedTsStart)
+	}
+	if find.CreatedTsEnd != nil {
+		where, args = append(where, "created_ts < ?"), append(args, *find.CreatedTsEnd)
+	}
+	return where, args
+}
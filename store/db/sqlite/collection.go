@@ -2,7 +2,6 @@ package sqlite
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"strings"
 
@@ -11,12 +10,19 @@ import (
 	"github.com/yourselfhosted/slash/internal/util"
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db/sqltx"
 )
 
 func (d *DB) CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error) {
-	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility"}
-	args := []any{create.CreatorId, create.Name, create.Title, create.Description, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(create.ShortcutIds)), ","), "[]"), create.Visibility.String()}
-	placeholder := []string{"?", "?", "?", "?", "?", "?"}
+	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility", "group_id"}
+	args := []any{create.CreatorId, create.Name, create.Title, create.Description, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(create.ShortcutIds)), ","), "[]"), create.Visibility.String(), create.GroupId}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
+	if create.CreatedTs != 0 {
+		set, args, placeholder = append(set, "created_ts"), append(args, create.CreatedTs), append(placeholder, "?")
+	}
+	if create.UpdatedTs != 0 {
+		set, args, placeholder = append(set, "updated_ts"), append(args, create.UpdatedTs), append(placeholder, "?")
+	}
 
 	stmt := `
 		INSERT INTO collection (
@@ -53,6 +59,9 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 	if update.Visibility != nil {
 		set, args = append(set, "visibility = ?"), append(args, update.Visibility.String())
 	}
+	if update.GroupID != nil {
+		set, args = append(set, "group_id = ?"), append(args, *update.GroupID)
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -64,7 +73,7 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 			` + strings.Join(set, ", ") + `
 		WHERE
 			id = ?
-		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility
+		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, group_id
 	`
 	collection := &storepb.Collection{}
 	var shortcutIDs, visibility string
@@ -78,6 +87,7 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		&collection.Description,
 		&shortcutIDs,
 		&visibility,
+		&collection.GroupId,
 	); err != nil {
 		return nil, err
 	}
@@ -111,10 +121,17 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 		list := []string{}
 		for _, visibility := range v {
 			list = append(list, fmt.Sprintf("$%d", len(args)+1))
-			args = append(args, visibility)
+			args = append(args, visibility.String())
 		}
 		where = append(where, fmt.Sprintf("visibility in (%s)", strings.Join(list, ",")))
 	}
+	if v := find.PrivateCreatorID; v != nil {
+		condition, conditionArgs := privateCondition(*v, find.PrivateGroupIDList)
+		where, args = append(where, condition), append(args, conditionArgs...)
+	}
+	if v := find.GroupID; v != nil {
+		where, args = append(where, "group_id = ?"), append(args, *v)
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -126,7 +143,8 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			title,
 			description,
 			shortcut_ids,
-			visibility
+			visibility,
+			group_id
 		FROM collection
 		WHERE `+strings.Join(where, " AND ")+`
 		ORDER BY created_ts DESC`,
@@ -151,6 +169,7 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			&collection.Description,
 			&shortcutIDs,
 			&visibility,
+			&collection.GroupId,
 		); err != nil {
 			return nil, err
 		}
@@ -183,7 +202,7 @@ func (d *DB) DeleteCollection(ctx context.Context, delete *store.DeleteCollectio
 	return nil
 }
 
-func vacuumCollection(ctx context.Context, tx *sql.Tx) error {
+func vacuumCollection(ctx context.Context, tx *sqltx.Tx) error {
 	stmt := `DELETE FROM collection WHERE creator_id NOT IN (SELECT id FROM user)`
 	_, err := tx.ExecContext(ctx, stmt)
 	if err != nil {
@@ -1,9 +1,35 @@
 package sqlite
 
-import "google.golang.org/protobuf/encoding/protojson"
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
 
 var (
 	protojsonUnmarshaler = protojson.UnmarshalOptions{
 		DiscardUnknown: true,
 	}
 )
+
+// privateCondition returns the condition excluding the private records not created by the user,
+// nor owned by any of the groups.
+func privateCondition(creatorID int32, groupIDList []int32) (string, []any) {
+	conditions, args := []string{"visibility != 'PRIVATE'", "creator_id = ?"}, []any{creatorID}
+	if len(groupIDList) != 0 {
+		list := []string{}
+		for _, groupID := range groupIDList {
+			list, args = append(list, "?"), append(args, groupID)
+		}
+		conditions = append(conditions, fmt.Sprintf("group_id IN (%s)", strings.Join(list, ",")))
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")", args
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern, which is used with `ESCAPE '\'`.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
@@ -2,7 +2,6 @@ package sqlite
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"strings"
 
@@ -11,12 +10,13 @@ import (
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db/sqltx"
 )
 
 func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
-	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag"}
-	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " ")}
-	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
+	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag", "link_template", "namespace_id", "group_id"}
+	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " "), create.LinkTemplate, create.NamespaceId, create.GroupId}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?", "?"}
 	if create.OgMetadata != nil {
 		set = append(set, "og_metadata")
 		openGraphMetadataBytes, err := protojson.Marshal(create.OgMetadata)
@@ -26,27 +26,45 @@ func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*sto
 		args = append(args, string(openGraphMetadataBytes))
 		placeholder = append(placeholder, "?")
 	}
+	if create.CreatedTs != 0 {
+		set, args, placeholder = append(set, "created_ts"), append(args, create.CreatedTs), append(placeholder, "?")
+	}
+	if create.UpdatedTs != 0 {
+		set, args, placeholder = append(set, "updated_ts"), append(args, create.UpdatedTs), append(placeholder, "?")
+	}
+	if create.RowStatus != storepb.RowStatus_ROW_STATUS_UNSPECIFIED {
+		set, args, placeholder = append(set, "row_status"), append(args, create.RowStatus.String()), append(placeholder, "?")
+	}
 
 	stmt := `
 		INSERT INTO shortcut (
 			` + strings.Join(set, ", ") + `
 		)
 		VALUES (` + strings.Join(placeholder, ",") + `)
-		RETURNING id, created_ts, updated_ts
+		RETURNING id, created_ts, updated_ts, row_status
 	`
+	var rowStatus string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.Id,
 		&create.CreatedTs,
 		&create.UpdatedTs,
+		&rowStatus,
 	); err != nil {
 		return nil, err
 	}
+	create.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut := create
 	return shortcut, nil
 }
 
 func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (*storepb.Shortcut, error) {
 	set, args := []string{}, []any{}
+	if update.UpdatedTs != nil {
+		set, args = append(set, "updated_ts = ?"), append(args, *update.UpdatedTs)
+	}
+	if update.RowStatus != nil {
+		set, args = append(set, "row_status = ?"), append(args, update.RowStatus.String())
+	}
 	if update.Name != nil {
 		set, args = append(set, "name = ?"), append(args, *update.Name)
 	}
@@ -72,6 +90,15 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		}
 		set, args = append(set, "og_metadata = ?"), append(args, string(openGraphMetadataBytes))
 	}
+	if update.LinkTemplate != nil {
+		set, args = append(set, "link_template = ?"), append(args, *update.LinkTemplate)
+	}
+	if update.NamespaceID != nil {
+		set, args = append(set, "namespace_id = ?"), append(args, *update.NamespaceID)
+	}
+	if update.GroupID != nil {
+		set, args = append(set, "group_id = ?"), append(args, *update.GroupID)
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -83,15 +110,16 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 			` + strings.Join(set, ", ") + `
 		WHERE
 			id = ?
-		RETURNING id, creator_id, created_ts, updated_ts, name, link, title, description, visibility, tag, og_metadata
+		RETURNING id, creator_id, created_ts, updated_ts, row_status, name, link, title, description, visibility, tag, og_metadata, link_template, namespace_id, group_id
 	`
 	shortcut := &storepb.Shortcut{}
-	var visibility, tags, openGraphMetadataString string
+	var rowStatus, visibility, tags, openGraphMetadataString string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&shortcut.Id,
 		&shortcut.CreatorId,
 		&shortcut.CreatedTs,
 		&shortcut.UpdatedTs,
+		&rowStatus,
 		&shortcut.Name,
 		&shortcut.Link,
 		&shortcut.Title,
@@ -99,9 +127,13 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		&visibility,
 		&tags,
 		&openGraphMetadataString,
+		&shortcut.LinkTemplate,
+		&shortcut.NamespaceId,
+		&shortcut.GroupId,
 	); err != nil {
 		return nil, err
 	}
+	shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
 	shortcut.Tags = filterTags(strings.Split(tags, " "))
 	var ogMetadata storepb.OpenGraphMetadata
@@ -120,9 +152,15 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, "creator_id = ?"), append(args, *v)
 	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "row_status = ?"), append(args, v.String())
+	}
 	if v := find.Name; v != nil {
 		where, args = append(where, "name = ?"), append(args, *v)
 	}
+	if v := find.NamespaceID; v != nil {
+		where, args = append(where, "namespace_id = ?"), append(args, *v)
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		list := []string{}
 		for _, visibility := range v {
@@ -131,28 +169,60 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 		}
 		where = append(where, fmt.Sprintf("visibility in (%s)", strings.Join(list, ",")))
 	}
+	if v := find.PrivateCreatorID; v != nil {
+		condition, conditionArgs := privateCondition(*v, find.PrivateGroupIDList)
+		where, args = append(where, condition), append(args, conditionArgs...)
+	}
+	if v := find.GroupID; v != nil {
+		where, args = append(where, "group_id = ?"), append(args, *v)
+	}
 	if v := find.Tag; v != nil {
 		where, args = append(where, "tag LIKE ?"), append(args, "%"+*v+"%")
 	}
+	if v := find.NamePrefix; v != nil {
+		where, args = append(where, "name LIKE ? ESCAPE '\\'"), append(args, escapeLike(*v)+"%")
+	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, "created_ts > ?"), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, "created_ts < ?"), append(args, *v)
+	}
+	if v := find.UpdatedTsAfter; v != nil {
+		where, args = append(where, "updated_ts > ?"), append(args, *v)
+	}
+	if v := find.UpdatedTsBefore; v != nil {
+		where, args = append(where, "updated_ts < ?"), append(args, *v)
+	}
 
-	rows, err := d.db.QueryContext(ctx, `
+	query := `
 		SELECT
 			id,
 			creator_id,
 			created_ts,
 			updated_ts,
+			row_status,
 			name,
 			link,
 			title,
 			description,
 			visibility,
 			tag,
-			og_metadata
+			og_metadata,
+			link_template,
+			namespace_id,
+			group_id
 		FROM shortcut
-		WHERE `+strings.Join(where, " AND ")+`
-		ORDER BY created_ts DESC`,
-		args...,
-	)
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY ` + shortcutOrderBy(find)
+	if find.Limit != nil {
+		query, args = query+" LIMIT ?", append(args, *find.Limit)
+		if find.Offset != nil {
+			query, args = query+" OFFSET ?", append(args, *find.Offset)
+		}
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -161,12 +231,13 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	list := make([]*storepb.Shortcut, 0)
 	for rows.Next() {
 		shortcut := &storepb.Shortcut{}
-		var visibility, tags, openGraphMetadataString string
+		var rowStatus, visibility, tags, openGraphMetadataString string
 		if err := rows.Scan(
 			&shortcut.Id,
 			&shortcut.CreatorId,
 			&shortcut.CreatedTs,
 			&shortcut.UpdatedTs,
+			&rowStatus,
 			&shortcut.Name,
 			&shortcut.Link,
 			&shortcut.Title,
@@ -174,9 +245,13 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 			&visibility,
 			&tags,
 			&openGraphMetadataString,
+			&shortcut.LinkTemplate,
+			&shortcut.NamespaceId,
+			&shortcut.GroupId,
 		); err != nil {
 			return nil, err
 		}
+		shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 		shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
 		shortcut.Tags = filterTags(strings.Split(tags, " "))
 		var ogMetadata storepb.OpenGraphMetadata
@@ -194,14 +269,29 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 }
 
 func (d *DB) DeleteShortcut(ctx context.Context, delete *store.DeleteShortcut) error {
-	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut WHERE id = ?`, delete.ID); err != nil {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	return nil
+	if _, err := tx.ExecContext(ctx, `DELETE FROM shortcut WHERE id = ?`, delete.ID); err != nil {
+		return err
+	}
+	if err := vacuumShortcutViewStat(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutViewSummary(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutRevision(ctx, tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
-func vacuumShortcut(ctx context.Context, tx *sql.Tx) error {
+func vacuumShortcut(ctx context.Context, tx *sqltx.Tx) error {
 	stmt := `DELETE FROM shortcut WHERE creator_id NOT IN (SELECT id FROM user)`
 	_, err := tx.ExecContext(ctx, stmt)
 	if err != nil {
@@ -211,6 +301,20 @@ func vacuumShortcut(ctx context.Context, tx *sql.Tx) error {
 	return nil
 }
 
+// shortcutOrderBy returns the ORDER BY clause of the shortcut list, with id as the tiebreaker to keep pages stable.
+func shortcutOrderBy(find *store.FindShortcut) string {
+	orderBy := store.ShortcutOrderByCreatedTs
+	switch find.OrderBy {
+	case store.ShortcutOrderByUpdatedTs, store.ShortcutOrderByName:
+		orderBy = find.OrderBy
+	}
+	direction := "DESC"
+	if find.OrderAsc {
+		direction = "ASC"
+	}
+	return fmt.Sprintf("%s %s, id %s", orderBy, direction, direction)
+}
+
 func filterTags(tags []string) []string {
 	result := []string{}
 	for _, tag := range tags {
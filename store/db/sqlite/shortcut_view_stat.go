@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db/sqltx"
+)
+
+func (d *DB) IncreaseShortcutViewStat(ctx context.Context, increase *store.ShortcutViewStat) error {
+	stmt := `
+		INSERT INTO shortcut_view_stat (
+			shortcut_id,
+			bucket_ts,
+			view_count
+		)
+		VALUES (?, ?, ?)
+		ON CONFLICT(shortcut_id, bucket_ts) DO UPDATE
+		SET view_count = view_count + EXCLUDED.view_count
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, increase.ShortcutID, increase.BucketTs, increase.ViewCount); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) CountShortcutViews(ctx context.Context, find *store.FindShortcutViewStat) (map[int32]int32, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ShortcutIDList; v != nil {
+		if len(v) == 0 {
+			return map[int32]int32{}, nil
+		}
+		list := []string{}
+		for _, id := range v {
+			list, args = append(list, "?"), append(args, id)
+		}
+		where = append(where, "shortcut_id IN ("+strings.Join(list, ",")+")")
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			shortcut_id,
+			SUM(view_count)
+		FROM shortcut_view_stat
+		WHERE `+strings.Join(where, " AND ")+`
+		GROUP BY shortcut_id`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := map[int32]int32{}
+	for rows.Next() {
+		var shortcutID, viewCount int32
+		if err := rows.Scan(&shortcutID, &viewCount); err != nil {
+			return nil, err
+		}
+		counts[shortcutID] = viewCount
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func vacuumShortcutViewStat(ctx context.Context, tx *sqltx.Tx) error {
+	stmt := `DELETE FROM shortcut_view_stat WHERE shortcut_id NOT IN (SELECT id FROM shortcut)`
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db/sqltx"
+)
+
+func (d *DB) ListShortcutViewSummaries(ctx context.Context, find *store.FindShortcutViewSummary) ([]*store.ShortcutViewSummary, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ShortcutID; v != nil {
+		where, args = append(where, "shortcut_id = ?"), append(args, *v)
+	}
+	if v := find.BucketTsStart; v != nil {
+		where, args = append(where, "bucket_ts >= ?"), append(args, *v)
+	}
+	if v := find.BucketTsEnd; v != nil {
+		where, args = append(where, "bucket_ts < ?"), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			shortcut_id,
+			bucket_ts,
+			view_count,
+			unique_visitor_count,
+			referer_counts,
+			user_agent_counts
+		FROM shortcut_view_summary
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY bucket_ts, shortcut_id`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutViewSummary{}
+	for rows.Next() {
+		summary := &store.ShortcutViewSummary{}
+		var refererCounts, userAgentCounts string
+		if err := rows.Scan(
+			&summary.ShortcutID,
+			&summary.BucketTs,
+			&summary.ViewCount,
+			&summary.UniqueVisitorCount,
+			&refererCounts,
+			&userAgentCounts,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(refererCounts), &summary.RefererCounts); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(userAgentCounts), &summary.UserAgentCounts); err != nil {
+			return nil, err
+		}
+		list = append(list, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) UpsertShortcutViewSummary(ctx context.Context, upsert *store.ShortcutViewSummary) error {
+	return upsertShortcutViewSummary(ctx, d.db, upsert)
+}
+
+func upsertShortcutViewSummary(ctx context.Context, tx sqltx.Executor, summary *store.ShortcutViewSummary) error {
+	refererCounts, err := json.Marshal(summary.RefererCounts)
+	if err != nil {
+		return err
+	}
+	userAgentCounts, err := json.Marshal(summary.UserAgentCounts)
+	if err != nil {
+		return err
+	}
+	// The views of the deleted shortcuts are dropped.
+	stmt := `
+		INSERT INTO shortcut_view_summary (
+			shortcut_id,
+			bucket_ts,
+			view_count,
+			unique_visitor_count,
+			referer_counts,
+			user_agent_counts
+		)
+		SELECT ?, ?, ?, ?, ?, ?
+		WHERE EXISTS (SELECT 1 FROM shortcut WHERE id = ?)
+		ON CONFLICT(shortcut_id, bucket_ts) DO UPDATE
+		SET
+			view_count = EXCLUDED.view_count,
+			unique_visitor_count = EXCLUDED.unique_visitor_count,
+			referer_counts = EXCLUDED.referer_counts,
+			user_agent_counts = EXCLUDED.user_agent_counts
+	`
+	if _, err := tx.ExecContext(ctx, stmt, summary.ShortcutID, summary.BucketTs, summary.ViewCount, summary.UniqueVisitorCount, string(refererCounts), string(userAgentCounts), summary.ShortcutID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) CompactShortcutViewActivities(ctx context.Context, bucketTs int64, summaries []*store.ShortcutViewSummary) (int, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	for _, summary := range summaries {
+		if err := upsertShortcutViewSummary(ctx, tx, summary); err != nil {
+			return 0, err
+		}
+	}
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM activity
+		WHERE type = ? AND created_ts >= ? AND created_ts < ?`,
+		store.ActivityShortcutView.String(), bucketTs, bucketTs+store.ShortcutViewStatBucketSize,
+	)
+	if err != nil {
+		return 0, err
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func vacuumShortcutViewSummary(ctx context.Context, tx *sqltx.Tx) error {
+	stmt := `DELETE FROM shortcut_view_summary WHERE shortcut_id NOT IN (SELECT id FROM shortcut)`
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
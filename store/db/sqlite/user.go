@@ -9,23 +9,25 @@ import (
 )
 
 func (d *DB) CreateUser(ctx context.Context, create *store.User) (*store.User, error) {
+	set := []string{"email", "nickname", "password_hash", "role"}
+	args := []any{create.Email, create.Nickname, create.PasswordHash, create.Role}
+	placeholder := []string{"?", "?", "?", "?"}
+	if create.CreatedTs != 0 {
+		set, args, placeholder = append(set, "created_ts"), append(args, create.CreatedTs), append(placeholder, "?")
+	}
+	if create.UpdatedTs != 0 {
+		set, args, placeholder = append(set, "updated_ts"), append(args, create.UpdatedTs), append(placeholder, "?")
+	}
+
 	stmt := `
 		INSERT INTO user (
-			email,
-			nickname,
-			password_hash,
-			role
+			` + strings.Join(set, ", ") + `
 		)
-		VALUES (?, ?, ?, ?)
+		VALUES (` + strings.Join(placeholder, ", ") + `)
 		RETURNING id, created_ts, updated_ts, row_status
 	`
 	var rowStatus string
-	if err := d.db.QueryRowContext(ctx, stmt,
-		create.Email,
-		create.Nickname,
-		create.PasswordHash,
-		create.Role,
-	).Scan(
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
 		&create.CreatedTs,
 		&create.UpdatedTs,
@@ -171,9 +173,61 @@ func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
 	if err := vacuumShortcut(ctx, tx); err != nil {
 		return err
 	}
+	if err := vacuumShortcutViewStat(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutViewSummary(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumShortcutRevision(ctx, tx); err != nil {
+		return err
+	}
 	if err := vacuumCollection(ctx, tx); err != nil {
 		return err
 	}
+	if err := vacuumGroupMember(ctx, tx); err != nil {
+		return err
+	}
 
 	return tx.Commit()
 }
+
+func (d *DB) TransferOwnership(ctx context.Context, transfer *store.TransferOwnership) (*store.TransferOwnershipResult, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `UPDATE shortcut SET namespace_id = ? WHERE namespace_id = ?`, transfer.ToNamespaceID, transfer.FromUserID); err != nil {
+		return nil, err
+	}
+	set, args := []string{"creator_id = ?"}, []any{transfer.ToUserID}
+	if transfer.ToGroupID != 0 {
+		set, args = append(set, "group_id = ?"), append(args, transfer.ToGroupID)
+	}
+	args = append(args, transfer.FromUserID)
+	result := &store.TransferOwnershipResult{}
+	for _, table := range []struct {
+		name  string
+		count *int
+	}{
+		{name: "shortcut", count: &result.ShortcutCount},
+		{name: "collection", count: &result.CollectionCount},
+	} {
+		res, err := tx.ExecContext(ctx, `UPDATE `+table.name+` SET `+strings.Join(set, ", ")+` WHERE creator_id = ?`, args...)
+		if err != nil {
+			return nil, err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		*table.count = int(affected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -2,7 +2,6 @@ package sqlite
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"strings"
 
@@ -10,6 +9,7 @@ import (
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db/sqltx"
 )
 
 func (d *DB) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting) (*storepb.UserSetting, error) {
@@ -111,7 +111,7 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 	return userSettingList, nil
 }
 
-func vacuumUserSetting(ctx context.Context, tx *sql.Tx) error {
+func vacuumUserSetting(ctx context.Context, tx *sqltx.Tx) error {
 	stmt := `DELETE FROM user_setting WHERE user_id NOT IN (SELECT id FROM user)`
 	_, err := tx.ExecContext(ctx, stmt)
 	if err != nil {
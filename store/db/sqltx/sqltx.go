@@ -0,0 +1,138 @@
+// Package sqltx wraps the database of the drivers, so that their statements can run in a transaction
+// begun by the store, where the transactions of the drivers become savepoints.
+package sqltx
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Executor runs the statements, which is either the database or a transaction.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// DB runs the statements on the database, or in the transaction begun by RunInTx.
+type DB struct {
+	db *sql.DB
+	tx *sql.Tx
+	// depth is the number of transactions the statements are nested in, which names the savepoints.
+	depth int
+}
+
+// New returns the DB running the statements on the database.
+func New(db *sql.DB) *DB {
+	return &DB{db: db}
+}
+
+// DB returns the underlying database.
+func (d *DB) DB() *sql.DB {
+	return d.db
+}
+
+func (d *DB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if d.tx != nil {
+		return d.tx.ExecContext(ctx, query, args...)
+	}
+	return d.db.ExecContext(ctx, query, args...)
+}
+
+func (d *DB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if d.tx != nil {
+		return d.tx.QueryContext(ctx, query, args...)
+	}
+	return d.db.QueryContext(ctx, query, args...)
+}
+
+func (d *DB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	if d.tx != nil {
+		return d.tx.QueryRowContext(ctx, query, args...)
+	}
+	return d.db.QueryRowContext(ctx, query, args...)
+}
+
+// BeginTx begins a transaction, which is a savepoint of the transaction begun by RunInTx if any.
+// The options only apply to a new transaction.
+func (d *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if d.tx == nil {
+		tx, err := d.db.BeginTx(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &Tx{tx: tx}, nil
+	}
+
+	savepoint := fmt.Sprintf("savepoint_%d", d.depth)
+	if _, err := d.tx.ExecContext(ctx, "SAVEPOINT "+savepoint); err != nil {
+		return nil, err
+	}
+	return &Tx{tx: d.tx, ctx: ctx, savepoint: savepoint}, nil
+}
+
+// RunInTx runs the function with a DB whose statements run in one transaction, which is committed
+// if the function returns nil and rolled back otherwise.
+func (d *DB) RunInTx(ctx context.Context, fn func(txDB *DB) error) error {
+	tx, err := d.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(&DB{db: d.db, tx: tx.tx, depth: d.depth + 1}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Tx is a transaction, or a savepoint in the transaction begun by RunInTx.
+type Tx struct {
+	tx *sql.Tx
+	// The context and the name of the savepoint, which is released on commit.
+	ctx       context.Context
+	savepoint string
+	done      bool
+}
+
+func (t *Tx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return t.tx.ExecContext(ctx, query, args...)
+}
+
+func (t *Tx) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return t.tx.QueryContext(ctx, query, args...)
+}
+
+func (t *Tx) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return t.tx.QueryRowContext(ctx, query, args...)
+}
+
+// Commit commits the transaction, or releases the savepoint, whose changes are committed along with the transaction.
+func (t *Tx) Commit() error {
+	if t.savepoint == "" {
+		return t.tx.Commit()
+	}
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+	_, err := t.tx.ExecContext(t.ctx, "RELEASE SAVEPOINT "+t.savepoint)
+	return err
+}
+
+// Rollback rolls back the transaction, or the changes since the savepoint.
+func (t *Tx) Rollback() error {
+	if t.savepoint == "" {
+		return t.tx.Rollback()
+	}
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+	if _, err := t.tx.ExecContext(t.ctx, "ROLLBACK TO SAVEPOINT "+t.savepoint); err != nil {
+		return err
+	}
+	_, err := t.tx.ExecContext(t.ctx, "RELEASE SAVEPOINT "+t.savepoint)
+	return err
+}
@@ -12,6 +12,9 @@ import (
 type Driver interface {
 	GetDB() *sql.DB
 	Close() error
+	// RunInTx runs the function with a driver whose statements run in one transaction, which is committed
+	// if the function returns nil and rolled back otherwise.
+	RunInTx(ctx context.Context, fn func(driver Driver) error) error
 
 	// MigrationHistory model related methods.
 	UpsertMigrationHistory(ctx context.Context, upsert *UpsertMigrationHistory) (*MigrationHistory, error)
@@ -19,7 +22,11 @@ type Driver interface {
 
 	// Activity model related methods.
 	CreateActivity(ctx context.Context, create *Activity) (*Activity, error)
+	// CreateActivities creates the activities in one multi-row insert, without returning their ids.
+	CreateActivities(ctx context.Context, creates []*Activity) error
 	ListActivities(ctx context.Context, find *FindActivity) ([]*Activity, error)
+	ListShortcutViewTimeBuckets(ctx context.Context, find *FindShortcutViewAnalytics, bucketSize, bucketOffset int64) ([]*ShortcutViewTimeBucket, error)
+	CountShortcutViewsByDimension(ctx context.Context, find *FindShortcutViewAnalytics, dimension ShortcutViewDimension) ([]*ShortcutViewDimensionCount, error)
 
 	// Collection model related methods.
 	CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error)
@@ -33,11 +40,38 @@ type Driver interface {
 	ListShortcuts(ctx context.Context, find *FindShortcut) ([]*storepb.Shortcut, error)
 	DeleteShortcut(ctx context.Context, delete *DeleteShortcut) error
 
+	// ShortcutRevision model related methods.
+	CreateShortcutRevision(ctx context.Context, create *storepb.ShortcutRevision) (*storepb.ShortcutRevision, error)
+	ListShortcutRevisions(ctx context.Context, find *FindShortcutRevision) ([]*storepb.ShortcutRevision, error)
+
+	// ShortcutViewStat model related methods.
+	IncreaseShortcutViewStat(ctx context.Context, increase *ShortcutViewStat) error
+	CountShortcutViews(ctx context.Context, find *FindShortcutViewStat) (map[int32]int32, error)
+
+	// ShortcutViewSummary model related methods.
+	ListShortcutViewSummaries(ctx context.Context, find *FindShortcutViewSummary) ([]*ShortcutViewSummary, error)
+	// UpsertShortcutViewSummary upserts the summary, unless the shortcut doesn't exist.
+	UpsertShortcutViewSummary(ctx context.Context, upsert *ShortcutViewSummary) error
+	// CompactShortcutViewActivities upserts the summaries of the day and deletes the view activities of the day in a transaction.
+	CompactShortcutViewActivities(ctx context.Context, bucketTs int64, summaries []*ShortcutViewSummary) (int, error)
+
 	// User model related methods.
 	CreateUser(ctx context.Context, create *User) (*User, error)
 	UpdateUser(ctx context.Context, update *UpdateUser) (*User, error)
 	ListUsers(ctx context.Context, find *FindUser) ([]*User, error)
 	DeleteUser(ctx context.Context, delete *DeleteUser) error
+	// TransferOwnership updates the creator, group and namespace of the shortcuts and collections in a transaction.
+	TransferOwnership(ctx context.Context, transfer *TransferOwnership) (*TransferOwnershipResult, error)
+
+	// Group model related methods.
+	CreateGroup(ctx context.Context, create *Group) (*Group, error)
+	UpdateGroup(ctx context.Context, update *UpdateGroup) (*Group, error)
+	ListGroups(ctx context.Context, find *FindGroup) ([]*Group, error)
+	// DeleteGroup deletes the group and its members, and resets the group of the owned shortcuts and collections in a transaction.
+	DeleteGroup(ctx context.Context, delete *DeleteGroup) error
+	UpsertGroupMember(ctx context.Context, upsert *GroupMember) (*GroupMember, error)
+	ListGroupMembers(ctx context.Context, find *FindGroupMember) ([]*GroupMember, error)
+	DeleteGroupMember(ctx context.Context, delete *DeleteGroupMember) error
 
 	// UserSetting model related methods.
 	UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting) (*storepb.UserSetting, error)
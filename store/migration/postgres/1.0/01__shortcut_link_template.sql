@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN link_template BOOLEAN NOT NULL DEFAULT FALSE;
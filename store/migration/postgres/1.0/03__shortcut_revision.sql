@@ -0,0 +1,10 @@
+CREATE TABLE shortcut_revision (
+  id SERIAL PRIMARY KEY,
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  old_value TEXT NOT NULL DEFAULT '{}',
+  new_value TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_shortcut_revision_shortcut_id ON shortcut_revision(shortcut_id);
@@ -0,0 +1,9 @@
+CREATE TABLE shortcut_view_summary (
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  bucket_ts BIGINT NOT NULL,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  unique_visitor_count INTEGER NOT NULL DEFAULT 0,
+  referer_counts TEXT NOT NULL DEFAULT '{}',
+  user_agent_counts TEXT NOT NULL DEFAULT '{}',
+  PRIMARY KEY (shortcut_id, bucket_ts)
+);
@@ -0,0 +1,14 @@
+ALTER TABLE shortcut DROP CONSTRAINT shortcut_name_key;
+
+ALTER TABLE shortcut ADD COLUMN namespace_id INTEGER NOT NULL DEFAULT 0;
+
+CREATE UNIQUE INDEX idx_shortcut_namespace_id_name ON shortcut(namespace_id, name);
+
+-- The names starting with "me/" are resolved in the personal namespace, so the workspace shortcuts with them are
+-- renamed to start with "me-" instead, followed by the id if the new name is taken.
+UPDATE shortcut
+SET name = 'me-' || substr(name, 4) || CASE
+    WHEN EXISTS (SELECT 1 FROM shortcut AS existing WHERE existing.namespace_id = 0 AND existing.name = 'me-' || substr(shortcut.name, 4)) THEN '-' || shortcut.id
+    ELSE ''
+  END
+WHERE namespace_id = 0 AND substr(name, 1, 3) = 'me/';
@@ -0,0 +1,20 @@
+CREATE TABLE user_group (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE user_group_member (
+  group_id INTEGER REFERENCES user_group(id) ON DELETE CASCADE NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('EDITOR', 'VIEWER')) DEFAULT 'VIEWER',
+  PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member(user_id);
+
+ALTER TABLE shortcut ADD COLUMN group_id INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE collection ADD COLUMN group_id INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+ALTER TABLE "user" DROP CONSTRAINT IF EXISTS user_role_check;
+
+ALTER TABLE "user" ADD CONSTRAINT user_role_check CHECK (role IN ('ADMIN', 'USER', 'EDITOR', 'VIEWER', 'ANALYST'));
@@ -19,7 +19,7 @@ CREATE TABLE "user" (
   email TEXT NOT NULL UNIQUE,
   nickname TEXT NOT NULL,
   password_hash TEXT NOT NULL,
-  role TEXT NOT NULL CHECK (role IN ('ADMIN', 'USER')) DEFAULT 'USER'
+  role TEXT NOT NULL CHECK (role IN ('ADMIN', 'USER', 'EDITOR', 'VIEWER', 'ANALYST')) DEFAULT 'USER'
 );
 
 CREATE INDEX idx_user_email ON "user"(email);
@@ -32,6 +32,25 @@ CREATE TABLE user_setting (
   PRIMARY KEY (user_id, key)
 );
 
+-- user_group
+CREATE TABLE user_group (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id INTEGER REFERENCES user_group(id) ON DELETE CASCADE NOT NULL,
+  user_id INTEGER REFERENCES "user"(id) ON DELETE CASCADE NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('EDITOR', 'VIEWER')) DEFAULT 'VIEWER',
+  PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member(user_id);
+
 -- shortcut
 CREATE TABLE shortcut (
   id SERIAL PRIMARY KEY,
@@ -39,17 +58,22 @@ CREATE TABLE shortcut (
   created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
   updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
   row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
-  name TEXT NOT NULL UNIQUE,
+  name TEXT NOT NULL,
   link TEXT NOT NULL,
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
   visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
   tag TEXT NOT NULL DEFAULT '',
-  og_metadata TEXT NOT NULL DEFAULT '{}'
+  og_metadata TEXT NOT NULL DEFAULT '{}',
+  link_template BOOLEAN NOT NULL DEFAULT FALSE,
+  namespace_id INTEGER NOT NULL DEFAULT 0,
+  group_id INTEGER NOT NULL DEFAULT 0
 );
 
 CREATE INDEX idx_shortcut_name ON shortcut(name);
 
+CREATE UNIQUE INDEX idx_shortcut_namespace_id_name ON shortcut(namespace_id, name);
+
 -- activity
 CREATE TABLE activity (
   id SERIAL PRIMARY KEY,
@@ -60,6 +84,37 @@ CREATE TABLE activity (
   payload TEXT NOT NULL DEFAULT '{}'
 );
 
+-- shortcut_view_stat
+CREATE TABLE shortcut_view_stat (
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  bucket_ts BIGINT NOT NULL,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  PRIMARY KEY (shortcut_id, bucket_ts)
+);
+
+-- shortcut_view_summary
+CREATE TABLE shortcut_view_summary (
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  bucket_ts BIGINT NOT NULL,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  unique_visitor_count INTEGER NOT NULL DEFAULT 0,
+  referer_counts TEXT NOT NULL DEFAULT '{}',
+  user_agent_counts TEXT NOT NULL DEFAULT '{}',
+  PRIMARY KEY (shortcut_id, bucket_ts)
+);
+
+-- shortcut_revision
+CREATE TABLE shortcut_revision (
+  id SERIAL PRIMARY KEY,
+  shortcut_id INTEGER REFERENCES shortcut(id) ON DELETE CASCADE NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  old_value TEXT NOT NULL DEFAULT '{}',
+  new_value TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_shortcut_revision_shortcut_id ON shortcut_revision(shortcut_id);
+
 -- collection
 CREATE TABLE collection (
   id SERIAL PRIMARY KEY,
@@ -70,7 +125,8 @@ CREATE TABLE collection (
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
   shortcut_ids INTEGER ARRAY NOT NULL,
-  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE'
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
+  group_id INTEGER NOT NULL DEFAULT 0
 );
 
 CREATE INDEX idx_collection_name ON collection(name);
@@ -0,0 +1 @@
+ALTER TABLE shortcut ADD COLUMN link_template INTEGER NOT NULL DEFAULT 0;
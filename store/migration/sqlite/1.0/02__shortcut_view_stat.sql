@@ -0,0 +1,16 @@
+CREATE TABLE shortcut_view_stat (
+  shortcut_id INTEGER NOT NULL,
+  bucket_ts BIGINT NOT NULL,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  PRIMARY KEY (shortcut_id, bucket_ts)
+);
+
+INSERT INTO shortcut_view_stat (shortcut_id, bucket_ts, view_count)
+SELECT
+  CAST(json_extract(activity.payload, '$.shortcutId') AS INTEGER) AS shortcut_id,
+  activity.created_ts - activity.created_ts % 86400 AS bucket_ts,
+  COUNT(*)
+FROM activity
+JOIN shortcut ON shortcut.id = CAST(json_extract(activity.payload, '$.shortcutId') AS INTEGER)
+WHERE activity.type = 'shortcut.view'
+GROUP BY 1, 2;
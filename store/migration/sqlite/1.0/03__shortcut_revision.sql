@@ -0,0 +1,10 @@
+CREATE TABLE shortcut_revision (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shortcut_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  old_value TEXT NOT NULL DEFAULT '{}',
+  new_value TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_shortcut_revision_shortcut_id ON shortcut_revision(shortcut_id);
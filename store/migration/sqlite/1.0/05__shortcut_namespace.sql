@@ -0,0 +1,64 @@
+ALTER TABLE shortcut RENAME TO shortcut_old;
+
+CREATE TABLE shortcut (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+  name TEXT NOT NULL,
+  link TEXT NOT NULL,
+  title TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
+  tag TEXT NOT NULL DEFAULT '',
+  og_metadata TEXT NOT NULL DEFAULT '{}',
+  link_template INTEGER NOT NULL DEFAULT 0,
+  namespace_id INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO shortcut (
+  id,
+  creator_id,
+  created_ts,
+  updated_ts,
+  row_status,
+  name,
+  link,
+  title,
+  description,
+  visibility,
+  tag,
+  og_metadata,
+  link_template
+)
+SELECT
+  id,
+  creator_id,
+  created_ts,
+  updated_ts,
+  row_status,
+  name,
+  link,
+  title,
+  description,
+  visibility,
+  tag,
+  og_metadata,
+  link_template
+FROM shortcut_old;
+
+DROP TABLE shortcut_old;
+
+CREATE INDEX idx_shortcut_name ON shortcut(name);
+
+CREATE UNIQUE INDEX idx_shortcut_namespace_id_name ON shortcut(namespace_id, name);
+
+-- The names starting with "me/" are resolved in the personal namespace, so the workspace shortcuts with them are
+-- renamed to start with "me-" instead, followed by the id if the new name is taken.
+UPDATE shortcut
+SET name = 'me-' || substr(name, 4) || CASE
+    WHEN EXISTS (SELECT 1 FROM shortcut AS existing WHERE existing.namespace_id = 0 AND existing.name = 'me-' || substr(shortcut.name, 4)) THEN '-' || shortcut.id
+    ELSE ''
+  END
+WHERE namespace_id = 0 AND substr(name, 1, 3) = 'me/';
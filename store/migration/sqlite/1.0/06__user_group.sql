@@ -0,0 +1,20 @@
+CREATE TABLE user_group (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('EDITOR', 'VIEWER')) DEFAULT 'VIEWER',
+  PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member(user_id);
+
+ALTER TABLE shortcut ADD COLUMN group_id INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE collection ADD COLUMN group_id INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,37 @@
+ALTER TABLE user RENAME TO user_old;
+
+CREATE TABLE user (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+  email TEXT NOT NULL UNIQUE,
+  nickname TEXT NOT NULL,
+  password_hash TEXT NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('ADMIN', 'USER', 'EDITOR', 'VIEWER', 'ANALYST')) DEFAULT 'USER'
+);
+
+INSERT INTO user (
+  id,
+  created_ts,
+  updated_ts,
+  row_status,
+  email,
+  nickname,
+  password_hash,
+  role
+)
+SELECT
+  id,
+  created_ts,
+  updated_ts,
+  row_status,
+  email,
+  nickname,
+  password_hash,
+  role
+FROM user_old;
+
+DROP TABLE user_old;
+
+CREATE INDEX idx_user_email ON user(email);
@@ -0,0 +1,116 @@
+package store
+
+import (
+	"context"
+	"slices"
+)
+
+// Permission is the permission to perform a kind of action in the workspace.
+type Permission string
+
+const (
+	// PermissionShortcutsCreate allows to create and import shortcuts.
+	PermissionShortcutsCreate Permission = "shortcuts.create"
+	// PermissionShortcutsEditAll allows to edit and delete the shortcuts of any user.
+	PermissionShortcutsEditAll Permission = "shortcuts.edit_all"
+	// PermissionShortcutsViewPrivate allows to view the private shortcuts of any user or group.
+	PermissionShortcutsViewPrivate Permission = "shortcuts.view_private"
+	// PermissionCollectionsCreate allows to create collections.
+	PermissionCollectionsCreate Permission = "collections.create"
+	// PermissionCollectionsEditAll allows to edit and delete the collections of any user.
+	PermissionCollectionsEditAll Permission = "collections.edit_all"
+	// PermissionCollectionsViewPrivate allows to view the private collections of any user or group.
+	PermissionCollectionsViewPrivate Permission = "collections.view_private"
+	// PermissionGroupsCreate allows to create groups.
+	PermissionGroupsCreate Permission = "groups.create"
+	// PermissionGroupsManage allows to edit and delete any group and its members.
+	PermissionGroupsManage Permission = "groups.manage"
+	// PermissionAnalyticsView allows to view the workspace analytics.
+	PermissionAnalyticsView Permission = "analytics.view"
+	// PermissionAuditLogsView allows to view the audit logs.
+	PermissionAuditLogsView Permission = "audit_logs.view"
+	// PermissionUsersManage allows to create, update and delete users.
+	PermissionUsersManage Permission = "users.manage"
+	// PermissionWorkspaceManage allows to update the workspace settings, export and import the workspace,
+	// and to demote and delete the admins.
+	// It is only granted to the admin role.
+	PermissionWorkspaceManage Permission = "workspace.manage"
+)
+
+// AllPermissions is the list of all permissions.
+var AllPermissions = []Permission{
+	PermissionShortcutsCreate,
+	PermissionShortcutsEditAll,
+	PermissionShortcutsViewPrivate,
+	PermissionCollectionsCreate,
+	PermissionCollectionsEditAll,
+	PermissionCollectionsViewPrivate,
+	PermissionGroupsCreate,
+	PermissionGroupsManage,
+	PermissionAnalyticsView,
+	PermissionAuditLogsView,
+	PermissionUsersManage,
+	PermissionWorkspaceManage,
+}
+
+// AdminOnlyPermissions is the list of permissions that cannot be granted to the configurable roles, as they
+// allow to take over the workspace, e.g. by importing an archive with admin users or changing the role permissions.
+var AdminOnlyPermissions = []Permission{PermissionWorkspaceManage}
+
+// ConfigurableRoles is the list of roles whose permissions can be configured in the workspace setting.
+var ConfigurableRoles = []Role{RoleUser, RoleEditor, RoleViewer, RoleAnalyst}
+
+// DefaultRolePermissions is the permissions of the roles when they are not configured.
+var DefaultRolePermissions = map[Role][]Permission{
+	RoleUser: {
+		PermissionShortcutsCreate,
+		PermissionCollectionsCreate,
+		PermissionGroupsCreate,
+	},
+	RoleEditor: {
+		PermissionShortcutsCreate,
+		PermissionShortcutsEditAll,
+		PermissionCollectionsCreate,
+		PermissionCollectionsEditAll,
+		PermissionGroupsCreate,
+	},
+	RoleViewer:  {},
+	RoleAnalyst: {PermissionAnalyticsView},
+}
+
+// GetRolePermissions returns the permissions of the role, as configured in the workspace setting
+// or the default ones otherwise. The admin role always has all permissions.
+func (s *Store) GetRolePermissions(ctx context.Context, role Role) ([]Permission, error) {
+	if role == RoleAdmin {
+		return AllPermissions, nil
+	}
+
+	rolePermissionSetting, err := s.GetWorkspaceRolePermissionSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, rolePermission := range rolePermissionSetting.RolePermissions {
+		if Role(rolePermission.Role) != role {
+			continue
+		}
+		permissions := []Permission{}
+		for _, permission := range rolePermission.Permissions {
+			// The admin only permissions might be configured before they were restricted.
+			if slices.Contains(AdminOnlyPermissions, Permission(permission)) {
+				continue
+			}
+			permissions = append(permissions, Permission(permission))
+		}
+		return permissions, nil
+	}
+	return DefaultRolePermissions[role], nil
+}
+
+// HasPermission returns whether the role of the user grants the permission.
+func (s *Store) HasPermission(ctx context.Context, user *User, permission Permission) (bool, error) {
+	permissions, err := s.GetRolePermissions(ctx, user.Role)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(permissions, permission), nil
+}
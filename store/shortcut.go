@@ -2,13 +2,23 @@ package store
 
 import (
 	"context"
+	"strings"
 
 	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 )
 
+const (
+	// WorkspaceNamespaceID is the namespace id of the shortcuts shared in the workspace.
+	WorkspaceNamespaceID int32 = 0
+	// PersonalNamespacePrefix is the prefix of the names only resolved in the personal namespace, e.g. "me/standup".
+	PersonalNamespacePrefix = "me/"
+)
+
 type UpdateShortcut struct {
 	ID int32
 
+	UpdatedTs         *int64
+	RowStatus         *storepb.RowStatus
 	Name              *string
 	Link              *string
 	Title             *string
@@ -16,14 +26,46 @@ type UpdateShortcut struct {
 	Visibility        *storepb.Visibility
 	Tag               *string
 	OpenGraphMetadata *storepb.OpenGraphMetadata
+	LinkTemplate      *bool
+	NamespaceID       *int32
+	GroupID           *int32
 }
 
+// ShortcutOrderBy is the column that the shortcuts are ordered by.
+type ShortcutOrderBy string
+
+const (
+	ShortcutOrderByCreatedTs ShortcutOrderBy = "created_ts"
+	ShortcutOrderByUpdatedTs ShortcutOrderBy = "updated_ts"
+	ShortcutOrderByName      ShortcutOrderBy = "name"
+)
+
 type FindShortcut struct {
 	ID             *int32
 	CreatorID      *int32
+	RowStatus      *storepb.RowStatus
 	Name           *string
+	NamePrefix     *string
+	NamespaceID    *int32
 	VisibilityList []storepb.Visibility
-	Tag            *string
+	// PrivateCreatorID excludes the private shortcuts not created by the user.
+	PrivateCreatorID *int32
+	// PrivateGroupIDList includes the private shortcuts owned by the groups along with PrivateCreatorID.
+	PrivateGroupIDList []int32
+	GroupID            *int32
+	Tag                *string
+	CreatedTsAfter     *int64
+	CreatedTsBefore    *int64
+	UpdatedTsAfter     *int64
+	UpdatedTsBefore    *int64
+
+	// OrderBy defaults to created_ts in descending order.
+	OrderBy  ShortcutOrderBy
+	OrderAsc bool
+
+	// Pagination.
+	Limit  *int
+	Offset *int
 }
 
 type DeleteShortcut struct {
@@ -82,6 +124,42 @@ func (s *Store) GetShortcut(ctx context.Context, find *FindShortcut) (*storepb.S
 	return shortcut, nil
 }
 
+// ResolveShortcut finds the shortcut by the name of the find for the user, who is 0 if not signed in.
+// The name is looked up in the personal namespace of the user first, then in the workspace namespace.
+// The name with the personal namespace prefix is only looked up in the personal namespace.
+// An archived shortcut does not hide the active one of the next namespace, and is only returned if none is active.
+func (s *Store) ResolveShortcut(ctx context.Context, find *FindShortcut, userID int32) (*storepb.Shortcut, error) {
+	name := *find.Name
+	namespaceIDs := []int32{}
+	if userID != 0 {
+		namespaceIDs = append(namespaceIDs, userID)
+	}
+	if personalName, ok := strings.CutPrefix(name, PersonalNamespacePrefix); ok {
+		name = personalName
+	} else {
+		namespaceIDs = append(namespaceIDs, WorkspaceNamespaceID)
+	}
+	var archived *storepb.Shortcut
+	for _, namespaceID := range namespaceIDs {
+		namespaceFind := *find
+		namespaceFind.Name, namespaceFind.NamespaceID = &name, &namespaceID
+		shortcut, err := s.GetShortcut(ctx, &namespaceFind)
+		if err != nil {
+			return nil, err
+		}
+		if shortcut == nil {
+			continue
+		}
+		if shortcut.RowStatus == storepb.RowStatus_NORMAL {
+			return shortcut, nil
+		}
+		if archived == nil {
+			archived = shortcut
+		}
+	}
+	return archived, nil
+}
+
 func (s *Store) DeleteShortcut(ctx context.Context, delete *DeleteShortcut) error {
 	if err := s.driver.DeleteShortcut(ctx, delete); err != nil {
 		return err
@@ -90,3 +168,24 @@ func (s *Store) DeleteShortcut(ctx context.Context, delete *DeleteShortcut) erro
 	s.shortcutCache.Delete(delete.ID)
 	return nil
 }
+
+// PurgeArchivedShortcuts deletes the shortcuts archived before the given time, i.e. last updated before it.
+// It returns the number of the deleted shortcuts.
+func (s *Store) PurgeArchivedShortcuts(ctx context.Context, archivedBefore int64) (int, error) {
+	rowStatus := storepb.RowStatus_ARCHIVED
+	shortcuts, err := s.ListShortcuts(ctx, &FindShortcut{
+		RowStatus:       &rowStatus,
+		UpdatedTsBefore: &archivedBefore,
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, shortcut := range shortcuts {
+		if err := s.DeleteShortcut(ctx, &DeleteShortcut{
+			ID: shortcut.Id,
+		}); err != nil {
+			return 0, err
+		}
+	}
+	return len(shortcuts), nil
+}
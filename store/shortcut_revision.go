@@ -0,0 +1,32 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+type FindShortcutRevision struct {
+	ID         *int32
+	ShortcutID *int32
+}
+
+func (s *Store) CreateShortcutRevision(ctx context.Context, create *storepb.ShortcutRevision) (*storepb.ShortcutRevision, error) {
+	return s.driver.CreateShortcutRevision(ctx, create)
+}
+
+// ListShortcutRevisions returns the revisions in descending order of the creation.
+func (s *Store) ListShortcutRevisions(ctx context.Context, find *FindShortcutRevision) ([]*storepb.ShortcutRevision, error) {
+	return s.driver.ListShortcutRevisions(ctx, find)
+}
+
+func (s *Store) GetShortcutRevision(ctx context.Context, find *FindShortcutRevision) (*storepb.ShortcutRevision, error) {
+	list, err := s.ListShortcutRevisions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
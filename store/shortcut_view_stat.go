@@ -0,0 +1,35 @@
+package store
+
+import (
+	"context"
+)
+
+// ShortcutViewStatBucketSize is the size of the time buckets that the shortcut views are counted in, in seconds.
+const ShortcutViewStatBucketSize int64 = 24 * 60 * 60
+
+// ShortcutViewStat is the view count of a shortcut in a daily bucket.
+type ShortcutViewStat struct {
+	ShortcutID int32
+	// BucketTs is the start of the UTC day.
+	BucketTs  int64
+	ViewCount int32
+}
+
+type FindShortcutViewStat struct {
+	ShortcutIDList []int32
+}
+
+// GetShortcutViewStatBucketTs returns the bucket that the timestamp belongs to.
+func GetShortcutViewStatBucketTs(ts int64) int64 {
+	return ts - ts%ShortcutViewStatBucketSize
+}
+
+// IncreaseShortcutViewStat adds the view count of the stat to its bucket.
+func (s *Store) IncreaseShortcutViewStat(ctx context.Context, increase *ShortcutViewStat) error {
+	return s.driver.IncreaseShortcutViewStat(ctx, increase)
+}
+
+// CountShortcutViews returns the total view counts by shortcut id. The shortcuts without any view are omitted.
+func (s *Store) CountShortcutViews(ctx context.Context, find *FindShortcutViewStat) (map[int32]int32, error) {
+	return s.driver.CountShortcutViews(ctx, find)
+}
@@ -0,0 +1,208 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// ShortcutViewSummary is the daily summary of the views of a shortcut, rolled up from the view activities
+// when they are compacted, so that the analytics survive the deletion of the activities.
+type ShortcutViewSummary struct {
+	ShortcutID int32
+	// BucketTs is the start of the UTC day.
+	BucketTs           int64
+	ViewCount          int32
+	UniqueVisitorCount int32
+	RefererCounts      map[string]int32
+	UserAgentCounts    map[string]int32
+}
+
+type FindShortcutViewSummary struct {
+	ShortcutID *int32
+	// BucketTsStart is inclusive and BucketTsEnd is exclusive.
+	BucketTsStart *int64
+	BucketTsEnd   *int64
+}
+
+func (s *Store) ListShortcutViewSummaries(ctx context.Context, find *FindShortcutViewSummary) ([]*ShortcutViewSummary, error) {
+	return s.driver.ListShortcutViewSummaries(ctx, find)
+}
+
+func (s *Store) UpsertShortcutViewSummary(ctx context.Context, upsert *ShortcutViewSummary) error {
+	return s.driver.UpsertShortcutViewSummary(ctx, upsert)
+}
+
+// listShortcutViewSummariesOfAnalytics returns the summaries of the days starting in the time range of the analytics.
+func (s *Store) listShortcutViewSummariesOfAnalytics(ctx context.Context, find *FindShortcutViewAnalytics) ([]*ShortcutViewSummary, error) {
+	return s.ListShortcutViewSummaries(ctx, &FindShortcutViewSummary{
+		ShortcutID:    find.ShortcutID,
+		BucketTsStart: find.CreatedTsStart,
+		BucketTsEnd:   find.CreatedTsEnd,
+	})
+}
+
+// CompactShortcutViewActivities rolls up the shortcut view activities created before the given time into
+// the daily summaries, and deletes them. Only the whole days are compacted, so the time is rounded down to the day.
+// It returns the number of the deleted activities.
+func (s *Store) CompactShortcutViewActivities(ctx context.Context, before int64) (int, error) {
+	before = GetShortcutViewStatBucketTs(before)
+	limit := 1
+	count := 0
+	for {
+		oldest, err := s.ListActivities(ctx, &FindActivity{
+			Type:            ActivityShortcutView,
+			CreatedTsBefore: &before,
+			Limit:           &limit,
+		})
+		if err != nil {
+			return count, errors.Wrap(err, "failed to find the oldest view activity")
+		}
+		if len(oldest) == 0 {
+			return count, nil
+		}
+
+		bucketTs := GetShortcutViewStatBucketTs(oldest[0].CreatedTs)
+		deleted, err := s.compactShortcutViewActivitiesOfDay(ctx, bucketTs)
+		if err != nil {
+			return count, errors.Wrapf(err, "failed to compact the view activities of day %d", bucketTs)
+		}
+		count += deleted
+	}
+}
+
+func (s *Store) compactShortcutViewActivitiesOfDay(ctx context.Context, bucketTs int64) (int, error) {
+	// The store compares the created timestamps exclusively.
+	createdTsAfter, createdTsBefore := bucketTs-1, bucketTs+ShortcutViewStatBucketSize
+	activities, err := s.ListActivities(ctx, &FindActivity{
+		Type:            ActivityShortcutView,
+		CreatedTsAfter:  &createdTsAfter,
+		CreatedTsBefore: &createdTsBefore,
+	})
+	if err != nil {
+		return 0, err
+	}
+	bucketTsEnd := bucketTs + 1
+	existingSummaries, err := s.ListShortcutViewSummaries(ctx, &FindShortcutViewSummary{
+		BucketTsStart: &bucketTs,
+		BucketTsEnd:   &bucketTsEnd,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	summaryMap := map[int32]*ShortcutViewSummary{}
+	for _, summary := range existingSummaries {
+		summaryMap[summary.ShortcutID] = summary
+	}
+	visitorMap := map[int32]map[string]bool{}
+	for _, activity := range activities {
+		payload := &storepb.ActivityShorcutViewPayload{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal([]byte(activity.Payload), payload); err != nil {
+			return 0, errors.Wrapf(err, "failed to unmarshal payload of activity %d", activity.ID)
+		}
+		summary, ok := summaryMap[payload.ShortcutId]
+		if !ok {
+			summary = &ShortcutViewSummary{
+				ShortcutID:      payload.ShortcutId,
+				BucketTs:        bucketTs,
+				RefererCounts:   map[string]int32{},
+				UserAgentCounts: map[string]int32{},
+			}
+			summaryMap[payload.ShortcutId] = summary
+		}
+		summary.ViewCount++
+		summary.RefererCounts[payload.Referer]++
+		summary.UserAgentCounts[payload.UserAgent]++
+		if visitorMap[payload.ShortcutId] == nil {
+			visitorMap[payload.ShortcutId] = map[string]bool{}
+		}
+		visitorMap[payload.ShortcutId][payload.Ip] = true
+	}
+	summaries := []*ShortcutViewSummary{}
+	for _, summary := range summaryMap {
+		// The visitors of the existing summary are not known anymore, so they are counted as different ones.
+		summary.UniqueVisitorCount += int32(len(visitorMap[summary.ShortcutID]))
+		summaries = append(summaries, summary)
+	}
+	return s.driver.CompactShortcutViewActivities(ctx, bucketTs, summaries)
+}
+
+// mergeShortcutViewSummaryTimeBuckets adds the views of the summaries into the time buckets.
+// The summaries are daily, so they are counted in the bucket containing the start of their day.
+func mergeShortcutViewSummaryTimeBuckets(buckets []*ShortcutViewTimeBucket, summaries []*ShortcutViewSummary, bucketSize, bucketOffset int64) []*ShortcutViewTimeBucket {
+	if len(summaries) == 0 {
+		return buckets
+	}
+	bucketMap := map[int64]*ShortcutViewTimeBucket{}
+	for _, bucket := range buckets {
+		bucketMap[bucket.BucketTs] = bucket
+	}
+	for _, summary := range summaries {
+		bucketTs := summary.BucketTs - (summary.BucketTs-bucketOffset)%bucketSize
+		bucket, ok := bucketMap[bucketTs]
+		if !ok {
+			bucket = &ShortcutViewTimeBucket{
+				BucketTs: bucketTs,
+			}
+			bucketMap[bucketTs] = bucket
+			buckets = append(buckets, bucket)
+		}
+		bucket.ViewCount += summary.ViewCount
+		bucket.UniqueVisitorCount += summary.UniqueVisitorCount
+	}
+	slices.SortFunc(buckets, func(a, b *ShortcutViewTimeBucket) int {
+		return cmp.Compare(a.BucketTs, b.BucketTs)
+	})
+	return buckets
+}
+
+// mergeShortcutViewSummaryDimensionCounts adds the views of the summaries into the counts of the dimension,
+// and sorts them in descending order of the count.
+func mergeShortcutViewSummaryDimensionCounts(counts []*ShortcutViewDimensionCount, summaries []*ShortcutViewSummary, dimension ShortcutViewDimension) []*ShortcutViewDimensionCount {
+	if len(summaries) == 0 {
+		return counts
+	}
+	countMap := map[string]*ShortcutViewDimensionCount{}
+	for _, count := range counts {
+		countMap[count.Value] = count
+	}
+	add := func(value string, viewCount int32) {
+		count, ok := countMap[value]
+		if !ok {
+			count = &ShortcutViewDimensionCount{
+				Value: value,
+			}
+			countMap[value] = count
+			counts = append(counts, count)
+		}
+		count.Count += viewCount
+	}
+	for _, summary := range summaries {
+		switch dimension {
+		case ShortcutViewDimensionReferer:
+			for value, viewCount := range summary.RefererCounts {
+				add(value, viewCount)
+			}
+		case ShortcutViewDimensionUserAgent:
+			for value, viewCount := range summary.UserAgentCounts {
+				add(value, viewCount)
+			}
+		case ShortcutViewDimensionShortcutID:
+			add(strconv.Itoa(int(summary.ShortcutID)), summary.ViewCount)
+		}
+	}
+	slices.SortFunc(counts, func(a, b *ShortcutViewDimensionCount) int {
+		if a.Count != b.Count {
+			return cmp.Compare(b.Count, a.Count)
+		}
+		return cmp.Compare(a.Value, b.Value)
+	})
+	return counts
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"context"
 	"sync"
 
 	"github.com/yourselfhosted/slash/server/profile"
@@ -25,6 +26,22 @@ func New(driver Driver, profile *profile.Profile) *Store {
 	}
 }
 
+// RunInTx runs the function with a store whose changes are made in one transaction, which is committed
+// if the function returns nil and rolled back otherwise. The store in the transaction has its own caches,
+// so the caches of this store are cleared after the commit.
+func (s *Store) RunInTx(ctx context.Context, fn func(txStore *Store) error) error {
+	if err := s.driver.RunInTx(ctx, func(driver Driver) error {
+		return fn(New(driver, s.profile))
+	}); err != nil {
+		return err
+	}
+	s.workspaceSettingCache.Clear()
+	s.userCache.Clear()
+	s.userSettingCache.Clear()
+	s.shortcutCache.Clear()
+	return nil
+}
+
 // Close closes the database connection.
 func (s *Store) Close() error {
 	return s.driver.Close()
@@ -2,10 +2,12 @@ package teststore
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
 )
 
@@ -29,3 +31,283 @@ func TestActivityStore(t *testing.T) {
 	require.Equal(t, 1, len(list))
 	require.Equal(t, activity, list[0])
 }
+
+func TestCreateActivities(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	creates := []*store.Activity{}
+	for i := 0; i < 3; i++ {
+		creates = append(creates, &store.Activity{
+			CreatorID: user.ID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   fmt.Sprintf(`{"shortcutId":%d}`, shortcut.Id),
+		})
+	}
+	creates = append(creates, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityShortcutCreate,
+		Level:     store.ActivityInfo,
+		Payload:   fmt.Sprintf(`{"shortcutId":%d}`, shortcut.Id),
+	})
+	require.NoError(t, ts.CreateActivities(ctx, creates))
+
+	list, err := ts.ListActivities(ctx, &store.FindActivity{})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(list))
+	require.NotZero(t, list[0].CreatedTs)
+	viewCounts, err := ts.CountShortcutViews(ctx, &store.FindShortcutViewStat{})
+	require.NoError(t, err)
+	require.Equal(t, map[int32]int32{shortcut.Id: 3}, viewCounts)
+	ts.Close()
+}
+
+func TestCreateActivityRollback(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	// The view stat can't be increased with the invalid payload, which rolls back the activity.
+	_, err = ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityShortcutView,
+		Level:     store.ActivityInfo,
+		Payload:   "invalid",
+	})
+	require.Error(t, err)
+	list, err := ts.ListActivities(ctx, &store.FindActivity{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(list))
+	ts.Close()
+}
+
+func TestListAuditActivities(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityShortcutView,
+		Level:     store.ActivityInfo,
+		Payload:   `{"shortcutId":1}`,
+	})
+	require.NoError(t, err)
+	for _, resource := range []string{"shortcuts/1", "shortcuts/2", "collections/1"} {
+		activityType := store.ActivityAuditShortcut
+		if resource == "collections/1" {
+			activityType = store.ActivityAuditCollection
+		}
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			Type:      activityType,
+			Level:     store.ActivityInfo,
+			Payload:   fmt.Sprintf(`{"method":"/slash.api.v1.ShortcutService/UpdateShortcut","resource":%q,"code":"OK"}`, resource),
+		})
+		require.NoError(t, err)
+	}
+
+	list, err := ts.ListActivities(ctx, &store.FindActivity{
+		TypeList:  store.AuditActivityTypes,
+		OrderDesc: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(list))
+	require.Equal(t, store.ActivityAuditCollection, list[0].Type)
+	resource := "shortcuts/2"
+	list, err = ts.ListActivities(ctx, &store.FindActivity{
+		TypeList:        []store.ActivityType{store.ActivityAuditShortcut},
+		PayloadResource: &resource,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	limit, offset := 2, 2
+	list, err = ts.ListActivities(ctx, &store.FindActivity{
+		TypeList:  store.AuditActivityTypes,
+		CreatorID: &user.ID,
+		Limit:     &limit,
+		Offset:    &offset,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	require.Equal(t, store.ActivityAuditCollection, list[0].Type)
+	ts.Close()
+}
+
+func TestShortcutViewStat(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   fmt.Sprintf(`{"shortcutId":%d}`, shortcut.Id),
+		})
+		require.NoError(t, err)
+	}
+	viewCounts, err := ts.CountShortcutViews(ctx, &store.FindShortcutViewStat{
+		ShortcutIDList: []int32{shortcut.Id},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[int32]int32{shortcut.Id: 3}, viewCounts)
+
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	viewCounts, err = ts.CountShortcutViews(ctx, &store.FindShortcutViewStat{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(viewCounts))
+}
+
+func TestShortcutViewAnalytics(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, payload := range []string{
+		`{"shortcutId":1,"ip":"10.0.0.1","referer":"https://a.com"}`,
+		`{"shortcutId":1,"ip":"10.0.0.1","referer":"https://b.com"}`,
+		`{"shortcutId":1,"ip":"10.0.0.2","referer":"https://a.com"}`,
+		`{"shortcutId":1,"ip":"10.0.0.3"}`,
+		`{"shortcutId":2,"ip":"10.0.0.4","referer":"https://a.com"}`,
+	} {
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   payload,
+		})
+		require.NoError(t, err)
+	}
+
+	shortcutID := int32(1)
+	find := &store.FindShortcutViewAnalytics{
+		ShortcutID: &shortcutID,
+	}
+	buckets, err := ts.ListShortcutViewTimeBuckets(ctx, find, store.ShortcutViewStatBucketSize, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(buckets))
+	require.Equal(t, int32(4), buckets[0].ViewCount)
+	require.Equal(t, int32(3), buckets[0].UniqueVisitorCount)
+
+	referers, err := ts.CountShortcutViewsByDimension(ctx, find, store.ShortcutViewDimensionReferer)
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutViewDimensionCount{
+		{Value: "https://a.com", Count: 2},
+		{Value: "", Count: 1},
+		{Value: "https://b.com", Count: 1},
+	}, referers)
+}
+
+func TestCountShortcutViewsByShortcutID(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, shortcutID := range []int32{1, 2, 2} {
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   fmt.Sprintf(`{"shortcutId":%d}`, shortcutID),
+		})
+		require.NoError(t, err)
+	}
+	counts, err := ts.CountShortcutViewsByDimension(ctx, &store.FindShortcutViewAnalytics{}, store.ShortcutViewDimensionShortcutID)
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutViewDimensionCount{
+		{Value: "2", Count: 2},
+		{Value: "1", Count: 1},
+	}, counts)
+}
+
+func TestCompactShortcutViewActivities(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	day := store.GetShortcutViewStatBucketTs(1700000000)
+	for i, createdTs := range []int64{day + 10, day + 20, day + 30, day + store.ShortcutViewStatBucketSize + 10} {
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			CreatedTs: createdTs,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   fmt.Sprintf(`{"shortcutId":%d,"ip":"10.0.0.%d","referer":"https://referer.link"}`, shortcut.Id, i%2),
+		})
+		require.NoError(t, err)
+	}
+
+	// Only the whole days before the time are compacted.
+	count, err := ts.CompactShortcutViewActivities(ctx, day+store.ShortcutViewStatBucketSize+100)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	activities, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type: store.ActivityShortcutView,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(activities))
+	summaries, err := ts.ListShortcutViewSummaries(ctx, &store.FindShortcutViewSummary{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(summaries))
+	require.Equal(t, day, summaries[0].BucketTs)
+	require.Equal(t, int32(3), summaries[0].ViewCount)
+	require.Equal(t, int32(2), summaries[0].UniqueVisitorCount)
+	require.Equal(t, map[string]int32{"https://referer.link": 3}, summaries[0].RefererCounts)
+
+	// The analytics include the compacted views.
+	viewCounts, err := ts.CountShortcutViews(ctx, &store.FindShortcutViewStat{})
+	require.NoError(t, err)
+	require.Equal(t, map[int32]int32{shortcut.Id: 4}, viewCounts)
+	buckets, err := ts.ListShortcutViewTimeBuckets(ctx, &store.FindShortcutViewAnalytics{
+		ShortcutID: &shortcut.Id,
+	}, store.ShortcutViewStatBucketSize, 0)
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutViewTimeBucket{
+		{BucketTs: day, ViewCount: 3, UniqueVisitorCount: 2},
+		{BucketTs: day + store.ShortcutViewStatBucketSize, ViewCount: 1, UniqueVisitorCount: 1},
+	}, buckets)
+	referers, err := ts.CountShortcutViewsByDimension(ctx, &store.FindShortcutViewAnalytics{}, store.ShortcutViewDimensionReferer)
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutViewDimensionCount{{Value: "https://referer.link", Count: 4}}, referers)
+
+	// The summaries are deleted with the shortcut.
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	summaries, err = ts.ListShortcutViewSummaries(ctx, &store.FindShortcutViewSummary{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(summaries))
+	ts.Close()
+}
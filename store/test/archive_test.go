@@ -0,0 +1,301 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestWorkspaceArchive(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.UserSetting_AccessTokensSetting{
+				AccessTokens: []*storepb.UserSetting_AccessTokensSetting_AccessToken{
+					{AccessToken: "test_access_token"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		CreatedTs:  1700000000,
+		UpdatedTs:  1700000100,
+		Name:       "test",
+		Link:       "https://test.link",
+		Tags:       []string{"a", "b"},
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        "test",
+		Title:       "Test",
+		ShortcutIds: []int32{shortcut.Id},
+		Visibility:  storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   fmt.Sprintf(`{"shortcutId":%d}`, shortcut.Id),
+		})
+		require.NoError(t, err)
+	}
+
+	archive, err := ts.ExportWorkspace(ctx, &store.ExportWorkspace{
+		IncludeActivities: true,
+		ExcludeSecrets:    true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(archive.Users))
+	require.Equal(t, 0, len(archive.UserSettings))
+	require.Equal(t, 1, len(archive.Shortcuts))
+	require.Equal(t, 1, len(archive.Collections))
+	require.Equal(t, 2, len(archive.Activities))
+	content, err := store.MarshalWorkspaceArchive(archive)
+	require.NoError(t, err)
+	require.NoError(t, ts.Close())
+
+	// Restore the archive into a fresh workspace.
+	ts = NewTestingStore(ctx, t)
+	archive, err = store.UnmarshalWorkspaceArchive(content)
+	require.NoError(t, err)
+	result, err := ts.ImportWorkspace(ctx, archive)
+	require.NoError(t, err)
+	require.Equal(t, &store.ImportWorkspaceResult{
+		CreatedUsers:       1,
+		CreatedShortcuts:   1,
+		CreatedCollections: 1,
+		CreatedActivities:  2,
+	}, result)
+
+	restoredUser, err := ts.GetUser(ctx, &store.FindUser{
+		Email: &user.Email,
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.PasswordHash, restoredUser.PasswordHash)
+	require.Equal(t, store.RoleAdmin, restoredUser.Role)
+	restoredShortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &shortcut.Name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, restoredUser.ID, restoredShortcut.CreatorId)
+	require.Equal(t, int64(1700000000), restoredShortcut.CreatedTs)
+	require.Equal(t, int64(1700000100), restoredShortcut.UpdatedTs)
+	require.Equal(t, []string{"a", "b"}, restoredShortcut.Tags)
+	restoredCollection, err := ts.GetCollection(ctx, &store.FindCollection{
+		Name: &archive.Collections[0].Name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int32{restoredShortcut.Id}, restoredCollection.ShortcutIds)
+	viewCounts, err := ts.CountShortcutViews(ctx, &store.FindShortcutViewStat{
+		ShortcutIDList: []int32{restoredShortcut.Id},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[int32]int32{restoredShortcut.Id: 2}, viewCounts)
+
+	// Importing the same archive again keeps the existing records.
+	result, err = ts.ImportWorkspace(ctx, archive)
+	require.NoError(t, err)
+	require.Equal(t, &store.ImportWorkspaceResult{
+		SkippedUsers:       1,
+		SkippedShortcuts:   1,
+		SkippedCollections: 1,
+	}, result)
+	ts.Close()
+}
+
+func TestWorkspaceArchiveGroups(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	group, err := ts.CreateGroup(ctx, &store.Group{
+		Name:        "team",
+		Description: "The team",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{
+		GroupID: group.ID,
+		UserID:  user.ID,
+		Role:    store.GroupRoleEditor,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_PRIVATE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		GroupId:    group.ID,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Title:      "Test",
+		Visibility: storepb.Visibility_PRIVATE,
+		GroupId:    group.ID,
+	})
+	require.NoError(t, err)
+
+	archive, err := ts.ExportWorkspace(ctx, &store.ExportWorkspace{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(archive.Groups))
+	require.Equal(t, 1, len(archive.Groups[0].Members))
+	require.NoError(t, ts.Close())
+
+	// The group gets another id in the workspace, which has a group already.
+	ts = NewTestingStore(ctx, t)
+	_, err = ts.CreateGroup(ctx, &store.Group{
+		Name: "other",
+	})
+	require.NoError(t, err)
+	result, err := ts.ImportWorkspace(ctx, archive)
+	require.NoError(t, err)
+	require.Equal(t, &store.ImportWorkspaceResult{
+		CreatedUsers:       1,
+		CreatedShortcuts:   1,
+		CreatedCollections: 1,
+		CreatedGroups:      1,
+	}, result)
+
+	restoredGroup, err := ts.GetGroup(ctx, &store.FindGroup{
+		Name: &group.Name,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, group.ID, restoredGroup.ID)
+	require.Equal(t, group.Description, restoredGroup.Description)
+	restoredUser, err := ts.GetUser(ctx, &store.FindUser{
+		Email: &user.Email,
+	})
+	require.NoError(t, err)
+	member, err := ts.GetGroupMember(ctx, restoredGroup.ID, restoredUser.ID)
+	require.NoError(t, err)
+	require.Equal(t, store.GroupRoleEditor, member.Role)
+	restoredShortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &archive.Shortcuts[0].Name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, restoredGroup.ID, restoredShortcut.GroupId)
+	restoredCollection, err := ts.GetCollection(ctx, &store.FindCollection{
+		Name: &archive.Collections[0].Name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, restoredGroup.ID, restoredCollection.GroupId)
+
+	// Importing the same archive again keeps the existing group.
+	result, err = ts.ImportWorkspace(ctx, archive)
+	require.NoError(t, err)
+	require.Equal(t, &store.ImportWorkspaceResult{
+		SkippedUsers:       1,
+		SkippedShortcuts:   1,
+		SkippedCollections: 1,
+		SkippedGroups:      1,
+	}, result)
+	ts.Close()
+}
+
+func TestWorkspaceArchiveCompactedViews(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	day := store.GetShortcutViewStatBucketTs(1700000000)
+	for _, createdTs := range []int64{day + 10, day + 20, day + store.ShortcutViewStatBucketSize + 10} {
+		_, err := ts.CreateActivity(ctx, &store.Activity{
+			CreatorID: user.ID,
+			CreatedTs: createdTs,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+			Payload:   fmt.Sprintf(`{"shortcutId":%d,"referer":"https://referer.link"}`, shortcut.Id),
+		})
+		require.NoError(t, err)
+	}
+	_, err = ts.CompactShortcutViewActivities(ctx, day+store.ShortcutViewStatBucketSize)
+	require.NoError(t, err)
+
+	archive, err := ts.ExportWorkspace(ctx, &store.ExportWorkspace{
+		IncludeActivities: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(archive.Activities))
+	require.Equal(t, 1, len(archive.ShortcutViewSummaries))
+	require.NoError(t, ts.Close())
+
+	// The compacted views are restored along with the remaining activities.
+	ts = NewTestingStore(ctx, t)
+	_, err = ts.ImportWorkspace(ctx, archive)
+	require.NoError(t, err)
+	restoredShortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &shortcut.Name,
+	})
+	require.NoError(t, err)
+	viewCounts, err := ts.CountShortcutViews(ctx, &store.FindShortcutViewStat{})
+	require.NoError(t, err)
+	require.Equal(t, map[int32]int32{restoredShortcut.Id: 3}, viewCounts)
+	summaries, err := ts.ListShortcutViewSummaries(ctx, &store.FindShortcutViewSummary{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(summaries))
+	require.Equal(t, restoredShortcut.Id, summaries[0].ShortcutID)
+	require.Equal(t, day, summaries[0].BucketTs)
+	require.Equal(t, int32(2), summaries[0].ViewCount)
+	require.Equal(t, map[string]int32{"https://referer.link": 2}, summaries[0].RefererCounts)
+	ts.Close()
+}
+
+func TestImportWorkspaceRollback(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	// The second user has an unknown role, which fails the import after the first user is created.
+	_, err := ts.ImportWorkspace(ctx, &storepb.WorkspaceArchive{
+		FormatVersion: store.WorkspaceArchiveFormatVersion,
+		Users: []*storepb.WorkspaceArchive_User{
+			{Id: 1, Email: "alice@example.com", Nickname: "alice", Role: string(store.RoleUser)},
+			{Id: 2, Email: "bob@example.com", Nickname: "bob", Role: "OWNER"},
+		},
+	})
+	require.ErrorContains(t, err, `invalid role "OWNER"`)
+	users, err := ts.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(users))
+
+	// The names starting with "me/" are resolved in the personal namespace only.
+	_, err = ts.ImportWorkspace(ctx, &storepb.WorkspaceArchive{
+		FormatVersion: store.WorkspaceArchiveFormatVersion,
+		Users: []*storepb.WorkspaceArchive_User{
+			{Id: 1, Email: "alice@example.com", Nickname: "alice", Role: string(store.RoleUser)},
+		},
+		Shortcuts: []*storepb.Shortcut{
+			{Id: 1, CreatorId: 1, Name: "me/standup", Link: "https://example.com"},
+		},
+	})
+	require.ErrorContains(t, err, "must not start with")
+	users, err = ts.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(users))
+	ts.Close()
+}
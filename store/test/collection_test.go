@@ -50,3 +50,43 @@ func TestCollectionStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(collections))
 }
+
+func TestListPrivateCollections(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@test.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	for _, create := range []*storepb.Collection{
+		{CreatorId: admin.ID, Name: "admin-private", Title: "Admin private", Visibility: storepb.Visibility_PRIVATE},
+		{CreatorId: user.ID, Name: "user-private", Title: "User private", Visibility: storepb.Visibility_PRIVATE},
+		{CreatorId: user.ID, Name: "user-workspace", Title: "User workspace", Visibility: storepb.Visibility_WORKSPACE},
+	} {
+		_, err := ts.CreateCollection(ctx, create)
+		require.NoError(t, err)
+	}
+
+	collections, err := ts.ListCollections(ctx, &store.FindCollection{
+		PrivateCreatorID: &admin.ID,
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"admin-private", "user-workspace"}, getCollectionNames(collections))
+	collections, err = ts.ListCollections(ctx, &store.FindCollection{
+		VisibilityList: []storepb.Visibility{storepb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"admin-private", "user-private"}, getCollectionNames(collections))
+}
+
+func getCollectionNames(collections []*storepb.Collection) []string {
+	names := []string{}
+	for _, collection := range collections {
+		names = append(names, collection.Name)
+	}
+	return names
+}
@@ -0,0 +1,108 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/server/common"
+	"github.com/yourselfhosted/slash/server/profile"
+	"github.com/yourselfhosted/slash/store"
+	"github.com/yourselfhosted/slash/store/db"
+)
+
+func TestCopyDatabase(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		CreatedTs:  1700000000,
+		Name:       "test",
+		Link:       "https://test.link",
+		Tags:       []string{"a", "b"},
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        "test",
+		ShortcutIds: []int32{shortcut.Id},
+		Visibility:  storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityShortcutView,
+		Level:     store.ActivityInfo,
+		Payload:   fmt.Sprintf(`{"shortcutId":%d}`, shortcut.Id),
+	})
+	require.NoError(t, err)
+
+	target := newTestingSQLiteStore(ctx, t)
+	results, err := store.CopyDatabase(ctx, ts, target)
+	require.NoError(t, err)
+	copiedRows := map[string]int{}
+	for _, result := range results {
+		copiedRows[result.Table] = result.Rows
+	}
+	require.Equal(t, 1, copiedRows["user"])
+	require.Equal(t, 1, copiedRows["shortcut"])
+	require.Equal(t, 1, copiedRows["shortcut_view_stat"])
+	require.Equal(t, 1, copiedRows["collection"])
+	require.Equal(t, 1, copiedRows["activity"])
+
+	copiedShortcut, err := target.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, copiedShortcut.CreatorId)
+	require.Equal(t, int64(1700000000), copiedShortcut.CreatedTs)
+	require.Equal(t, []string{"a", "b"}, copiedShortcut.Tags)
+	copiedCollection, err := target.GetCollection(ctx, &store.FindCollection{
+		ID: &collection.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int32{shortcut.Id}, copiedCollection.ShortcutIds)
+	viewCounts, err := target.CountShortcutViews(ctx, &store.FindShortcutViewStat{})
+	require.NoError(t, err)
+	require.Equal(t, map[int32]int32{shortcut.Id: 1}, viewCounts)
+
+	// New records get ids after the copied ones.
+	newShortcut, err := target.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "new",
+		Link:       "https://new.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.Greater(t, newShortcut.Id, shortcut.Id)
+
+	// The target must be empty.
+	_, err = store.CopyDatabase(ctx, ts, target)
+	require.Error(t, err)
+	ts.Close()
+	target.Close()
+}
+
+func newTestingSQLiteStore(ctx context.Context, t *testing.T) *store.Store {
+	dir := t.TempDir()
+	profile := &profile.Profile{
+		Mode:    "prod",
+		Data:    dir,
+		DSN:     fmt.Sprintf("%s/slash_target.db", dir),
+		Driver:  "sqlite",
+		Version: common.GetCurrentVersion("prod"),
+	}
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	store := store.New(dbDriver, profile)
+	require.NoError(t, store.Migrate(ctx))
+	return store
+}
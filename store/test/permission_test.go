@@ -0,0 +1,68 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestRolePermissions(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	viewer, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleViewer,
+		Email:    "viewer@test.com",
+		Nickname: "viewer",
+	})
+	require.NoError(t, err)
+
+	// Default permissions.
+	hasPermission, err := ts.HasPermission(ctx, viewer, store.PermissionShortcutsCreate)
+	require.NoError(t, err)
+	require.False(t, hasPermission)
+	permissions, err := ts.GetRolePermissions(ctx, store.RoleEditor)
+	require.NoError(t, err)
+	require.Contains(t, permissions, store.PermissionShortcutsEditAll)
+	require.NotContains(t, permissions, store.PermissionShortcutsViewPrivate)
+	permissions, err = ts.GetRolePermissions(ctx, store.RoleAnalyst)
+	require.NoError(t, err)
+	require.Equal(t, []store.Permission{store.PermissionAnalyticsView}, permissions)
+
+	// Configured permissions override the default ones of the role only.
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ROLE_PERMISSION,
+		Value: &storepb.WorkspaceSetting_RolePermission{
+			RolePermission: &storepb.WorkspaceSetting_RolePermissionSetting{
+				RolePermissions: []*storepb.WorkspaceSetting_RolePermissionSetting_RolePermission{
+					{Role: string(store.RoleViewer), Permissions: []string{string(store.PermissionShortcutsCreate), string(store.PermissionShortcutsViewPrivate), string(store.PermissionWorkspaceManage)}},
+					{Role: string(store.RoleAdmin), Permissions: []string{}},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	hasPermission, err = ts.HasPermission(ctx, viewer, store.PermissionShortcutsCreate)
+	require.NoError(t, err)
+	require.True(t, hasPermission)
+	hasPermission, err = ts.HasPermission(ctx, viewer, store.PermissionShortcutsViewPrivate)
+	require.NoError(t, err)
+	require.True(t, hasPermission)
+	// The admin only permissions are never granted to the other roles.
+	hasPermission, err = ts.HasPermission(ctx, viewer, store.PermissionWorkspaceManage)
+	require.NoError(t, err)
+	require.False(t, hasPermission)
+	permissions, err = ts.GetRolePermissions(ctx, store.RoleUser)
+	require.NoError(t, err)
+	require.Equal(t, store.DefaultRolePermissions[store.RoleUser], permissions)
+	for _, permission := range store.AllPermissions {
+		hasPermission, err := ts.HasPermission(ctx, admin, permission)
+		require.NoError(t, err)
+		require.True(t, hasPermission)
+	}
+}
@@ -31,13 +31,15 @@ func TestShortcutStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(shortcuts))
 	require.Equal(t, shortcut, shortcuts[0])
-	newLink := "https://new.link"
+	newLink, linkTemplate := "https://new.link/{1}", true
 	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
-		ID:   shortcut.Id,
-		Link: &newLink,
+		ID:           shortcut.Id,
+		Link:         &newLink,
+		LinkTemplate: &linkTemplate,
 	})
 	require.NoError(t, err)
 	require.Equal(t, newLink, updatedShortcut.Link)
+	require.True(t, updatedShortcut.LinkTemplate)
 	tag := "test"
 	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{
 		Tag: &tag,
@@ -53,3 +55,324 @@ func TestShortcutStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(shortcuts))
 }
+
+func TestListShortcutsWithFindOptions(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, name := range []string{"gh", "gh-pr", "go_doc", "jira"} {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://test.link/" + name,
+			Visibility: storepb.Visibility_PUBLIC,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+
+	namePrefix := "g"
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		NamePrefix: &namePrefix,
+		OrderBy:    store.ShortcutOrderByName,
+		OrderAsc:   true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"gh", "gh-pr", "go_doc"}, getShortcutNames(shortcuts))
+
+	// The wildcards in the prefix are matched literally.
+	namePrefix = "go_"
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		NamePrefix: &namePrefix,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"go_doc"}, getShortcutNames(shortcuts))
+
+	limit, offset := 2, 1
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		OrderBy:  store.ShortcutOrderByName,
+		OrderAsc: true,
+		Limit:    &limit,
+		Offset:   &offset,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"gh-pr", "go_doc"}, getShortcutNames(shortcuts))
+
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		VisibilityList: []storepb.Visibility{storepb.Visibility_WORKSPACE},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+}
+
+func TestListPrivateShortcuts(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@test.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	for _, create := range []struct {
+		creatorID  int32
+		name       string
+		visibility storepb.Visibility
+	}{
+		{creatorID: admin.ID, name: "admin-private", visibility: storepb.Visibility_PRIVATE},
+		{creatorID: admin.ID, name: "admin-workspace", visibility: storepb.Visibility_WORKSPACE},
+		{creatorID: user.ID, name: "user-private", visibility: storepb.Visibility_PRIVATE},
+		{creatorID: user.ID, name: "user-public", visibility: storepb.Visibility_PUBLIC},
+	} {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  create.creatorID,
+			Name:       create.name,
+			Link:       "https://test.link/" + create.name,
+			Visibility: create.visibility,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		PrivateCreatorID: &user.ID,
+		OrderBy:          store.ShortcutOrderByName,
+		OrderAsc:         true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"admin-workspace", "user-private", "user-public"}, getShortcutNames(shortcuts))
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		VisibilityList: []storepb.Visibility{storepb.Visibility_PRIVATE},
+		OrderBy:        store.ShortcutOrderByName,
+		OrderAsc:       true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"admin-private", "user-private"}, getShortcutNames(shortcuts))
+	name := "user-private"
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, storepb.Visibility_PRIVATE, shortcut.Visibility)
+}
+
+func TestResolveShortcutInNamespaces(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@test.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	workspaceShortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  admin.ID,
+		Name:       "standup",
+		Link:       "https://test.link/workspace",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	personalShortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:   user.ID,
+		Name:        "standup",
+		Link:        "https://test.link/personal",
+		Visibility:  storepb.Visibility_PRIVATE,
+		OgMetadata:  &storepb.OpenGraphMetadata{},
+		NamespaceId: user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.ID, personalShortcut.NamespaceId)
+	// The names are unique in a namespace.
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:   user.ID,
+		Name:        "standup",
+		Link:        "https://test.link/duplicate",
+		Visibility:  storepb.Visibility_PRIVATE,
+		OgMetadata:  &storepb.OpenGraphMetadata{},
+		NamespaceId: user.ID,
+	})
+	require.Error(t, err)
+
+	for _, tc := range []struct {
+		name       string
+		userID     int32
+		expectedID int32
+	}{
+		{name: "standup", userID: user.ID, expectedID: personalShortcut.Id},
+		{name: "standup", userID: admin.ID, expectedID: workspaceShortcut.Id},
+		{name: "standup", userID: 0, expectedID: workspaceShortcut.Id},
+		{name: "me/standup", userID: user.ID, expectedID: personalShortcut.Id},
+		{name: "me/standup", userID: admin.ID, expectedID: 0},
+		{name: "me/standup", userID: 0, expectedID: 0},
+	} {
+		shortcut, err := ts.ResolveShortcut(ctx, &store.FindShortcut{
+			Name: &tc.name,
+		}, tc.userID)
+		require.NoError(t, err)
+		require.Equal(t, tc.expectedID, shortcut.GetId(), "resolve %q for user %d", tc.name, tc.userID)
+	}
+
+	// An archived shortcut falls through to the active one of the next namespace, and is returned if none is active.
+	name, rowStatus := "standup", storepb.RowStatus_ARCHIVED
+	for _, tc := range []struct {
+		archivedID int32
+		expectedID int32
+	}{
+		{archivedID: personalShortcut.Id, expectedID: workspaceShortcut.Id},
+		{archivedID: workspaceShortcut.Id, expectedID: personalShortcut.Id},
+	} {
+		_, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:        tc.archivedID,
+			RowStatus: &rowStatus,
+		})
+		require.NoError(t, err)
+		shortcut, err := ts.ResolveShortcut(ctx, &store.FindShortcut{
+			Name: &name,
+		}, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, tc.expectedID, shortcut.GetId())
+	}
+
+	namespaceID := store.WorkspaceNamespaceID
+	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:          workspaceShortcut.Id,
+		NamespaceID: &admin.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, admin.ID, updatedShortcut.NamespaceId)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		NamespaceID: &namespaceID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+}
+
+func TestArchiveShortcut(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, name := range []string{"old", "recent", "active"} {
+		shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://" + name + ".link",
+			Visibility: storepb.Visibility_WORKSPACE,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+		require.Equal(t, storepb.RowStatus_NORMAL, shortcut.RowStatus)
+		if name == "active" {
+			continue
+		}
+		updatedTs := int64(2000000000)
+		if name == "old" {
+			updatedTs = 1000000000
+		}
+		rowStatus := storepb.RowStatus_ARCHIVED
+		shortcut, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:        shortcut.Id,
+			RowStatus: &rowStatus,
+			UpdatedTs: &updatedTs,
+		})
+		require.NoError(t, err)
+		require.Equal(t, storepb.RowStatus_ARCHIVED, shortcut.RowStatus)
+		require.Equal(t, updatedTs, shortcut.UpdatedTs)
+	}
+
+	normal, archived := storepb.RowStatus_NORMAL, storepb.RowStatus_ARCHIVED
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus: &normal,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"active"}, getShortcutNames(shortcuts))
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus: &archived,
+		OrderBy:   store.ShortcutOrderByName,
+		OrderAsc:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"old", "recent"}, getShortcutNames(shortcuts))
+
+	count, err := ts.PurgeArchivedShortcuts(ctx, 1500000000)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		OrderBy:  store.ShortcutOrderByName,
+		OrderAsc: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"active", "recent"}, getShortcutNames(shortcuts))
+}
+
+func TestShortcutRevisionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "test",
+		Link:       "https://test.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	links := []string{"https://test.link/1", "https://test.link/2"}
+	oldShortcut := shortcut
+	for _, link := range links {
+		newShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:   shortcut.Id,
+			Link: &link,
+		})
+		require.NoError(t, err)
+		_, err = ts.CreateShortcutRevision(ctx, &storepb.ShortcutRevision{
+			ShortcutId: shortcut.Id,
+			CreatorId:  user.ID,
+			OldValue:   oldShortcut,
+			NewValue:   newShortcut,
+		})
+		require.NoError(t, err)
+		oldShortcut = newShortcut
+	}
+
+	revisions, err := ts.ListShortcutRevisions(ctx, &store.FindShortcutRevision{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(revisions))
+	require.Equal(t, "https://test.link/1", revisions[0].OldValue.Link)
+	require.Equal(t, "https://test.link/2", revisions[0].NewValue.Link)
+	require.Equal(t, "https://test.link", revisions[1].OldValue.Link)
+	require.Equal(t, user.ID, revisions[1].CreatorId)
+	revision, err := ts.GetShortcutRevision(ctx, &store.FindShortcutRevision{
+		ID: &revisions[1].Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, revisions[1].NewValue.Link, revision.NewValue.Link)
+
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	revisions, err = ts.ListShortcutRevisions(ctx, &store.FindShortcutRevision{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(revisions))
+	ts.Close()
+}
+
+func getShortcutNames(shortcuts []*storepb.Shortcut) []string {
+	names := []string{}
+	for _, shortcut := range shortcuts {
+		names = append(names, shortcut.Name)
+	}
+	return names
+}
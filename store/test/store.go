@@ -38,7 +38,12 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS user_setting CASCADE;
 		DROP TABLE IF EXISTS shortcut CASCADE;
 		DROP TABLE IF EXISTS activity CASCADE;
-		DROP TABLE IF EXISTS collection CASCADE;`)
+		DROP TABLE IF EXISTS collection CASCADE;
+		DROP TABLE IF EXISTS shortcut_view_stat CASCADE;
+		DROP TABLE IF EXISTS shortcut_revision CASCADE;
+		DROP TABLE IF EXISTS shortcut_view_summary CASCADE;
+		DROP TABLE IF EXISTS user_group CASCADE;
+		DROP TABLE IF EXISTS user_group_member CASCADE;`)
 		if err != nil {
 			fmt.Printf("failed to reset testing db, error: %+v\n", err)
 			panic(err)
@@ -0,0 +1,60 @@
+package teststore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestRunInTx(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	errRollback := errors.New("rollback")
+
+	// The transaction of DeleteUser becomes a savepoint, which is rolled back along with the outer transaction.
+	err = ts.RunInTx(ctx, func(txStore *store.Store) error {
+		if err := txStore.DeleteUser(ctx, &store.DeleteUser{ID: user.ID}); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	require.ErrorIs(t, err, errRollback)
+	users, err := ts.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(users))
+
+	// The nested transaction is rolled back on its own, and the outer one still commits.
+	err = ts.RunInTx(ctx, func(txStore *store.Store) error {
+		err := txStore.RunInTx(ctx, func(nestedStore *store.Store) error {
+			if err := nestedStore.DeleteUser(ctx, &store.DeleteUser{ID: user.ID}); err != nil {
+				return err
+			}
+			return errRollback
+		})
+		require.ErrorIs(t, err, errRollback)
+		_, err = txStore.CreateUser(ctx, &store.User{
+			Role:     store.RoleUser,
+			Email:    "user@test.com",
+			Nickname: "user",
+		})
+		return err
+	})
+	require.NoError(t, err)
+	users, err = ts.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(users))
+
+	err = ts.RunInTx(ctx, func(txStore *store.Store) error {
+		return txStore.DeleteUser(ctx, &store.DeleteUser{ID: user.ID})
+	})
+	require.NoError(t, err)
+	users, err = ts.ListUsers(ctx, &store.FindUser{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(users))
+}
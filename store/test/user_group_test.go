@@ -0,0 +1,127 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+	"github.com/yourselfhosted/slash/store"
+)
+
+func TestGroupStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@test.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+
+	group, err := ts.CreateGroup(ctx, &store.Group{
+		Name:        "platform",
+		Description: "The platform team",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: admin.ID, Role: store.GroupRoleEditor})
+	require.NoError(t, err)
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: user.ID, Role: store.GroupRoleEditor})
+	require.NoError(t, err)
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: user.ID, Role: store.GroupRoleViewer})
+	require.NoError(t, err)
+	members, err := ts.ListGroupMembers(ctx, &store.FindGroupMember{
+		GroupID: &group.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(members))
+	member, err := ts.GetGroupMember(ctx, group.ID, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, store.GroupRoleViewer, member.Role)
+
+	newName := "infra"
+	updatedGroup, err := ts.UpdateGroup(ctx, &store.UpdateGroup{
+		ID:   group.ID,
+		Name: &newName,
+	})
+	require.NoError(t, err)
+	require.Equal(t, newName, updatedGroup.Name)
+
+	err = ts.DeleteGroupMember(ctx, &store.DeleteGroupMember{GroupID: group.ID, UserID: user.ID})
+	require.NoError(t, err)
+	groupIDs, err := ts.ListUserGroupIDs(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(groupIDs))
+}
+
+func TestListGroupPrivateShortcuts(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@test.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	group, err := ts.CreateGroup(ctx, &store.Group{
+		Name: "platform",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: user.ID, Role: store.GroupRoleViewer})
+	require.NoError(t, err)
+	for _, create := range []*storepb.Shortcut{
+		{CreatorId: admin.ID, Name: "admin-private", Link: "https://example.com", Visibility: storepb.Visibility_PRIVATE},
+		{CreatorId: admin.ID, Name: "group-private", Link: "https://example.com", Visibility: storepb.Visibility_PRIVATE, GroupId: group.ID},
+	} {
+		_, err := ts.CreateShortcut(ctx, create)
+		require.NoError(t, err)
+	}
+	_, err = ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:  admin.ID,
+		Name:       "group-private",
+		Title:      "Group private",
+		Visibility: storepb.Visibility_PRIVATE,
+		GroupId:    group.ID,
+	})
+	require.NoError(t, err)
+
+	groupIDs, err := ts.ListUserGroupIDs(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, []int32{group.ID}, groupIDs)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		PrivateCreatorID:   &user.ID,
+		PrivateGroupIDList: groupIDs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, "group-private", shortcuts[0].Name)
+	collections, err := ts.ListCollections(ctx, &store.FindCollection{
+		PrivateCreatorID:   &user.ID,
+		PrivateGroupIDList: groupIDs,
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"group-private"}, getCollectionNames(collections))
+
+	// The shortcuts owned by the deleted group are kept with their creators.
+	err = ts.DeleteGroup(ctx, &store.DeleteGroup{ID: group.ID})
+	require.NoError(t, err)
+	groupIDs, err = ts.ListUserGroupIDs(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(groupIDs))
+	name := "group-private"
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{
+		Name: &name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), shortcut.GroupId)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		PrivateCreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+}
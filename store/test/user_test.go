@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
 	"github.com/yourselfhosted/slash/store"
 )
 
@@ -36,6 +37,68 @@ func TestUserStore(t *testing.T) {
 	require.Equal(t, 0, len(users))
 }
 
+func TestTransferOwnership(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@test.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	for _, create := range []*storepb.Shortcut{
+		{CreatorId: user.ID, Name: "docs", Link: "https://example.com/docs", Visibility: storepb.Visibility_WORKSPACE},
+		{CreatorId: user.ID, Name: "todo", Link: "https://example.com/todo", Visibility: storepb.Visibility_PRIVATE, NamespaceId: user.ID},
+		{CreatorId: admin.ID, Name: "admin", Link: "https://example.com/admin", Visibility: storepb.Visibility_WORKSPACE},
+	} {
+		_, err := ts.CreateShortcut(ctx, create)
+		require.NoError(t, err)
+	}
+	_, err = ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:  user.ID,
+		Name:       "team",
+		Title:      "Team",
+		Visibility: storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+	group, err := ts.CreateGroup(ctx, &store.Group{
+		Name: "platform",
+	})
+	require.NoError(t, err)
+
+	result, err := ts.TransferOwnership(ctx, &store.TransferOwnership{
+		FromUserID:    user.ID,
+		ToUserID:      admin.ID,
+		ToGroupID:     group.ID,
+		ToNamespaceID: store.WorkspaceNamespaceID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &store.TransferOwnershipResult{ShortcutCount: 2, CollectionCount: 1}, result)
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: user.ID,
+	})
+	require.NoError(t, err)
+
+	// The shortcuts survive the deletion of their previous creator.
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		GroupID: &group.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(shortcuts))
+	for _, shortcut := range shortcuts {
+		require.Equal(t, admin.ID, shortcut.CreatorId)
+		require.Equal(t, store.WorkspaceNamespaceID, shortcut.NamespaceId)
+	}
+	collections, err := ts.ListCollections(ctx, &store.FindCollection{
+		CreatorID: &admin.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+	require.Equal(t, group.ID, collections[0].GroupId)
+}
+
 // createTestingAdminUser creates a testing admin user.
 func createTestingAdminUser(ctx context.Context, ts *store.Store) (*store.User, error) {
 	userCreate := &store.User{
@@ -14,6 +14,12 @@ const (
 	RoleAdmin Role = "ADMIN"
 	// RoleUser is the USER role.
 	RoleUser Role = "USER"
+	// RoleEditor is the EDITOR role, who can edit all shortcuts and collections by default.
+	RoleEditor Role = "EDITOR"
+	// RoleViewer is the VIEWER role, who can only view shortcuts and collections by default.
+	RoleViewer Role = "VIEWER"
+	// RoleAnalyst is the ANALYST role, who can view the workspace analytics by default.
+	RoleAnalyst Role = "ANALYST"
 )
 
 type User struct {
@@ -53,6 +59,22 @@ type DeleteUser struct {
 	ID int32
 }
 
+// TransferOwnership transfers the shortcuts and collections created by a user.
+type TransferOwnership struct {
+	FromUserID int32
+	// ToUserID is the new creator of the shortcuts and collections.
+	ToUserID int32
+	// ToGroupID makes the group own the shortcuts and collections if it's not 0.
+	ToGroupID int32
+	// ToNamespaceID is the namespace that the personal shortcuts of the user are moved into.
+	ToNamespaceID int32
+}
+
+type TransferOwnershipResult struct {
+	ShortcutCount   int
+	CollectionCount int
+}
+
 func (s *Store) CreateUser(ctx context.Context, create *User) (*User, error) {
 	user, err := s.driver.CreateUser(ctx, create)
 	if err != nil {
@@ -103,6 +125,22 @@ func (s *Store) GetUser(ctx context.Context, find *FindUser) (*User, error) {
 	return list[0], nil
 }
 
+// TransferOwnership transfers the shortcuts and collections created by the user in a transaction.
+func (s *Store) TransferOwnership(ctx context.Context, transfer *TransferOwnership) (*TransferOwnershipResult, error) {
+	result, err := s.driver.TransferOwnership(ctx, transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	s.shortcutCache.Range(func(key, value any) bool {
+		if shortcut, ok := value.(*storepb.Shortcut); ok && (shortcut.CreatorId == transfer.FromUserID || shortcut.NamespaceId == transfer.FromUserID) {
+			s.shortcutCache.Delete(key)
+		}
+		return true
+	})
+	return result, nil
+}
+
 func (s *Store) DeleteUser(ctx context.Context, delete *DeleteUser) error {
 	if err := s.driver.DeleteUser(ctx, delete); err != nil {
 		return err
@@ -0,0 +1,150 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/yourselfhosted/slash/proto/gen/store"
+)
+
+// GroupRole is the role of a member in a group.
+type GroupRole string
+
+const (
+	// GroupRoleEditor is the EDITOR role, who can edit the shortcuts and collections owned by the group, and manage the group.
+	GroupRoleEditor GroupRole = "EDITOR"
+	// GroupRoleViewer is the VIEWER role, who can view the private shortcuts and collections owned by the group.
+	GroupRoleViewer GroupRole = "VIEWER"
+)
+
+// Group is a team of users sharing the ownership of shortcuts and collections.
+type Group struct {
+	ID int32
+
+	// Standard fields
+	CreatedTs int64
+	UpdatedTs int64
+
+	// Domain specific fields
+	Name        string
+	Description string
+}
+
+type UpdateGroup struct {
+	ID int32
+
+	UpdatedTs   *int64
+	Name        *string
+	Description *string
+}
+
+type FindGroup struct {
+	ID     *int32
+	Name   *string
+	IDList []int32
+}
+
+type DeleteGroup struct {
+	ID int32
+}
+
+type GroupMember struct {
+	GroupID int32
+	UserID  int32
+	Role    GroupRole
+}
+
+type FindGroupMember struct {
+	GroupID *int32
+	UserID  *int32
+}
+
+type DeleteGroupMember struct {
+	GroupID int32
+	UserID  int32
+}
+
+func (s *Store) CreateGroup(ctx context.Context, create *Group) (*Group, error) {
+	return s.driver.CreateGroup(ctx, create)
+}
+
+func (s *Store) UpdateGroup(ctx context.Context, update *UpdateGroup) (*Group, error) {
+	return s.driver.UpdateGroup(ctx, update)
+}
+
+func (s *Store) ListGroups(ctx context.Context, find *FindGroup) ([]*Group, error) {
+	return s.driver.ListGroups(ctx, find)
+}
+
+func (s *Store) GetGroup(ctx context.Context, find *FindGroup) (*Group, error) {
+	list, err := s.ListGroups(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+// DeleteGroup deletes the group and its members. The shortcuts and collections owned by the group
+// are kept, and only owned by their creators afterwards.
+func (s *Store) DeleteGroup(ctx context.Context, delete *DeleteGroup) error {
+	if err := s.driver.DeleteGroup(ctx, delete); err != nil {
+		return err
+	}
+
+	s.shortcutCache.Range(func(key, value any) bool {
+		if shortcut, ok := value.(*storepb.Shortcut); ok && shortcut.GroupId == delete.ID {
+			s.shortcutCache.Delete(key)
+		}
+		return true
+	})
+	return nil
+}
+
+func (s *Store) UpsertGroupMember(ctx context.Context, upsert *GroupMember) (*GroupMember, error) {
+	return s.driver.UpsertGroupMember(ctx, upsert)
+}
+
+func (s *Store) ListGroupMembers(ctx context.Context, find *FindGroupMember) ([]*GroupMember, error) {
+	return s.driver.ListGroupMembers(ctx, find)
+}
+
+// GetGroupMember returns the membership of the user in the group, or nil if the user is not a member.
+func (s *Store) GetGroupMember(ctx context.Context, groupID, userID int32) (*GroupMember, error) {
+	list, err := s.ListGroupMembers(ctx, &FindGroupMember{
+		GroupID: &groupID,
+		UserID:  &userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0], nil
+}
+
+// ListUserGroupIDs returns the ids of the groups that the user is a member of.
+func (s *Store) ListUserGroupIDs(ctx context.Context, userID int32) ([]int32, error) {
+	members, err := s.ListGroupMembers(ctx, &FindGroupMember{
+		UserID: &userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs := []int32{}
+	for _, member := range members {
+		groupIDs = append(groupIDs, member.GroupID)
+	}
+	return groupIDs, nil
+}
+
+func (s *Store) DeleteGroupMember(ctx context.Context, delete *DeleteGroupMember) error {
+	return s.driver.DeleteGroupMember(ctx, delete)
+}
@@ -2,6 +2,8 @@ package store
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 
 	"github.com/pkg/errors"
 
@@ -92,3 +94,37 @@ func (s *Store) GetWorkspaceSecuritySetting(ctx context.Context) (*storepb.Works
 	}
 	return securitySetting, nil
 }
+
+func (s *Store) GetWorkspaceShortcutRelatedSetting(ctx context.Context) (*storepb.WorkspaceSetting_ShortcutRelatedSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+	})
+	if err != nil {
+		return nil, err
+	}
+	shortcutRelatedSetting := &storepb.WorkspaceSetting_ShortcutRelatedSetting{}
+	if setting != nil && setting.GetShortcutRelated() != nil {
+		shortcutRelatedSetting = setting.GetShortcutRelated()
+	}
+	return shortcutRelatedSetting, nil
+}
+
+func (s *Store) GetWorkspaceRolePermissionSetting(ctx context.Context) (*storepb.WorkspaceSetting_RolePermissionSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ROLE_PERMISSION,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rolePermissionSetting := &storepb.WorkspaceSetting_RolePermissionSetting{}
+	if setting != nil && setting.GetRolePermission() != nil {
+		rolePermissionSetting = setting.GetRolePermission()
+	}
+	return rolePermissionSetting, nil
+}
+
+// HashSCIMToken returns the hash of the SCIM bearer token, which is stored instead of the token.
+func HashSCIMToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}